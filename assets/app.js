@@ -2,6 +2,55 @@
 
 const e = React.createElement;
 
+// schemaOfTable returns the schema of the stored table with the given id.
+function schemaOfTable(tableID) {
+    let tables = data["Tables"];
+    for (let i = 0; i < tables.length; i++) {
+        if (tables[i]["id"] === tableID) {
+            return tables[i]["schema"] || "";
+        }
+    }
+    return "";
+}
+
+// groupBySchema formats the given items grouped by their schema. The items of databases
+// that are not read by schema are formatted without any group.
+function groupBySchema(items, schemaOf, format) {
+    let groups = {};
+    let schemas = [];
+    for (let i = 0; i < items.length; i++) {
+        let schema = schemaOf(items[i]) || "";
+        if (!(schema in groups)) {
+            groups[schema] = [];
+            schemas.push(schema);
+        }
+        groups[schema].push(items[i]);
+    }
+    schemas.sort();
+
+    let msg = "";
+    for (let i = 0; i < schemas.length; i++) {
+        if (schemas[i] !== "") {
+            msg += "schema (" + schemas[i] + "):\n"
+        }
+        for (let j = 0; j < groups[schemas[i]].length; j++) {
+            msg += format(groups[schemas[i]][j])
+        }
+    }
+    return msg
+}
+
+// formatBytes formats the given size in bytes, e.g. 1536 as 1.5 kB.
+function formatBytes(size) {
+    let units = ["B", "kB", "MB", "GB", "TB"];
+    let i = 0;
+    while (size >= 1024 && i < units.length - 1) {
+        size /= 1024;
+        i++;
+    }
+    return (i === 0 ? size : size.toFixed(1)) + " " + units[i]
+}
+
 class DatabaseInfo extends React.Component {
     constructor(props) {
         super(props);
@@ -9,9 +58,11 @@ class DatabaseInfo extends React.Component {
             info: data["DatabaseInfo"],
             syncIndicator:false,
             checkIndicator:false,
+            profileIndicator:false,
         };
         this.syncDatabase = this.syncDatabase.bind(this);
         this.checkDatabaseChanges = this.checkDatabaseChanges.bind(this);
+        this.profileDatabase = this.profileDatabase.bind(this);
     }
 
     syncDatabase = () => {
@@ -57,52 +108,119 @@ class DatabaseInfo extends React.Component {
                 res.json().then((data) => {
                     let newTables = data["new_tables"];
                     let deletedTables = data["deleted_tables"];
+                    let tableChanges = data["table_changes"];
                     let columnChanges = data["column_changes"];
                     let deletedCols = data["deleted_columns"];
                     let newCols = data["new_columns"];
+                    let newDescriptions = data["new_descriptions"];
+                    let descriptionConflicts = data["description_conflicts"];
+                    let newRoutines = data["new_routines"];
+                    let deletedRoutines = data["deleted_routines"];
+                    let routineChanges = data["routine_changes"];
+                    let newTypes = data["new_types"];
+                    let deletedTypes = data["deleted_types"];
+                    let typeChanges = data["type_changes"];
 
                     if (newTables.length === 0 &&
                         deletedTables.length === 0 &&
+                        tableChanges.length === 0 &&
                         columnChanges.length === 0 &&
                         deletedCols.length === 0 &&
-                        newCols.length === 0) {
-                        alert("Database does not have any changes. It is up-to-date.")
+                        newCols.length === 0 &&
+                        newDescriptions.length === 0 &&
+                        descriptionConflicts.length === 0 &&
+                        newRoutines.length === 0 &&
+                        deletedRoutines.length === 0 &&
+                        routineChanges.length === 0 &&
+                        newTypes.length === 0 &&
+                        deletedTypes.length === 0 &&
+                        typeChanges.length === 0) {
+                        // syncing a database without changes still records the statistics of its tables.
+                        let yes = confirm("Database does not have any changes. It is up-to-date.\n\n" +
+                            "Do you want to sync it anyway to record the current statistics of the tables?")
+                        if (yes) {
+                            this.syncDatabase();
+                        }
                         return;
                     }
                     let topMsg = "Before syncing the database scroll down and check all changes detected by godic, if you want " +
-                        "to proceed with the synchronization press OK. When existing columns are updated godic will remove " +
-                        "the previous descriptions saved, so godic will force you to update the columns's descriptions.\n\n"
+                        "to proceed with the synchronization press OK. When existing columns are updated godic will keep " +
+                        "the descriptions saved, so review them in case they do not match the changes anymore.\n\n"
                     let msg = topMsg+"";
 
                     if (newTables.length > 0) {
                         msg += "\nThere are new tables created:\n"
-                        for (let i = 0; i < newTables.length; i++) {
-                            msg += "- " + newTables[i] + "\n"
-                        }
+                        msg += groupBySchema(newTables, (t) => t["schema"], (t) => "- " + t["name"] + "\n")
                     }
                     if (deletedTables.length > 0) {
                         msg += "\nSome tables have been deleted:\n"
-                        for (let i = 0; i < deletedTables.length; i++) {
-                            msg += "- " + deletedTables[i] + "\n"
-                        }
+                        msg += groupBySchema(deletedTables, (t) => t["schema"], (t) => "- " + t["name"] + "\n")
+                    }
+                    if (tableChanges.length > 0) {
+                        msg += "\nThere has been some changes in the keys of existing tables:\n"
+                        msg += groupBySchema(tableChanges, (c) => c["metadata"]["schema"], (c) =>
+                            `- table (${c["metadata"]["name"]}) suffered the following changes:\n${c["changes_message"]}\n`
+                        )
                     }
                     if (columnChanges.length > 0) {
                         msg += "\nThere has been some changes in existing columns:\n"
-                        for (let i = 0; i < columnChanges.length; i++) {
-                            msg += `- column (${columnChanges[i]["metadata"]["name"]}) in table (${columnChanges[i]["metadata"]["table_name"]}) suffered the following changes:\n${columnChanges[i]["changes_message"]}\n`
-                        }
+                        msg += groupBySchema(columnChanges, (c) => schemaOfTable(c["metadata"]["table_name"]), (c) =>
+                            `- column (${c["metadata"]["name"]}) in table (${c["metadata"]["table_name"]}) suffered the following changes:\n${c["changes_message"]}\n`
+                        )
                     }
                     if (deletedCols.length > 0) {
                         msg += "\nSome columns have been deleted:\n"
-                        for (let i = 0; i < deletedCols.length; i++) {
-                            msg += `- column (${deletedCols[i]["name"]}) in table (${deletedCols[i]["table"]})\n`
-                        }
+                        msg += groupBySchema(deletedCols, (c) => schemaOfTable(c["table"]), (c) =>
+                            `- column (${c["name"]}) in table (${c["table"]})\n`
+                        )
                     }
                     if (newCols.length > 0) {
                         msg += "\nThere are some new columns in existing tables:\n"
-                        for (let i = 0; i < newCols.length; i++) {
-                            msg += `- new column (${newCols[i]["name"]}) in table (${newCols[i]["table"]})\n`
-                        }
+                        msg += groupBySchema(newCols, (c) => schemaOfTable(c["table"]), (c) =>
+                            `- new column (${c["name"]}) in table (${c["table"]})\n`
+                        )
+                    }
+                    if (newDescriptions.length > 0) {
+                        msg += "\nSome comments of the database will be imported as descriptions:\n"
+                        msg += groupBySchema(newDescriptions, (c) => schemaOfTable(c["table"]), (c) =>
+                            (c["column"] ? `- column (${c["column"]}) in table (${c["table"]})` : `- table (${c["table"]})`) +
+                            `: ${c["comment"]}\n`
+                        )
+                    }
+                    if (descriptionConflicts.length > 0) {
+                        msg += "\nSome descriptions do not match the comments of the database, the descriptions will be kept:\n"
+                        msg += groupBySchema(descriptionConflicts, (c) => schemaOfTable(c["table"]), (c) =>
+                            (c["column"] ? `- column (${c["column"]}) in table (${c["table"]})` : `- table (${c["table"]})`) +
+                            `:\n  description: ${c["description"]}\n  comment: ${c["comment"]}\n`
+                        )
+                    }
+                    if (newRoutines.length > 0) {
+                        msg += "\nThere are new functions, procedures or triggers:\n"
+                        msg += groupBySchema(newRoutines, (r) => r["schema"], (r) => `- ${r["kind"]} (${r["name"]})\n`)
+                    }
+                    if (deletedRoutines.length > 0) {
+                        msg += "\nSome functions, procedures or triggers have been deleted:\n"
+                        msg += groupBySchema(deletedRoutines, (r) => r["schema"], (r) => `- ${r["kind"]} (${r["name"]})\n`)
+                    }
+                    if (routineChanges.length > 0) {
+                        msg += "\nThere has been some changes in existing functions, procedures or triggers:\n"
+                        msg += groupBySchema(routineChanges, (c) => c["metadata"]["schema"], (c) =>
+                            `- ${c["metadata"]["kind"]} (${c["metadata"]["name"]}) suffered the following changes:\n${c["changes_message"]}\n`
+                        )
+                    }
+                    if (newTypes.length > 0) {
+                        msg += "\nThere are new user defined types:\n"
+                        msg += groupBySchema(newTypes, (t) => t["schema"], (t) => `- ${t["kind"]} (${t["name"]})\n`)
+                    }
+                    if (deletedTypes.length > 0) {
+                        msg += "\nSome user defined types have been deleted:\n"
+                        msg += groupBySchema(deletedTypes, (t) => t["schema"], (t) => `- ${t["kind"]} (${t["name"]})\n`)
+                    }
+                    if (typeChanges.length > 0) {
+                        msg += "\nThere has been some changes in existing user defined types:\n"
+                        msg += groupBySchema(typeChanges, (c) => c["metadata"]["schema"], (c) =>
+                            `- ${c["metadata"]["kind"]} (${c["metadata"]["name"]}) suffered the following changes:\n${c["changes_message"]}\n`
+                        )
                     }
 
                     let yes = confirm(msg);
@@ -121,14 +239,49 @@ class DatabaseInfo extends React.Component {
         });
     };
 
+    profileDatabase = () => {
+        let schema = window.location.protocol;
+        let host = window.location.host;
+        let endpoint = schema + "//" + host + "/profile";
+
+        // Let's start the profiling indicator...
+        this.setState({profileIndicator: true})
+
+        fetch(endpoint, {
+            method: "POST",
+        }).then(res => {
+            this.setState({profileIndicator: false})
+            if (res.status === 200) {
+                res.json().then((report) => {
+                    let msg = report["profiled"].length + " column(s) profiled."
+                    if (report["skipped"].length > 0) {
+                        msg += "\n" + report["skipped"].length + " column(s) left for the next run because the time budget ran out: " + report["skipped"].join(", ")
+                    }
+                    alert(msg)
+                    window.location.href = "/"
+                })
+                return
+            }
+            res.text().then((text) => {
+                alert("An error occurred while profiling the tables: \n" + text);
+            })
+        }).catch(function (error) {
+            console.log(error);
+            this.setState({profileIndicator: false})
+        });
+    }
+
     render() {
         let showSyncIndicator = this.state.syncIndicator;
         let showCheckIndicator = this.state.checkIndicator;
+        let showProfileIndicator = this.state.profileIndicator;
         let indicator;
         if (showSyncIndicator) {
             indicator = <SyncIndicator text={"Syncing database, please wait"}/>
         } else if (showCheckIndicator) {
             indicator = <SyncIndicator text={"Checking database changes, please wait"}/>
+        } else if (showProfileIndicator) {
+            indicator = <SyncIndicator text={"Profiling tables, please wait"}/>
         } else {
             indicator = null;
         }
@@ -142,6 +295,13 @@ class DatabaseInfo extends React.Component {
                 >
                     Sync
                 </button>
+                <button
+                    style={{width: 60, cursor: "pointer", marginBottom: 20, marginLeft: 10}}
+                    type="button"
+                    onClick={this.profileDatabase}
+                >
+                    Profile
+                </button>
                 <p style={styles.p}><strong>Database name: </strong>{this.state.info["name"]}</p>
                 <p style={styles.p}><strong>Database user: </strong>{this.state.info["user"]}</p>
                 <p style={styles.p}><strong>Database host: </strong>{this.state.info["host"]}</p>
@@ -213,38 +373,23 @@ class TablesData extends React.Component {
     componentDidMount() {
         let tables = data["Tables"];
         let columns = data["Columns"];
+        let stats = data["Stats"] || [];
+
+        // tables are grouped by schema, so we sort them by schema first and then by name.
+        tables.sort((a, b) => (a["schema"] || "").localeCompare(b["schema"] || "") || a["name"].localeCompare(b["name"]))
 
         for (let j = 0; j < tables.length; j++) {
             let cols = []
 
             for (let i = 0; i < columns.length; i++) {
-                if (columns[i]["table_name"] === tables[j]["name"]) {
+                if (columns[i]["table_name"] === tables[j]["id"]) {
                     cols.push(columns[i])
                 }
             }
 
-            // we need to sort the cols accordingly, the rule is that
-            // the PK will go in the first place and FKs will follow,
-            // the rest of the columns will go afterwards.
-            let pkIdx = false
-            let fksIdx = []
-            for (let w = 0; w < cols.length; w++) {
-                if (cols[w]["is_primary_key"] === true) {
-                    pkIdx = w
-                } else if (cols[w]["is_foreign_key"] === true) {
-                    fksIdx.push(w)
-                }
-            }
-
-            if (typeof pkIdx === "number") {
-                let pkObj = cols.splice(pkIdx, 1)[0]
-                cols.splice(0, 0, pkObj)
-            }
-
-            for (let z = 0; z < fksIdx.length; z++) {
-                let fkObj = cols.splice(fksIdx[z], 1)[0]
-                cols.splice(1, 0, fkObj)
-            }
+            // the columns are shown in their order in the table. The columns stored by previous versions of
+            // godic do not have a position, so they go last.
+            cols.sort((a, b) => (a["position"] || Infinity) - (b["position"] || Infinity) || a["name"].localeCompare(b["name"]))
 
             // finally we loop again through the table columns and check if any has a ENUM type.
             // If so, we need to create a custom description for that column.
@@ -255,6 +400,7 @@ class TablesData extends React.Component {
             }
 
             tables[j]["columns"] = cols
+            tables[j]["stats"] = (stats.find((s) => s["table"] === tables[j]["id"]) || {"samples": []})["samples"]
         }
 
         this.setState({tables:tables})
@@ -303,6 +449,34 @@ class TablesData extends React.Component {
         });
     }
 
+    updateTableProfiling = (e) => {
+        let tableIdx = e.target.getAttribute("data-table-idx");
+        let profiling = e.target.checked;
+        let schema = window.location.protocol;
+        let host = window.location.host;
+        let endpoint = schema + "//" + host + "/update-profiling";
+        let tables = this.state.tables;
+
+        fetch(endpoint, {
+            method: "POST",
+            body: JSON.stringify({
+                table_id: tables[tableIdx]["id"],
+                profiling: profiling
+            })
+        }).then(res => {
+            if (res.status === 200) {
+                tables[tableIdx]["profiling"] = profiling;
+                this.setState({tables});
+            } else {
+                res.text().then((text) => {
+                    alert("An error occurred: " + text);
+                })
+            }
+        }).catch(function (error) {
+            console.log(error);
+        });
+    }
+
     onChangeTableDesc = (e) => {
         let tableIdx = e.target.getAttribute("data-table-idx");
         let tables = this.state.tables;
@@ -319,17 +493,33 @@ class TablesData extends React.Component {
     }
 
     rendeTables() {
-        return this.state.tables.map((table, i) =>
+        let tables = this.state.tables;
+        return tables.map((table, i) =>
             <Table
                 key={i}
                 tableIdx={i}
+                schemaHeader={table["schema"] && (i === 0 || tables[i-1]["schema"] !== table["schema"]) ? table["schema"] : ""}
                 tableName={table["name"]}
                 tableID={table["id"]}
+                tableKind={table["kind"] || "table"}
+                tableDefinition={table["definition"]}
                 tableDescription={table["description"]}
+                tablePrimaryKey={table["primary_key"]}
+                tableForeignKeys={table["foreign_keys"] || []}
+                tableIndexes={table["indexes"] || []}
+                tableChecks={table["checks"] || []}
+                tablePartitionKey={table["partition_key"]}
+                tablePartitions={table["partitions"] || []}
+                tableInherits={table["inherits"] || []}
+                tableCharset={table["charset"]}
+                tableCollation={table["collation"]}
                 tableColumns={table["columns"]}
+                tableStats={table["stats"]}
+                tableProfiling={table["profiling"] || false}
                 onChangeColumnDesc={this.onChangeColumnDesc}
                 onChangeTableDesc={this.onChangeTableDesc}
                 onClickSave={this.updateTableDictionary}
+                onChangeProfiling={this.updateTableProfiling}
             />
         )
     }
@@ -338,14 +528,393 @@ class TablesData extends React.Component {
         return (
             <div>
                 {this.rendeTables()}
+                <RoutinesData/>
+                <TypesData/>
+                <SequencesData/>
                 <TopBtn/>
             </div>
         );
     }
 }
 
+class RoutinesData extends React.Component {
+    constructor(props) {
+        super(props);
+        this.state = {
+            routines: [],
+        };
+        this.onChangeRoutineDesc = this.onChangeRoutineDesc.bind(this);
+    }
+
+    componentDidMount() {
+        let routines = data["Routines"] || [];
+
+        // routines are grouped by schema like the tables, and the triggers go after the functions and procedures.
+        routines.sort((a, b) => (a["schema"] || "").localeCompare(b["schema"] || "") ||
+            (a["kind"] === "trigger") - (b["kind"] === "trigger") || a["name"].localeCompare(b["name"]))
+
+        this.setState({routines: routines})
+    }
+
+    updateRoutineDictionary = (e) => {
+        let routineIdx = e.target.getAttribute("data-routine-idx");
+        let schema = window.location.protocol;
+        let host = window.location.host;
+        let endpoint = schema + "//" + host + "/update-routine";
+        let routine = this.state.routines[routineIdx];
+
+        let yes = confirm("Are you sure you want to update the dictionary of " + routine["kind"] + " " + routine["name"] + "?")
+        if (!yes) {
+            return
+        }
+
+        fetch(endpoint, {
+            method: "POST",
+            body: JSON.stringify({
+                routine_id: routine["id"],
+                description: routine["description"]
+            })
+        }).then(res => {
+            if (res.status === 200) {
+                alert(routine["kind"] + " " + routine["name"] + " has been updated successfully.")
+            } else {
+                res.text().then((text) => {
+                    alert("An error occurred: " + text);
+                })
+            }
+        }).catch(function (error) {
+            console.log(error);
+        });
+    }
+
+    onChangeRoutineDesc = (e) => {
+        let routineIdx = e.target.getAttribute("data-routine-idx");
+        let routines = this.state.routines;
+        routines[routineIdx]["description"] = e.target.value;
+        this.setState({routines});
+    }
+
+    render() {
+        return this.state.routines.map((routine, i) => {
+            let kindLabel = routine["kind"].charAt(0).toUpperCase() + routine["kind"].slice(1)
+            let name = (routine["schema"] ? routine["schema"] + "." : "") + routine["name"]
+            if (routine["kind"] !== "trigger") {
+                name += "(" + routine["arguments"] + ")"
+            }
+            return (
+                <div key={i} style={{marginTop: 50}}>
+                    <p style={styles.p}><strong>{kindLabel}: </strong>{name}</p>
+                    <p style={styles.p}><strong>Description:</strong></p>
+                    <div style={{display: "flex"}}>
+                        <textarea
+                            data-routine-idx={i}
+                            onChange={this.onChangeRoutineDesc}
+                            rows="4"
+                            cols="80"
+                            value={routine["description"]}
+                        />
+                        <button
+                            style={{width: 60, cursor: "pointer"}}
+                            type="button"
+                            data-routine-idx={i}
+                            onClick={this.updateRoutineDictionary}
+                        >
+                            save
+                        </button>
+                    </div>
+                    {routine["return_type"] ? <p style={styles.p}><strong>Returns: </strong>{routine["return_type"]}</p> : null}
+                    {routine["table"] ? <p style={styles.p}><strong>Fires: </strong>{routine["event"]} on {routine["table"]}</p> : null}
+                    <p style={styles.p}><strong>Language: </strong>{routine["language"]}</p>
+                </div>
+            )
+        })
+    }
+}
+
+class TypesData extends React.Component {
+    constructor(props) {
+        super(props);
+        this.state = {
+            types: [],
+        };
+        this.onChangeTypeDesc = this.onChangeTypeDesc.bind(this);
+    }
+
+    componentDidMount() {
+        let types = data["Types"] || [];
+
+        // types are grouped by schema like the tables.
+        types.sort((a, b) => a["schema"].localeCompare(b["schema"]) || a["name"].localeCompare(b["name"]))
+
+        this.setState({types: types})
+    }
+
+    updateTypeDictionary = (e) => {
+        let typeIdx = e.target.getAttribute("data-type-idx");
+        let schema = window.location.protocol;
+        let host = window.location.host;
+        let endpoint = schema + "//" + host + "/update-type";
+        let type = this.state.types[typeIdx];
+
+        let yes = confirm("Are you sure you want to update the dictionary of " + type["kind"] + " type " + type["name"] + "?")
+        if (!yes) {
+            return
+        }
+
+        fetch(endpoint, {
+            method: "POST",
+            body: JSON.stringify({
+                type_id: type["id"],
+                description: type["description"]
+            })
+        }).then(res => {
+            if (res.status === 200) {
+                alert(type["kind"] + " type " + type["name"] + " has been updated successfully.")
+            } else {
+                res.text().then((text) => {
+                    alert("An error occurred: " + text);
+                })
+            }
+        }).catch(function (error) {
+            console.log(error);
+        });
+    }
+
+    onChangeTypeDesc = (e) => {
+        let typeIdx = e.target.getAttribute("data-type-idx");
+        let types = this.state.types;
+        types[typeIdx]["description"] = e.target.value;
+        this.setState({types});
+    }
+
+    render() {
+        return this.state.types.map((type, i) => {
+            let kindLabel = type["kind"].charAt(0).toUpperCase() + type["kind"].slice(1)
+            let definition = ""
+            if (type["kind"] === "enum") {
+                definition = (type["values"] || []).join(", ")
+            } else if (type["kind"] === "composite") {
+                definition = (type["attributes"] || []).map((a) => a["name"] + " " + a["type"]).join(", ")
+            } else {
+                definition = [
+                    type["base_type"],
+                    type["not_null"] ? "NOT NULL" : "",
+                    type["default"] ? "DEFAULT " + type["default"] : "",
+                ].concat((type["checks"] || []).map((c) => c["name"] + " CHECK (" + c["clause"] + ")")).filter(Boolean).join(", ")
+            }
+            return (
+                <div key={i} style={{marginTop: 50}}>
+                    <p style={styles.p}><strong>{kindLabel} type: </strong>{type["schema"] + "." + type["name"]}</p>
+                    <p style={styles.p}><strong>Description:</strong></p>
+                    <div style={{display: "flex"}}>
+                        <textarea
+                            data-type-idx={i}
+                            onChange={this.onChangeTypeDesc}
+                            rows="4"
+                            cols="80"
+                            value={type["description"]}
+                        />
+                        <button
+                            style={{width: 60, cursor: "pointer"}}
+                            type="button"
+                            data-type-idx={i}
+                            onClick={this.updateTypeDictionary}
+                        >
+                            save
+                        </button>
+                    </div>
+                    <p style={styles.p}><strong>Definition: </strong>{definition}</p>
+                </div>
+            )
+        })
+    }
+}
+
+class SequencesData extends React.Component {
+    constructor(props) {
+        super(props);
+        this.state = {
+            sequences: [],
+        };
+    }
+
+    // sequences are read live from the database since their current values change all the time.
+    componentDidMount() {
+        let schema = window.location.protocol;
+        let host = window.location.host;
+        let endpoint = schema + "//" + host + "/sequences";
+
+        fetch(endpoint, {
+            method: "GET"
+        }).then(res => {
+            if (res.status === 200) {
+                res.json().then((data) => {
+                    this.setState({sequences: data["sequences"]})
+                })
+            }
+        }).catch(function (error) {
+            console.log(error);
+        });
+    }
+
+    render() {
+        return this.state.sequences.map((seq, i) => {
+            let kindLabel = seq["kind"] === "auto_increment" ? "Auto increment" : "Sequence"
+            let usageStyle = seq["warning"] ? {margin: 0, color: "red", fontWeight: "bold"} : styles.p
+            return (
+                <div key={i} style={{marginTop: 50}}>
+                    <p style={styles.p}><strong>{kindLabel}: </strong>{seq["id"]} ({seq["data_type"]})</p>
+                    {seq["table"] ? <p style={styles.p}><strong>Feeds: </strong>{seq["table"]}.{seq["column"]}</p> : null}
+                    <p style={styles.p}><strong>Increment: </strong>{seq["increment"]}</p>
+                    <p style={usageStyle}>
+                        <strong>Current value: </strong>{seq["current_value"]} of {seq["max_value"]} ({seq["usage"]}% used)
+                    </p>
+                </div>
+            )
+        })
+    }
+}
+
 class Table extends React.Component{
 
+    renderKeys = () => {
+        let keys = [];
+        let pk = this.props.tablePrimaryKey;
+        if (pk) {
+            keys.push(
+                <p key="pk" style={styles.p}>
+                    <strong>Primary key: </strong>{pk["name"]} ({pk["columns"].join(", ")})
+                </p>
+            )
+        }
+        this.props.tableForeignKeys.forEach((fk, i) => {
+            keys.push(
+                <p key={"fk" + i} style={styles.p}>
+                    <strong>Foreign key: </strong>{fk["name"]} ({fk["columns"].join(", ")}) references {fk["target_table"]} ({(fk["target_columns"] || []).join(", ")}) ON DELETE {fk["delete_rule"]} ON UPDATE {fk["update_rule"]}
+                </p>
+            )
+        })
+        this.props.tableIndexes.forEach((idx, i) => {
+            keys.push(
+                <p key={"idx" + i} style={styles.p}>
+                    <strong>{idx["unique"] ? "Unique index: " : "Index: "}</strong>{idx["name"]} using {idx["method"]} ({idx["keys"].map(k => k["column"] || "(" + k["expression"] + ")").join(", ")}){idx["predicate"] ? " WHERE " + idx["predicate"] : ""}
+                </p>
+            )
+        })
+        this.props.tableChecks.forEach((chk, i) => {
+            keys.push(
+                <p key={"chk" + i} style={styles.p}>
+                    <strong>Check: </strong>{chk["name"]} CHECK ({chk["clause"]})
+                </p>
+            )
+        })
+        if (this.props.tableCollation) {
+            keys.push(
+                <p key="collation" style={styles.p}>
+                    <strong>Character set: </strong>{this.props.tableCharset}, <strong>collation: </strong>{this.props.tableCollation}
+                </p>
+            )
+        }
+        if (this.props.tableInherits.length > 0) {
+            keys.push(
+                <p key="inherits" style={styles.p}>
+                    <strong>Inherits from: </strong>{this.props.tableInherits.join(", ")}
+                </p>
+            )
+        }
+        // the partitions are listed within their table, they are not documented as tables themselves.
+        if (this.props.tablePartitionKey) {
+            keys.push(
+                <p key="partition-key" style={styles.p}>
+                    <strong>Partitioned by: </strong>{this.props.tablePartitionKey}
+                </p>
+            )
+        }
+        this.props.tablePartitions.forEach((part, i) => {
+            keys.push(
+                <p key={"part" + i} style={styles.p}>
+                    <strong>Partition: </strong>{part["name"]} {part["bound"]}
+                </p>
+            )
+        })
+        return keys
+    }
+
+    // renderStats shows the last statistics of the table and their growth since the first sync, followed by the
+    // statistics taken on every sync.
+    renderStats = () => {
+        let samples = this.props.tableStats;
+        if (samples.length === 0) {
+            return null
+        }
+        let first = samples[0];
+        let last = samples[samples.length - 1];
+        let growth = (from, to, format) => {
+            let diff = to - from;
+            let percent = from > 0 ? " (" + (diff >= 0 ? "+" : "") + (diff * 100 / from).toFixed(1) + "%)" : "";
+            return (diff >= 0 ? "+" : "-") + format(Math.abs(diff)) + percent
+        }
+        let date = (time) => new Date(time).toLocaleString()
+        return (
+            <div>
+                <p style={styles.p}>
+                    <strong>Statistics: </strong>~{last["rows"]} rows, table size {formatBytes(last["table_size"])},
+                    indexes size {formatBytes(last["index_size"])} (synced on {date(last["time"])})
+                </p>
+                {last["last_vacuum"] || last["last_analyze"] ?
+                    <p style={styles.p}>
+                        <strong>Last vacuum: </strong>{last["last_vacuum"] ? date(last["last_vacuum"]) : "never"},
+                        <strong> last analyze: </strong>{last["last_analyze"] ? date(last["last_analyze"]) : "never"}
+                    </p> : null}
+                {samples.length > 1 ?
+                    <details>
+                        <summary>
+                            <strong>Growth since {date(first["time"])}: </strong>
+                            {growth(first["rows"], last["rows"], (n) => n)} rows,
+                            table size {growth(first["table_size"], last["table_size"], formatBytes)},
+                            indexes size {growth(first["index_size"], last["index_size"], formatBytes)}
+                        </summary>
+                        <table style={styles.table}>
+                            <thead>
+                            <tr>
+                                <th style={styles.table}>Synced on</th>
+                                <th style={styles.table}>Rows</th>
+                                <th style={styles.table}>Table size</th>
+                                <th style={styles.table}>Indexes size</th>
+                            </tr>
+                            </thead>
+                            <tbody>
+                            {samples.map((sample, i) =>
+                                <tr key={i}>
+                                    <td style={styles.table}>{date(sample["time"])}</td>
+                                    <td style={styles.table}>{sample["rows"]}</td>
+                                    <td style={styles.table}>{formatBytes(sample["table_size"])}</td>
+                                    <td style={styles.table}>{formatBytes(sample["index_size"])}</td>
+                                </tr>
+                            )}
+                            </tbody>
+                        </table>
+                    </details> : null}
+            </div>
+        )
+    }
+
+    // renderProfile shows the statistics of the sampled values of a column computed by the profiling job.
+    renderProfile = (profile) => {
+        if (!profile) {
+            return null
+        }
+        let values = (profile["top_values"] || []).map(v => v["value"] + " (" + v["count"] + ")").join(", ")
+        return (
+            <div title={"Profiled on " + new Date(profile["time"]).toLocaleString() + " from " + profile["sampled_rows"] + " sampled rows"}>
+                nulls {(profile["null_fraction"] * 100).toFixed(1)}%, {profile["distinct_count"]} distinct<br/>
+                min {profile["min"]}, max {profile["max"]}<br/>
+                {profile["avg_length"] ? <span>avg length {profile["avg_length"]}<br/></span> : null}
+                {values ? "top: " + values : null}
+            </div>
+        )
+    }
+
     renderColumns = () => {
         return this.props.tableColumns.map((col, i) => {
 
@@ -360,12 +929,39 @@ class Table extends React.Component{
             if (col["db_type"].toUpperCase() === "VARCHAR") {
                 dbType = dbType + "(" + col["length"] + ")"
              }
+            if (col["type_kind"] === "domain") {
+                dbType = col["user_type"] + " (domain over " + col["base_type"] + ")"
+                if (col["domain_checks"]) {
+                    dbType += ", " + col["domain_checks"].join(", ")
+                }
+            } else if (col["type_kind"] === "composite") {
+                dbType = col["user_type"] + " (composite)"
+            } else if (col["type_kind"] === "range") {
+                dbType = (col["user_type"] || dbType) + " (range of " + col["base_type"] + ")"
+            } else if (col["type_kind"] === "array") {
+                dbType = col["base_type"] + "[]"
+            }
+            // the default collation of postgres is left out, it would be shown on every text column.
+            if (col["collation"] && col["collation"] !== "default") {
+                dbType += " (" + [col["charset"], col["collation"]].filter(Boolean).join(", ") + ")"
+            }
+            // the check constraints of the table are shown as well on the columns they reference.
+            this.props.tableChecks.filter((check) => (check["columns"] || []).includes(col["name"])).forEach((check) => {
+                dbType += ", CHECK (" + check["clause"] + ")"
+            })
 
 
             let nullable = col["nullable"] === true ? "YES" : "NO"
 
             let unique = col["is_unique"] === true ? "YES" : "NO"
 
+            let defaultValue = [
+                col["default"],
+                col["identity"] ? "identity (" + col["identity"] + ")" : "",
+                col["generation_expression"] ? "generated as " + col["generation_expression"] : "",
+                col["extra"],
+            ].filter(Boolean).join(", ")
+
             return(
                 <tr key={i}>
                     <td style={styles.table}>{key}</td>
@@ -373,6 +969,8 @@ class Table extends React.Component{
                     <td style={styles.table}>{dbType}</td>
                     <td style={styles.table}>{nullable}</td>
                     <td style={styles.table}>{unique}</td>
+                    <td style={styles.table}>{defaultValue}</td>
+                    <td style={styles.table}>{this.renderProfile(col["profile"])}</td>
                     <td
                         data-table={col["table_name"]}
                         data-column-id={col["id"]}
@@ -395,9 +993,11 @@ class Table extends React.Component{
     }
 
     render() {
+        let kindLabel = this.props.tableKind.charAt(0).toUpperCase() + this.props.tableKind.slice(1)
         return (
             <div style={{marginTop: 50}}>
-                <p style={styles.p}><strong>Table: </strong>{this.props.tableName}</p>
+                {this.props.schemaHeader ? <h2>Schema: {this.props.schemaHeader}</h2> : null}
+                <p style={styles.p}><strong>{kindLabel}: </strong>{this.props.tableName}</p>
                 <p style={styles.p}><strong>Description:</strong></p>
                 <div style={{display: "flex"}}>
                     <textarea
@@ -417,6 +1017,19 @@ class Table extends React.Component{
                         save
                     </button>
                 </div>
+                <p style={styles.p}>
+                    <label>
+                        <input
+                            type="checkbox"
+                            data-table-idx={this.props.tableIdx}
+                            checked={this.props.tableProfiling}
+                            onChange={this.props.onChangeProfiling}
+                        /> Profile the data of this {this.props.tableKind}
+                    </label>
+                </p>
+                {this.renderKeys()}
+                {this.renderStats()}
+                {this.props.tableDefinition ? <pre style={styles.p}>{this.props.tableDefinition}</pre> : null}
                 <table style={styles.table}>
                     <thead>
                     <tr>
@@ -425,6 +1038,8 @@ class Table extends React.Component{
                         <th style={styles.table}>Data Type</th>
                         <th style={styles.table}>Nullable</th>
                         <th style={styles.table}>Unique</th>
+                        <th style={styles.table}>Default</th>
+                        <th style={styles.table}>Profile</th>
                         <th style={styles.table}>Description</th>
                     </tr>
                     </thead>
@@ -79,7 +79,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _assetsAppJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x3c\xed\x72\xdb\x38\x92\xff\xfd\x14\x7d\xdc\xab\x8d\x54\x96\x29\x79\x76\xb2\x35\x2b\x4b\xde\x4a\x9c\xec\x5e\x2e\x93\x8f\x1a\x7b\x6e\xeb\xca\x71\x69\x29\xb2\x25\x22\xa2\x00\x2e\x00\x5a\x56\xbc\x7a\xf7\x2b\x00\x24\x05\x92\xa0\x2c\xc9\x99\xbd\x3b\xd6\x54\x4d\x0c\x74\x37\x1a\xdd\x8d\xfe\x02\xec\x17\x99\x40\x10\x92\x93\x50\xbe\xb8\x38\x39\x09\x19\x15\x12\x10\xc6\xf0\x0b\x06\xa1\xf4\x43\x8e\x81\xc4\xb7\x09\x2e\x91\x4a\x35\x9f\x04\x42\xc0\x9b\x40\x06\xd3\x40\xe0\x3b\x3a\x63\x80\x0f\x12\x69\x24\x72\x84\x2b\xb6\x4c\x19\x45\x2a\xe1\xf1\x04\x00\x40\x13\xe4\x59\x28\x19\xef\xa4\x9c\xa5\xa2\x9b\x4f\xa8\x4f\x64\x29\x16\xc3\x17\xe5\xa8\x8c\x89\xf0\x85\x0c\xa4\x62\x63\x0b\xac\x3e\x42\x67\x6c\x08\x51\x20\x83\x5b\xcf\x66\xc2\xbb\xeb\x55\xe0\xc4\x9a\x86\xef\x68\x44\xc2\x40\x32\x3e\x9c\x05\x89\xc0\x2a\x40\x18\x63\xb8\x68\x85\xd8\xd4\x99\x59\xd3\xb0\x58\x0e\xc6\xcd\x31\x7f\x4a\x68\xd4\x51\xc3\xf5\x6d\xe8\x75\x0a\xb0\xab\x38\xa0\x73\x14\x05\x05\xd7\x5c\x83\xd2\xe6\xe4\xa4\xd8\x90\xc5\x41\xa7\x0b\xe3\x4b\x4b\x36\x09\x4a\x10\x61\x8c\xcb\x00\xc6\xb0\x22\x34\x62\x2b\x3f\x61\x61\x20\x09\xa3\x7e\xca\x99\x64\x21\x4b\x2e\x2a\xe0\x31\x13\xd2\x01\xac\x86\xab\x80\x48\xa3\x94\x11\xaa\x80\xf3\x25\x4e\xc1\xeb\xf7\x3d\x38\x35\x34\xd4\x4f\x8a\xbd\xb3\x68\xea\x5d\x9c\x94\xa8\xfd\x3e\xfc\x8c\xf2\x85\x00\x21\x03\x2e\x41\xc6\xa8\x37\x41\xe8\x1c\x48\x21\x77\xdf\xf7\x6b\x82\x46\x79\xad\x14\xdf\x79\xac\x6a\x10\x24\xcf\x70\xd3\xdd\x52\x9f\xa1\x0c\xe3\x4e\xc1\x5a\xaf\x66\x26\x4b\x94\x31\x8b\x86\xe0\x7d\xfe\x74\x7d\xe3\x59\x7a\xed\xfa\x32\x46\xda\xe1\x4a\x09\x97\x35\xa4\xdd\x0c\x68\x0b\xd9\x74\xab\xd6\x38\x03\x45\x4a\xdb\x6a\x26\x60\x3c\x1e\xc3\x0f\x83\x41\xb7\x46\x57\x7d\x41\x82\x5c\x76\xbc\x9b\x18\xb5\xf1\x6a\x2d\xc6\x81\x80\x29\x22\xd5\x62\xc1\x08\x44\x16\x86\x28\xc4\x2c\x4b\x92\xb5\xef\x75\x1b\x34\x1a\x9a\xe2\x38\x83\x31\x78\x7d\xaf\x01\xca\x51\x66\x9c\x56\x86\x37\x27\x55\x00\xe1\x4b\x7c\x90\x9d\x5c\x20\x1d\xf5\x43\xb7\x29\x13\x8b\xf7\x57\x14\x90\x73\xc6\x81\x85\x61\xc6\x39\x46\x3d\x58\xb3\x8c\x6f\xf7\xb3\x24\xf3\x58\x02\x65\x12\xa6\x58\xec\x29\x64\xcb\x34\x41\x89\xc9\xda\x87\xcf\x09\x2a\x30\x9e\x51\x08\xe6\x01\xa1\xa5\x49\xc0\x2c\xa3\xa1\xda\xd2\x10\xbe\x50\x65\x56\x9a\x99\x8b\x2a\xfb\x5d\x5b\x89\x61\xa0\xb4\x5f\xa0\x41\x47\x33\x56\x97\xbb\xf2\x3c\x2c\x41\x3f\x61\xf3\x1c\xe0\xe2\x99\xfa\xfe\xd7\x4b\xa2\xce\xf7\xa6\xea\x15\x5a\xbc\xcb\xff\x35\xef\xa0\xd9\x3c\x0b\x0d\x7f\x4f\xf8\x08\x0d\xbb\x97\x87\xa8\xb9\xf0\x63\x5d\xc4\x5f\xdf\x1e\xeb\x21\xea\x0c\x3c\xcf\x45\x28\xa8\xaf\x82\xd1\xf2\x48\x2a\x73\x6a\x39\x92\x85\xf0\x29\xae\x6e\x82\x69\xa2\x95\x6e\x82\x22\xc5\xd5\x44\xea\x21\xef\xee\xa2\x15\x2f\x42\x65\x8a\x51\x0d\x37\x1f\xdd\x03\x3f\x64\x49\xb6\xa4\x5b\x83\x33\xf8\x66\x74\x52\xe8\xf9\xe9\xf5\xaf\x58\xd2\x5c\xdd\x50\xd9\x89\x4e\x71\x55\x41\x55\x9b\xb6\xd0\x9c\x78\x4a\x11\xa5\xb8\xfc\x04\xe9\x5c\xc6\x5a\x1d\x03\xf8\xfd\xef\x9d\x18\xea\xab\x08\x6a\x6f\xac\x8a\x78\x0e\x5d\x4b\xed\x6c\x6f\x9c\x5c\x12\x15\x78\x97\x71\x15\x5f\xee\xc1\xca\x4c\x22\x62\x28\xb4\xa3\x8a\x83\x7b\x84\x80\xae\x21\xd7\x9e\x0f\xef\x24\x10\x01\x59\x7a\x26\xd9\x59\x14\x48\x74\x85\xa4\xe2\x33\xf1\xc6\xad\xb0\x4d\xab\x1a\x25\x4b\x3f\x88\xb9\x0a\x61\xaf\x71\xc6\xf8\x36\x3f\x90\x76\x98\x14\x21\x67\x49\x02\x11\x5b\x51\x08\x68\x94\x3b\x88\x20\x49\x0a\x56\x21\x42\x89\xa1\xc4\x08\xa6\x6b\x98\xb3\x88\x84\x3d\xa5\xed\x35\xcb\x60\x15\x50\x09\x1e\x9c\xb6\x32\xee\x49\x06\x29\x67\x21\x62\x04\x2b\x22\xe3\xd2\x13\xc7\x9c\x51\xf2\x4d\x3b\x3d\x48\x39\x0a\x01\x9f\xde\xfb\xf0\xb7\x18\x29\xe0\x03\x11\x52\xb1\x99\x9b\x1c\x04\x1c\x21\x4b\x95\x8c\x22\xb3\x3e\xac\x48\x92\x00\xc7\x25\xbb\xc7\x27\x96\x8f\x51\x91\xbf\x27\x2c\x53\xfb\x10\x21\x27\xa9\x5a\x53\x80\x08\xee\x55\x60\x11\xcc\x26\x39\x63\x3c\x44\xbd\x33\xc9\xf2\x25\x8d\xd3\x34\x9c\xbc\xa8\xd2\xf0\xbf\xd0\x2f\xb4\x99\x1c\x14\xe2\x5f\x6a\xd9\x1b\x25\x9c\x7a\xde\x21\xe7\xe6\x72\xb7\x95\x29\xca\xa7\x63\xf0\xbe\xd0\x9b\x18\x39\x6a\x01\x51\x5c\x81\x71\x2c\x60\x6a\x8a\x68\xd8\xc6\x9c\xfa\x66\x8c\x43\x47\x71\x49\x60\x0c\x83\x0b\x20\x30\x82\x3a\x1b\x17\x40\x4e\x4f\x77\xf1\x61\xf3\x72\xa6\x14\xb1\x25\x71\x4b\xee\x54\x68\xda\xc5\x82\xdb\x70\xdd\xa3\x4a\x4a\x4e\x5f\xb1\xb7\xa4\xae\xd9\x12\x0b\x01\xe9\xc3\xa8\x53\xc3\x9c\xe6\xc1\xa2\x72\xf1\x72\x84\xb8\x2a\x64\x7e\x03\x91\x39\x1d\xe5\x81\xc6\xb5\x4d\xa3\x95\x04\x0b\x9f\x40\x9a\xe7\xf4\x60\x21\xba\xb8\x3b\x44\x88\x7f\x3f\xcb\x49\x40\xe7\xdf\x1f\x2b\xc4\x6e\xc9\xdd\xad\xb7\x44\x19\x28\x2f\xe7\xdd\xdd\x7a\x34\x58\xa2\x77\xb7\xe9\x2a\xbe\xb5\x11\x3c\x8d\xa2\xc1\x26\x25\xa2\xc8\x66\x33\xe4\x18\x69\x7f\x30\x63\x49\xc2\x56\x7a\xef\x06\x7b\xf8\x85\xba\xe8\xe5\xb3\x93\x25\x0a\x11\xcc\x15\xa1\x2f\xf4\xef\xdf\xfb\x40\xd8\x01\xea\xb0\xe3\x50\x38\xd8\xef\x76\x1e\x2c\x56\x8e\x56\xa4\x45\x4a\xcb\xd0\xa9\xba\x3a\x90\x9e\x50\x50\xdf\x53\xbe\xb5\xe0\x7f\x84\x53\xd6\x47\x46\x79\xe6\x42\xd2\xf6\xb1\x31\xce\xe8\x18\x2f\x7d\xbc\x98\xb7\xbc\x28\x29\xe6\xa4\xda\xc5\x6c\x03\x3c\x4b\xc4\xad\x41\x72\xad\xf3\xdb\x90\xd1\x19\xe1\xcb\xce\x52\xcc\xbb\xee\x2c\x47\xe9\x63\x8d\x62\xd7\x4e\x1b\x5d\xa3\x4e\x0b\xad\x26\x93\xb5\xa2\x62\x03\x98\x08\x6c\xa9\x21\xf6\x2d\xeb\x61\x47\x41\x3b\x84\x96\x32\xdc\xc5\xcc\xc1\x85\xf9\x11\x65\xd4\xae\x5a\xbe\xac\x89\xf3\x0c\x86\x23\x8d\x90\x77\xba\xf5\x02\x38\x66\xab\x6b\xbb\xc6\x2f\x1b\x79\x8a\x0d\xbf\x52\xfe\x5f\x34\x30\xaf\x2a\x4c\x56\x51\xab\x1b\xa8\xe2\x92\xe6\xb0\x32\x95\x06\x33\x75\x19\x11\x6b\xa9\x51\x95\x6d\xa5\x96\xf1\xa3\x77\x9d\x27\xcb\x45\xa2\xdc\x83\xd4\xb4\x15\x56\x01\x91\xde\xa6\x7f\x79\x52\x33\x97\x62\xdd\xea\x56\x0e\x5f\x58\xe3\xdb\x2b\x17\x41\xe6\x49\x0e\xda\x57\xa2\x59\x62\x35\x20\xb6\x26\x65\x0a\x0b\xe8\x54\x10\x47\x11\xb9\xbf\x6c\x98\xe5\x63\x49\xaf\x79\x7e\x46\xd3\x4c\x4a\x46\x9d\x67\x40\xc8\x75\x82\xe3\xc7\xc7\x15\x89\x64\x3c\x84\x3f\x0e\x7a\x10\x66\x5c\x28\x4b\xf4\x74\xe7\x00\xb9\xd7\x83\x65\xc0\xe7\x84\xbe\x66\x52\xb2\xe5\x10\x7e\x18\x6c\xdc\x9e\x44\xae\x53\x1c\x7b\x66\x35\xb7\xdb\x64\xf4\x2a\x21\xe1\x62\xfc\xd8\xda\x02\x6e\x52\x6e\x6e\x56\x7d\x4a\x39\xcd\x9d\xf6\xcd\xe2\x4d\x94\x51\x5a\x6c\x55\xff\x4f\xf8\xe9\xe6\x72\x24\x24\x67\x74\x7e\x59\xd6\x83\xca\xcb\x0e\x61\xd4\xcf\xc7\x1f\x2d\x2b\x27\x74\xc6\x4a\x37\x3c\xea\xa7\x47\xae\x90\x09\xe4\x3b\x57\x50\x00\xcf\x5a\x21\x66\x42\xee\x5c\x41\x01\x3c\x6b\x85\x88\x93\xfb\x27\x76\x61\x40\x9e\xb5\x8a\x69\xa7\xed\x5c\xc5\x80\x3c\x6b\x95\x94\xf1\xdd\xd2\x52\x00\xae\x15\x46\xfd\xca\x31\x2c\x1b\x93\x9b\xe2\x92\xa8\xea\x3c\x76\xdf\x12\x4d\x88\xf8\xc0\x32\xaa\x2a\xe8\xb1\x09\x00\xb9\x33\x3f\xe2\xfa\x48\xb7\x16\xf0\x41\x16\x4e\x5a\xcf\xeb\xb0\xd8\x76\xc5\xa4\xe6\x86\x1a\xa7\x7e\xf1\x63\x3c\xdb\x8d\x45\x6d\x3b\xd2\x76\x55\x13\x16\x7b\x7b\x43\x22\xbd\xa9\x4a\x28\xd2\x44\x2a\xdb\x95\x3c\xc3\xed\xb2\x02\xe5\x3b\xe5\x73\xee\x83\xa4\x53\x5b\xb0\x07\x2f\x07\x83\x7a\x03\xd8\xe2\xcf\xd5\xf6\x8d\x98\x9a\xf1\x7c\xaf\x5d\x3e\x46\xd9\x55\xf9\xe8\xd0\x15\x3d\x28\x10\xb5\x53\x42\x23\x7c\xf8\x34\xeb\x78\xbe\xd7\xad\x06\x32\x0d\x34\x1e\xc3\xd9\x79\x23\xca\xe3\x83\x3c\x1d\x47\x4c\xee\x8e\x02\x39\x93\xa2\x58\x4a\x24\x24\x44\x45\xb6\xa7\x7f\x34\x59\x64\x2d\x0b\xd1\xc5\x05\x93\x95\xb6\xd7\x39\xfc\xf3\x9f\x50\x1f\xfc\x01\x5c\x09\x59\x93\xb3\x56\xee\x0a\xf0\x92\xbb\x6c\x2a\x24\xef\x0c\x7a\x4a\x3a\xf5\x2b\x0a\x47\xfc\x52\x9c\xd6\x34\xfe\x44\x36\xa4\x6d\x51\x9b\x62\xb7\x46\xaf\x6e\x5e\x7f\x23\x49\xf2\x2b\x5d\xee\x61\x61\xf9\x81\xb2\x88\x38\x72\x24\x77\xa8\x8d\xcf\x1d\x91\xb6\x66\x36\x9b\x9a\x5f\xb0\x71\xba\x75\xaf\x60\x7a\x09\xca\xfd\xfc\x4b\x2f\x8e\xf3\x62\x06\x6e\xef\xda\x6f\x79\x59\x5e\x1b\x5f\xe9\x02\xe4\x0d\x8a\xb0\x38\x22\xcd\x99\xf6\x1b\xdf\x02\x56\x6f\xd4\x45\xa4\x9c\x38\xce\x81\xe8\xe3\x5b\x6d\xdc\xdf\x34\x1a\xf6\xdb\x26\xfd\x16\xea\xca\xd1\x21\x2f\x0b\xb8\xaf\xa6\x80\xfb\x0a\xa3\x9c\x78\x59\xbf\x7d\x6d\xd6\x6f\x39\x75\x45\xfa\xf6\xae\x5a\x3b\xb5\xf7\x51\x9e\xac\x08\xb7\x0d\x21\xab\xa0\xcb\x1b\x1c\xfa\x34\x1b\xc6\x6e\xbf\x96\xd5\x60\x5b\xb5\xa5\x78\xf3\xd3\x4c\xc4\x16\xbd\x66\xf3\x7a\x53\x3b\xbd\x95\x1f\xfb\x7d\x58\xa9\xd2\x18\x23\x90\x0c\x04\x2b\x6e\xa7\xd4\xae\x83\x30\x64\x3c\x22\x74\x9e\xac\x7b\x7a\x94\x67\x09\x02\x11\x20\xe3\x40\xd6\xa9\xa8\xf9\xcf\xef\x4d\xfb\x76\xce\x20\xbf\xec\x9b\x11\x2e\x24\xa4\x49\x10\xa2\x6e\x6a\xff\xe5\xbd\x28\x3a\xbc\x49\xc2\x56\x3d\x17\x15\x8e\x42\x02\x9b\xd9\x0d\xdf\x92\x6c\x30\x93\xc8\x57\x01\x8f\x84\xdf\xd0\x54\xba\x78\xa7\x3d\xb9\xf6\x02\x8d\xd9\xd9\x42\x98\xe9\xdb\x3b\xb7\x22\x57\x46\x91\x2b\xa3\xc8\xad\x16\x57\x3b\xb5\x28\x6e\x57\x77\xb7\x1e\x11\x93\x94\x93\x65\xc0\xd7\x93\x05\xae\x0b\x35\xf2\x0c\xdb\x34\x57\xf0\xba\x6a\x6a\x6b\x5b\xc4\xd8\xe4\x67\x8c\x23\x99\xd3\x3d\xc9\x9b\xcd\x1a\xd3\x58\x1d\x68\x11\xda\x95\xaf\x53\x64\xb3\x82\xcb\xf1\x18\x3c\x9a\x2d\xa7\xc8\x3d\xd7\x82\x46\xf6\x9f\xa6\x5f\x75\x03\x21\x11\xbe\x48\x75\x6c\xd3\xd8\x3d\x38\xef\xde\x0e\xee\x1a\x48\x36\xe0\xa0\x07\x83\x9e\x21\x51\x2f\xb9\xdd\xaa\xfa\x66\x54\xf5\x0d\x46\xc5\x4e\x0b\x65\x7d\x73\x2b\xcb\x18\x40\x93\x45\x83\x7d\xfb\xed\x6e\x1f\x36\xcf\x35\x9b\xb3\x27\xd9\xec\xf7\x61\x46\x68\x90\x24\x6b\x75\xb4\x12\xc6\xd2\xf2\xf6\x9b\xb3\x6c\x6e\xee\x5e\x4c\x6b\xa7\xbc\x59\x29\xef\x7b\xc8\x4c\xdf\x4e\xc5\x81\x80\x00\xde\x7e\xfc\xf5\x83\x2e\xb5\xfc\xfa\x02\xef\x66\x20\x58\xcf\x3e\xba\xe6\xb2\x01\x02\x08\x33\x21\xd9\xd2\xbe\x20\xd1\x82\x53\x67\x36\x5f\xcf\x77\x4b\x75\x61\xa4\xba\xa8\x1f\x80\xc5\x13\x07\x60\x71\x77\xeb\xc5\x81\x98\x20\xcd\x96\xbb\x7d\x95\x06\x8d\xa6\x13\xb5\x25\x65\xc5\xe0\xa9\x1d\x76\x3c\x80\xd3\xed\xbc\x22\x33\xb9\x0f\x92\x4c\x79\x7a\xff\x2b\x23\xb4\xd3\x85\x53\xf0\xba\xcd\x3a\x73\xa7\x1d\x5b\x5e\xb4\xbc\x33\xcd\xd5\x7f\xe2\x40\xa9\xa7\x27\x26\x8e\x9a\xff\x6d\xba\x76\xd8\x32\xf7\x51\x26\xba\x11\xdd\xff\x09\xf8\x5a\xa5\xa5\xe8\xc8\x4b\x35\x01\x73\xd6\xd1\x97\x01\x9f\xa3\xf4\xe7\x28\x5f\x49\xc9\xc9\x34\x93\xd8\xf1\x54\xd0\x3a\xd3\x60\x67\x24\x7a\xf0\xea\x09\xbe\x9a\xf8\x18\x2c\x71\x2f\x02\x3a\x5c\xd4\x28\xfc\x6f\x3e\x88\x30\xa2\xaa\xe7\xe4\xda\xf4\xab\x49\xb9\xd1\x55\x21\xac\xd6\x00\xaf\x01\x2c\x7d\x3a\xe1\x74\xce\xa5\xfc\xbc\xeb\x01\x46\x20\x16\xfa\xfc\xa9\xea\x5b\x99\x70\x8c\x7d\xa1\x63\x8e\x39\xaf\x01\x95\xa2\x76\xe7\xa8\xc4\x0b\xd1\x56\xd1\x79\x64\xd2\xac\xf8\x95\xf5\xab\x3d\x54\xef\x15\x37\x57\x98\x22\xcb\xff\xa1\x6f\x69\x6b\xc4\xab\x74\xb5\x64\x74\x4b\xb2\x54\xfb\xa9\xf7\x67\xeb\x42\x5a\x1d\xba\x7f\x73\xf4\x5f\x6b\x0f\xa1\x36\x8e\xcc\xe7\xb0\x44\x25\x97\xa7\xca\x2f\xab\xa7\x2c\x64\x89\x56\xc0\x84\x44\xc5\x79\x2a\x33\x19\x35\x74\xd1\x84\xb6\x5c\x51\x03\xa5\x32\xd7\xc0\x2d\xd4\x59\x26\x39\x5d\xe7\x16\x8f\x7a\x23\xa7\xbe\x29\x8b\xd6\x43\xf8\xcf\xeb\x4f\x1f\x7d\x21\x39\xa1\x73\x32\x5b\x77\x1c\x95\x91\xce\xd0\x48\x34\x2c\x0c\x50\x6d\xb4\xd7\x02\x66\xed\xa8\x84\xaf\xec\xb2\x89\x98\xef\x74\xa2\x4c\x6d\x68\xef\xbb\xea\xdf\x2a\x4f\xc3\xda\x5f\xef\x1c\xfc\x5a\x6f\x6b\x76\xb6\xdd\x81\xb7\xbd\x72\x2c\x6e\xfd\x77\x3d\xdd\xfb\x7f\xde\xac\xdf\xab\xf3\x6e\x6c\xce\x55\xf9\xfc\x76\xce\x5f\xb8\x5c\xa5\x55\x8b\xd5\x5c\x67\xf3\xb8\x95\x4b\xeb\xa0\x5a\xaf\x22\x6b\x11\xaf\x65\xab\x95\x52\xf1\x37\xd9\x6b\xc8\x92\xa7\xb1\x43\x96\x7c\x3f\x39\x95\x51\xe4\xd6\xac\xfd\x9d\x45\xa7\x5b\x0f\xa6\x62\x75\xf5\x1f\x1a\xac\xfa\xcb\x20\xed\x74\xf4\xbf\x7b\x40\x94\x84\xab\xed\x06\x4d\xaa\x61\xf7\x0b\x5c\x8f\x1f\x49\xb3\x95\x5e\xec\xb3\x7d\x52\x9d\xf2\xf1\x63\xee\xa0\xf2\x76\x77\x0b\x99\x37\x25\x9c\x72\x7c\x2d\x50\x6f\xb6\xb2\x2b\xc1\x2b\xf2\x6c\xc1\xcb\xcb\xf5\x12\xa7\x54\x4b\x13\xbe\x69\x8d\xf9\xf5\x42\x73\xa2\x1d\xb9\x3c\xb5\x35\xdc\x72\xdc\x89\x9a\x90\x70\x71\x1d\xdc\x63\x8e\xe4\xcc\x01\xab\x88\xfd\x66\x73\xe8\xb0\x96\x94\xfb\xf6\x47\x2f\x5f\xb1\x2d\xc7\x35\xd0\x0d\x4b\x5f\x4b\xda\x3f\xb0\x91\xad\x09\xb6\x75\xab\x1e\x6d\xee\xaf\xca\x8c\xac\xde\x8b\xb5\xad\x3b\x6f\x48\x5b\x4a\x36\x36\x1e\xb2\x24\xb7\x70\x78\x3c\x69\x64\x1d\x0b\x54\xc9\xb4\xe7\x35\x62\x9a\xce\x25\xea\x95\xb6\x2b\xac\xe5\x14\x3e\xbf\xf7\x5c\x01\xca\x26\x55\xa9\xaa\x77\x90\xfa\x4b\x9d\x54\x93\xed\x68\x7a\xb3\x4e\xd1\x64\x37\x56\x91\xe3\xde\x45\x39\xed\x4b\xf6\x6b\x9a\x22\xbf\xd2\xd7\xe4\xa6\xd4\xfe\xaf\x57\xbf\x5c\xfd\xc7\xab\x5f\x9c\xb5\x76\xb9\x4a\xfe\x8f\x53\xf0\x3a\x9e\xa9\x9e\x6e\x3d\x93\xce\x79\x77\xae\x6a\x69\x73\xd2\x64\x99\x66\x49\x92\xe7\xe2\x1a\xbf\xf8\xd9\x6a\x30\xc0\x9f\xc1\xfb\xef\xb7\xd7\x1e\x0c\xc1\xfb\xf8\xc9\x6b\xd2\xc8\x28\xf9\x47\x56\x52\x20\x62\x62\x06\xf6\x25\x61\xcc\xa5\xd3\xb4\x60\xc9\x0b\xd7\xe6\xbe\x14\x1c\xc9\xa8\x76\xe9\xa3\xed\x6c\x73\xf9\xb8\xc0\xf5\x66\xd4\x97\xd1\xa1\x78\x46\x04\xc5\xb5\xdf\xe1\xf8\x46\x23\x47\xa1\x16\x82\x3f\x0a\xd9\x08\x7c\x37\xaa\x73\x5c\x7d\xdb\x98\x3c\x36\x02\xa8\xbc\xb6\xda\x8d\x66\x1c\xf5\x19\x89\x72\x54\x77\x6c\x28\x3e\x17\xf3\x4e\x60\xf7\x26\xcc\x46\xf0\x41\x06\x1c\x83\x56\x88\xea\x96\x54\xaa\x90\xfb\x6b\xcb\x15\xbd\x8b\x1e\xda\xb9\x84\xe3\x65\x02\x96\x5c\xf6\x15\x4a\x0d\xc7\x1d\xad\xed\xaf\x08\x56\x95\x6d\xed\x13\xfd\xec\x8f\xb3\x95\x18\x7b\x2f\xdb\x9f\x3b\x41\xde\xaf\x19\x7b\x2f\x07\xbb\xa1\x74\x5e\x94\x6f\xf5\x89\x60\x5f\x7c\xfd\x16\x2b\x75\xda\xef\xa8\x2f\x79\x75\xd4\x2e\x82\x8e\x0d\xab\xe5\x5b\x08\xf3\xda\xe1\x86\xa5\x43\x78\x39\xd8\x38\x5c\xcd\xae\xab\x65\x1d\x2e\x1b\x57\xca\x96\xa5\xa9\x0c\xeb\x88\x1b\x6b\xab\x7e\x2c\x69\xbb\xa9\xd8\x5b\x89\x88\x48\x93\x60\x3d\x04\x6f\x96\xe0\x83\xe7\xda\x0c\xec\x75\x86\x9e\x75\x7e\x76\xda\xe7\x8e\x0c\xab\x54\x99\x36\xcd\x1f\xdb\x8d\xce\x98\xe5\x4f\x3b\xcc\x32\x37\xc9\x3a\xdb\x96\x58\xdd\xcb\xb7\xd9\xe5\x8e\x27\x35\xb0\xe7\xb3\x9a\x96\x47\x34\xb0\xd7\x43\x1a\xa8\xea\x84\x9a\xb4\xdd\x65\x6a\xbf\x99\x4a\xad\x87\x3c\xa5\x46\x8b\x84\xf8\x50\x27\x2e\x82\xfb\x66\x15\x03\xbb\x5f\xf4\xf4\x9d\xa9\xf0\xc8\xf4\x2d\x9c\x51\xb1\xc5\xf6\x63\x0c\x5a\x83\x24\xdf\x15\x78\x62\xf7\x32\xef\x71\x3d\xea\xcb\xf8\x08\xcc\xb2\xb2\x3d\x12\x5f\x37\x39\x55\xc2\x71\x24\xfe\xc7\x3c\xe5\x38\x12\xfd\x57\x9d\x74\x1c\xcb\xfb\xf6\x28\xb6\x53\x68\x7a\x7e\x6b\x66\x97\x1e\xa7\x2c\x5a\xb7\x33\x65\xd5\x4f\x45\x15\xe3\xaa\xa0\xf2\x75\xdc\xb4\x46\x7d\xbd\x91\x43\xab\x2b\x5d\x93\x1d\xfc\x18\xe0\x19\x6f\x01\xa6\x92\x4e\xf2\xb0\x30\xd1\x1a\x18\x82\x47\x19\xc5\x7a\x13\x54\xd2\x09\x4b\x83\x90\xc8\xf5\x10\x06\xfe\x8f\xdb\x00\x5b\x5b\x25\x0e\x68\x94\xe0\xb5\xf9\x3d\xa5\x71\x73\xec\x80\x7b\xfe\x2d\xa3\xf9\x45\x43\x10\x45\x6f\xef\x91\xca\x9f\x89\x90\x48\x91\x77\x5e\x98\xdf\x87\x7a\xd1\x6b\xae\xd3\x42\xbd\xed\x9d\x48\xbe\x82\xf9\x35\xa5\x23\x17\xb1\x67\x1a\xaf\x14\x94\x95\x98\xa9\xcf\x4c\x3f\x43\x60\x61\xb6\x44\x2a\x7d\x35\xe1\x9b\x25\x6e\x58\x5a\xed\x59\x45\x2c\x74\xe2\x14\xff\xc8\xff\x14\x81\x0b\x5d\x95\x91\xd5\x35\x2f\xe1\x87\x81\x79\x17\x14\x56\x07\xbb\x3b\xdf\xe0\x38\x2d\x64\x9a\xb0\x70\xe1\x55\xba\xcd\x8e\xc6\xee\x3e\x94\x3e\x2a\x5b\x83\xb6\xf7\x3d\x8e\x84\xcd\xfc\x51\x06\xe3\x2c\xf4\xcd\x83\x75\x83\x53\xb8\x10\x96\x4e\xa6\x92\x36\x6c\xbd\x4c\x7f\xac\xbe\x5a\x83\xa7\xaa\xdd\xa7\x4c\x10\xd3\xa5\xf7\x66\xe4\x01\xa3\xc6\xdd\x40\xf1\x06\xb6\x3a\xce\xc9\x3c\x96\x43\xf8\x43\x6d\xf8\xdb\x3b\x1a\xe1\xc3\x10\xfe\xf4\xa7\xea\xf8\x8c\x51\x79\x4d\xbe\xe1\x10\xce\x7f\xaa\x2f\xc0\x23\xe4\xee\x33\xc9\x32\x99\x10\xda\x72\x60\x43\x96\xe8\xf4\x62\xce\x71\x5d\x9f\x6a\xbc\xe8\xad\x6e\x39\x88\x22\x42\xe7\x43\x38\x7f\xe9\xe2\xe5\x97\x20\x22\x99\x18\xc2\x8f\x35\x66\x0a\xe7\x50\x93\x6d\x3e\x6e\xfb\x8b\xa7\x73\x6f\x87\x3b\x7d\xfa\xd1\x72\x55\xf9\x2d\xbf\x29\x12\x8d\xbd\xe5\xfa\xb5\x7c\xea\x3d\x72\xde\xb1\xda\xe3\xc4\xc1\x18\x06\xd0\x92\xbb\x31\xfa\x81\x65\x02\x3f\xdd\x23\x2f\x48\xba\x8e\x84\xe5\x57\x7f\x82\x4d\xb7\xe5\xf7\x32\x0a\x62\x99\xdc\x93\xd6\x8f\x6e\x5a\xee\xb0\xf7\x57\x06\x52\xfd\x97\x3a\x02\x99\x2b\xe5\xaa\x47\x32\x2b\x90\xd5\xce\xa7\x9e\x49\x87\xd6\x51\x34\x05\xd5\x10\x06\x06\xc9\xd8\x91\x34\x85\xd2\x16\xaa\xb4\xfc\xf3\xf4\x01\x04\x4b\x48\x04\xd3\x24\x08\x17\x9e\x8d\xa5\x12\xc5\xd7\x92\x0e\x2b\xfe\xc1\x18\xfe\x2a\x26\xd2\x3e\x14\xa5\x59\x7b\xe7\x2f\xd3\x07\xf8\xc3\x0f\xe9\x83\x35\xab\x2a\x9e\x57\x09\x99\xab\x83\x1e\x62\xed\x54\x58\xc7\xf3\x8f\xdb\xd1\x1d\xc7\x68\x1a\x84\x8b\x39\x67\x19\x8d\xae\x72\x6e\x7e\x37\x18\xfc\x74\xf5\xfa\x95\x05\x63\xa4\xf0\x33\xce\x94\x9f\xd8\x0e\xd7\x0e\x75\x5d\xae\x11\x5b\x5e\x31\x2a\x03\x42\x91\xdb\x11\xe1\x1f\x19\xf2\xf5\x35\x26\xa8\xb3\x82\x17\xbf\x8b\xac\xbf\x07\xf3\xa2\x7b\xe1\xc0\xbe\x59\xb1\x5d\x04\x64\xf9\x34\x51\xa1\xeb\x44\xe4\xcd\xa7\x0f\x79\x52\xd4\xc1\x8e\xfd\x07\x67\xba\xbd\x0a\x65\x27\xfc\xf6\xa9\x63\x0d\xfa\x66\xc5\xba\x17\x27\xff\x13\x00\x00\xff\xff\x60\x81\x31\xc4\x7a\x47\x00\x00")
+var _assetsAppJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x7d\xfd\x73\xdb\x38\x92\xe8\xef\xfe\x2b\x7a\x79\xb7\x33\xe4\x8b\x2c\xd9\xb3\x93\xad\x3d\xd9\x4a\x2a\x71\x32\xbb\x79\x93\x49\x52\xb1\xe7\xb6\x5e\x39\x2e\x0f\x45\x42\x12\x62\x0a\xd0\x00\xa0\x6d\x8d\xa3\xf7\xb7\x5f\xe1\x8b\x04\x49\x90\xfa\xb0\xf3\x71\x7b\xe7\x9a\xca\xd8\x00\xba\xd1\x68\x74\x37\xba\x81\x06\xf8\x7d\xce\x11\x70\xc1\x70\x22\xbe\x3f\xda\xdb\x4b\x28\xe1\x02\x10\x8c\xe0\x3d\x8a\x13\xd1\x4f\x18\x8a\x05\x7a\x99\xa1\x39\x22\xe2\x68\x6f\x6f\x30\x00\x9e\xcc\xd0\x3c\x7e\x3b\x39\x8b\xc7\x19\x02\x86\x44\xce\x08\x07\x31\x43\xa6\x06\xe8\x44\xff\x25\x28\x43\x29\x08\xd5\xec\x06\x8b\x99\x2a\x9d\xe2\x6b\x44\x00\xa7\xfd\xbd\x49\x4e\x12\x81\x29\xa9\x22\x0c\x55\xfb\x57\x2f\x22\xb8\xdb\x03\x00\xc8\x90\xd0\x28\x38\x8c\x20\x8d\x45\x7c\x1e\xa8\x76\x3c\xb8\x38\x52\x0d\x26\x94\x41\x28\x5b\x61\x18\xc1\xc1\x11\x60\x38\x36\x00\xfd\x0c\x91\xa9\x98\x1d\x01\x7e\xf4\xc8\xa2\x93\x3f\x78\x02\xba\x17\x7e\x8e\x2f\xce\x03\x9c\x06\x17\x30\x1a\x8d\xa0\xd6\xb3\xfd\xd1\x23\x04\x07\x42\x13\x1c\x5c\xc0\xa7\x4f\x10\x04\x47\x45\xeb\xd5\x5e\xf9\xaf\x81\x92\xd5\x2b\xc5\xb6\x29\xa3\xf9\xe2\xf9\xf2\x54\xc1\x4a\xaa\xe7\xb1\xe0\x2e\x4b\x04\x9a\x73\xdd\x0a\xa5\x30\x5e\xca\x2a\xcc\x0c\x73\xfa\x70\x36\x43\xa6\x09\x9d\x28\x3e\x8c\x63\x8e\xb8\x44\x2c\x66\xb1\x80\x98\x21\x20\x54\x00\x43\xb1\x02\xd6\x60\xaa\x58\x77\x25\x50\xaa\x26\x81\xe6\x02\x62\xb2\xd4\x1d\x39\x93\x50\x21\x2f\x54\x3d\xf5\x8a\x99\xe9\x19\x24\xee\xa4\x28\x00\x39\x29\x77\xab\xa3\xa2\x50\x03\xc8\xd2\xf3\x8e\xe9\x51\xd8\xdb\x66\xa7\x44\x03\xa3\x82\x00\x4d\xd0\x39\xbe\x88\xea\x3c\x97\x93\xf9\xa7\xd0\x00\x60\x33\x0e\x1e\xd5\x27\x51\x17\x9f\xeb\x76\x17\x0e\x7d\xf6\xc7\x50\xde\x5f\xe4\x7c\x66\xd0\x45\xf5\x99\x6d\x22\xd2\xcd\x0b\xe2\x8e\x1c\x01\xb0\x08\x39\x65\x22\x8c\x8e\xf6\x0a\x1e\xcd\xf9\x14\x46\xc5\x18\x7c\xfc\xb1\xa0\x1d\xf2\x6b\x9a\x9c\xe3\x0b\xf8\xd3\x48\x62\xab\x0f\x58\xf6\xf2\x68\x04\x46\x56\x21\x0c\xe0\x11\x38\x40\x8f\x20\x88\x86\x1f\x48\xe0\x19\x61\x41\xd1\x47\x4d\xd1\x47\x38\xae\x8e\x5a\x22\xb8\x28\x88\xfb\x58\x25\xce\xe9\x5b\xcb\x4c\xd8\x84\x3d\xff\x78\x11\x75\x29\xcd\x9c\x4f\x8d\xd2\x68\x14\xcf\x97\x02\x71\x8f\xca\x70\xfc\x07\x02\x4c\x60\x2c\xeb\x7b\x80\xfa\xd3\x3e\x1c\x3e\xfe\xcb\x5f\x21\xe6\x70\xd8\x7f\x0c\x57\xcf\x1d\x01\x77\x50\x85\x12\xd0\x95\xe5\x9c\x60\xa1\x84\x36\x78\x1e\xf4\x20\xb8\x52\xff\xfe\xa2\xfe\xfd\xbb\xfa\xf7\xec\xb9\x35\x37\xe5\x54\xa9\x3f\x6f\x66\x38\x43\xa0\x10\xc2\x93\x11\x1c\x1e\xfc\xf0\x23\x7c\xf7\x9d\x9a\x45\x85\xd4\xb0\x09\xf6\xe1\xd0\xe5\x92\x6a\x3f\xd0\xed\x1d\x59\x7e\xf4\xe8\xa8\xc9\x8d\x10\x2b\xeb\x74\x00\x4f\x35\xd8\x50\xfd\xaf\x2f\xe8\x4f\xf8\x16\xa5\xe1\x61\x14\xc9\xd9\x04\x39\xc1\xaa\xcb\x73\x7c\x21\xb9\x97\x64\x31\xe7\xf0\xc2\x58\x8a\x57\x64\x42\x01\xdd\x0a\x44\x52\x6e\x4c\xfb\x09\x9d\x2f\x28\x41\x44\x18\xba\x94\xe9\x67\x79\x22\x28\x0b\x17\x8c\x2e\x78\x85\xe0\x7c\x81\x6c\x71\x49\xb0\x98\x61\xde\xe7\x22\x16\x08\x46\x35\x19\xc0\x64\x42\x87\xc6\x60\xbb\x44\x04\x17\xbd\xaa\xde\x2d\x49\xf2\x8a\xa4\x38\x89\x05\x65\xc3\x49\x9c\x71\x54\x6d\x90\xcc\x50\x72\xd5\xd9\x62\xc1\xe8\x04\x67\xa8\xb5\xcd\xaa\x4e\xf0\x92\x24\x96\x24\x18\x35\xcb\xfa\x63\x4c\xd2\x50\x16\xd7\x87\xaa\x68\xb1\xcd\x4e\x66\x31\x99\xaa\x65\xa9\xb5\xae\x1d\x93\xa1\xb9\x4e\x46\xad\xb8\x01\xbf\xd2\x66\xa4\x36\x82\x30\x82\xd1\x93\x36\x13\x7a\x83\x49\x4a\x6f\xfa\x19\x4d\x62\xa9\x07\xb2\x0b\x41\x13\x9a\x1d\x55\x9a\xcf\x28\x17\x9e\xc6\xb2\xb8\xda\x10\x91\x74\x41\x31\x11\x85\x71\x96\xc2\x37\x18\x48\xe9\x53\x38\xe4\x5f\x92\xbc\xfd\x74\x1c\x1c\xed\x15\xa0\x83\x01\xbc\x46\xe2\x7b\x0e\x5c\xc4\x4c\x68\xff\x60\x49\x12\x4c\xa6\x80\xed\xbc\xf5\xfb\xfd\xda\x44\x21\x71\x2a\x85\x2b\xbc\xab\x4a\x09\x08\x96\xa3\x55\x54\x62\x9f\x20\x91\xcc\x42\x4b\x5a\xaf\x6e\x8e\x90\x98\xd1\x74\x08\xc1\xbb\xb7\xa7\x67\x81\x23\x17\x51\x5f\xcc\x10\x09\x19\xe2\x55\xfe\xad\x27\x40\x49\xd8\x2a\xaa\x4a\xfc\x04\x24\x2a\xa5\x0f\x39\x57\x3a\xfb\xc3\xc1\x41\xdd\x36\xca\x9f\x38\x43\x4c\x84\x81\x5c\xd2\xed\x4a\x0e\xb3\x98\xc3\x18\x21\xa2\xd8\x82\x52\xe0\x79\x92\x20\xce\x27\x79\x96\x2d\xfb\x41\xd4\xc0\xd1\x98\x29\x86\x26\x30\x82\x60\x10\x34\x9a\x6a\x3b\x52\x29\x5e\xd5\x3c\x1c\xde\x17\xe8\x56\x84\x86\x21\xa1\xfc\x23\x6a\xf2\xc4\xa1\xfd\x19\x01\xc4\x18\x65\x40\x93\x24\x67\x0c\xa5\x3d\x58\xd2\x9c\x95\xe3\x99\xe3\xe9\x4c\x28\x97\x64\x8c\xec\x98\x12\x3a\x5f\x64\x48\xa0\x6c\xd9\x87\x77\x19\x92\xcd\x58\x4e\x20\x9e\xc6\x98\x14\x22\x01\xd6\x62\x0f\xe1\x03\x91\x62\xa5\x88\xa9\x2e\xd6\x0e\xe7\x57\x51\x3f\x89\xe5\xec\x5b\x30\x08\x15\x61\x75\xbe\x4b\xeb\x46\x33\xd4\xcf\xe8\xd4\x34\x38\xba\xe7\x7c\x7f\x79\x4e\xd4\xe9\x5e\x55\xad\x42\x8b\x75\xfa\xd6\xac\x83\x22\x73\x3f\xd1\xf4\xad\xb1\x11\xaa\xed\x46\x16\xa2\xb6\x4c\xec\x6a\x22\xfe\xfe\x72\x57\x0b\x51\x27\xe0\x7e\x26\x42\xb6\xfa\xc8\x29\x29\x54\x52\x8a\x53\x8b\x4a\x5a\xe6\x13\x74\x73\x56\x8d\x94\x08\xba\xb9\x14\x95\x68\xc9\x07\x97\x22\x29\x8a\x69\x0d\xd6\x94\x6e\x00\xaf\x5a\x94\xf2\xa6\xc1\x55\xe1\xa5\x9d\xe5\x0e\xe8\x84\x66\xf9\x9c\xd4\xc1\x75\xe9\x26\xf0\x86\xce\x13\x9a\x35\x69\xd7\x58\x3a\xc1\x09\xba\xa9\x80\x4a\x96\x6d\x06\xf6\x02\xf1\x84\xe1\x85\xd4\x84\x2a\x78\xea\x54\x74\x53\x5e\xb4\x3b\xa1\x64\x92\xe1\x44\xb8\x43\x28\x2a\x2f\x13\x5b\xbb\x86\xa2\xf7\x34\x17\x98\xd4\xe6\x9f\x99\xc2\x0d\x78\xd8\x80\xb7\x7c\xdc\x04\x87\x69\x53\x9f\x48\x53\xbc\xc9\x4c\x4a\xf9\x5d\x2e\xea\xe2\xbb\x5c\x74\x43\x59\xe9\xad\x40\x16\xc2\xbb\x0e\x5a\x36\x68\x88\xee\x72\x51\xa1\xd7\x0b\x2c\x55\xb9\x50\x38\xeb\xe5\x6b\x3f\xfd\xbb\xef\xbc\x10\xf2\xa7\xa2\x6a\x1b\x43\xb9\x0a\xb6\x31\x50\x45\xaf\xb6\x25\x50\xaa\xc4\xc6\x30\x46\x85\xb6\x69\xef\xea\xce\x16\xb4\x35\xf5\x65\x9b\x4e\xad\x78\x6f\xcb\x8c\xad\xe1\xaa\xaa\xb0\x0d\x89\x4a\x8a\xb7\x96\xa6\xad\x80\x1c\x89\xaf\xc0\x44\x70\xd7\x0a\x33\x18\x14\xde\x7a\x5c\x3a\x36\x76\x3b\xc9\xa8\x0a\x70\x81\xb3\x0c\x18\x4a\x28\x4b\xcd\x86\xa0\x88\x05\xe6\x02\x27\x6a\xd7\x0a\x0b\x6e\x77\xe6\x5a\x7b\xca\x90\x80\xa5\xd2\x45\x69\xf4\x30\x9b\x87\x45\xf4\x08\x29\x45\x5c\xf9\x51\xb3\xf8\x1a\xa9\x4d\x2c\xd3\x73\x1f\x5e\x09\xc0\x1c\xf2\xc5\xbe\xa0\xfb\x69\x2c\x50\xff\x03\x51\x2e\x53\x6b\x3f\xf2\x27\x78\x41\xa5\xab\x06\x37\x31\x11\x20\xa8\x1a\x22\x60\xb5\x3d\x76\x13\x2f\x65\x89\x1e\x8b\xf6\x44\xa4\x6f\x47\x44\x6d\x48\xb2\x46\x0f\xe9\xa9\xc7\x47\x77\x6d\xc5\x12\xf1\x2e\x0e\x7b\x83\xd3\x30\x3a\x6a\x05\x58\xb5\xd6\x68\x77\xff\x68\x6f\x73\x28\x65\x08\xe9\xe2\x17\xbd\x31\xf5\x1c\x4d\x28\x2b\xc3\x33\xe1\x46\x29\x3c\x61\x34\xcb\x20\xa5\x37\x04\x62\x92\x1a\xff\x2c\xce\xb2\x42\x08\x52\x24\x50\x22\xf4\x0e\xe6\x94\xa6\x38\xe9\xc9\xe1\x17\x6c\xee\x9a\x93\x40\x50\x19\xcb\x27\xc8\x6c\x55\x16\x8e\xf0\x8c\x51\x82\xff\x50\x3e\x27\x2c\x18\xe2\x1c\xde\xfe\xdc\x87\x7f\xce\x10\x01\x74\x2b\x27\x83\x4c\x8d\xb9\xe3\x10\x33\x04\xf9\x42\xca\x40\xaa\xfb\x87\x1b\x29\x95\x57\x08\x2d\xd6\x74\x3e\x43\xae\x85\xe1\xc0\xe3\x6b\xe9\xcc\x73\x29\x06\xd7\x18\xdd\x48\x72\xe6\x80\x09\x24\x92\x13\x62\x86\x96\x90\x52\x25\x8f\x73\x19\x80\x18\x7f\x55\x73\x21\x26\xcb\x39\x65\x46\x0c\x5b\x79\xae\x77\x02\x35\xe7\x1f\x05\xc1\x36\x2b\xcd\x93\x6e\x85\xb5\xbb\x7f\x1f\xc8\xd9\x0c\x31\xa4\xb7\x87\xe5\x10\x14\x12\xd0\xbb\xfa\xe9\xb0\x8d\x38\x07\x45\x75\x5f\xb8\xa0\xa3\x07\xa1\x8e\x10\x45\xb9\x1d\x5e\x94\x05\xfb\xa0\x02\xb7\xf3\x80\xc4\x73\x14\xa8\xdd\xc6\x0f\x24\x88\xb6\x10\x49\x39\x68\xef\x62\xb9\xf1\xc0\x4f\xe9\xdc\xea\xa6\x36\x18\x2a\xba\x36\x38\xb7\x1f\x79\x85\x98\x2f\x31\x7a\xdf\xa2\xbf\xe5\xac\x97\x7b\x0a\x74\x5e\xca\xa6\x89\x30\xaf\xd0\x52\x99\xb0\x42\x83\x34\xaf\xb6\xe7\x8c\x4b\x68\x0f\xc2\x44\x31\x21\x39\x0f\xe6\x48\xc4\xd2\x70\x04\x17\x15\x26\xa9\xfa\x4e\x3b\xf8\xdb\xbe\xa6\x05\xc2\x7f\xbf\xab\xe1\xd1\x1c\x5d\x45\xc0\xf3\xc9\x04\xa9\xe3\xa5\x19\x82\x09\xcd\x32\x7a\xa3\xac\x80\x26\x63\xf8\x81\x28\x50\xf3\xe7\xe5\x1c\x71\x1e\x4f\x25\xe4\x07\xf2\x5b\x6b\xdf\xdb\xce\x90\xd7\xc3\x7a\xa8\x29\xaa\x1b\xb6\xed\xe7\xa5\x42\x5e\x31\x31\xd5\xc3\xb6\x1a\x7b\x75\xd0\xa6\x99\x1c\x6d\x3a\x57\xba\x9f\xae\xc9\xc2\xa4\x75\x42\xdd\x1e\xbf\x91\x69\xf5\xb8\xc0\xdb\x19\x1d\x33\x63\x0f\x68\x75\x24\x2d\xed\x33\xa8\x78\xb8\xeb\x84\xb5\x4d\x92\x41\xba\x8a\x1e\x92\xb5\xb5\x48\x61\x87\x45\x4c\xa9\x89\x5c\xc9\x2c\x93\x5d\x55\xd9\xd5\x82\x19\xb2\x1e\x8e\xc3\x25\x81\x5f\x89\xcb\xbe\xf8\x6a\x5b\x21\x9e\xcb\x23\xff\xc2\xcb\x75\xbc\xfe\x2c\x83\x31\x02\x3c\x5f\x50\x26\x50\x0a\x31\xaf\xf8\x4d\x3b\xb1\xdf\xa5\xf7\x81\xa6\x41\x02\xe8\x39\x08\x2e\xe0\x69\x43\xee\x6d\x55\xc7\x9c\xfc\x06\xc3\xfa\x5a\x54\xd6\x45\x6b\x82\x8b\xdf\x86\x60\xfa\x51\x7c\x7c\x70\x1b\xd5\x1e\x0a\x6f\x37\xcf\xee\xd4\x79\xfc\xd9\x16\x29\xe8\x41\xc3\x5d\xb6\x72\x71\x85\x16\x62\x17\x43\xd7\x1c\xd0\xbf\x8a\x20\x7c\x20\x95\xbd\x0b\x23\x18\x4e\x89\x12\x0e\xb0\xcc\xfe\xac\x82\xe3\xd9\x06\xd9\x31\x94\xb0\x87\x13\xbc\xa7\x83\xb6\x34\x67\x88\x03\x65\x20\x18\x9e\x4e\x11\xdb\xcd\x10\x58\xe2\x7a\x10\x32\x35\xf7\xac\xe2\x3c\xea\xb2\xdf\xf6\xe1\xdf\xef\xd8\x79\x70\x85\x49\x1a\x5c\xac\xe4\x94\xb0\xd2\xc6\x7e\x20\xbf\xed\xb8\xe4\xef\xcc\x19\xa5\x49\x6b\x19\xf2\x80\x0e\xc1\xd7\x61\x93\x7f\x5f\xeb\xc1\x3d\xde\xcf\x20\x59\x55\xca\x1f\x2a\x4a\x69\x78\xb3\x0e\xa7\xbf\xe5\xc0\xa5\xbe\xd1\xb8\xa3\x05\xc8\x39\x62\x90\xa2\x09\x26\x72\x38\x12\xe1\x6e\x1b\x0a\x12\xb2\x33\xa2\x56\xac\x16\x15\xf6\x8a\x87\xd0\xf7\xdd\x98\xa0\x94\xbd\x39\xf6\x87\xdc\x64\xf8\xf2\x1c\xf1\xec\x0a\x3f\xb8\x5e\x3f\x84\xbc\x38\x74\xfe\x4f\xd1\xe2\xbd\xcd\xf6\xc9\xe7\x7c\x1a\x1d\xb5\xce\xef\x9a\xed\xe7\x8d\xb7\x9e\x9b\x12\x54\x3b\xe2\x5e\x01\xca\x38\x6a\x39\xd1\xde\x34\xc9\x04\x3a\xd2\x2b\x86\xd0\x92\x14\xe2\x23\x66\xeb\x34\x91\x1d\x0e\xf5\xbb\x32\x4b\x8a\x0c\x0d\xb3\xb7\xdb\x4c\xfb\xfa\xd6\xb2\x33\x0c\x85\x6b\xf2\x32\x74\xab\x4d\xb3\xb7\x1a\x09\x7a\x5f\x21\x81\xab\x49\xc3\x03\x27\x68\x30\x24\xe3\xe1\x35\x29\x1a\x7a\xc7\x5f\x37\x3d\x0f\x0c\x4d\x69\x60\xb3\x69\xe5\x0c\x98\xe8\x24\xe4\x91\x95\x96\xb4\x1f\xb4\xbb\x64\x06\x15\xbf\xc2\x8b\x85\x8b\x69\x63\xeb\x2d\x67\xbf\x15\x4b\x95\x9e\x0c\x4d\x84\x4a\x0f\x96\x22\x40\xd0\xad\x50\x49\x4b\x63\x94\xc4\xb9\x3e\x11\x01\x81\xe7\x08\xc6\x79\x3a\x45\x02\x58\x4c\x80\xe6\x62\x08\xfe\x1e\x3e\x52\x4c\x42\x99\x5b\xbb\xcd\x52\xa5\x8d\x82\x34\x75\xde\xea\x2d\x92\xe2\x56\xd1\xd7\xc9\x93\x33\x19\xc3\xa5\x02\x95\x47\x88\x5f\x35\xe1\x6d\xbd\x7e\xd4\xb2\xcd\x18\x22\x29\x62\x61\x23\x75\x7f\x46\x6f\x4e\xdd\xe4\xb9\x22\xc3\x56\xf6\xd3\xaf\xe4\xd5\x1d\x35\x20\x4f\x2a\xf6\xb6\x0a\x5a\xb5\xc5\x4d\xd8\x77\xb5\x11\x54\xa1\xeb\xe3\xab\xc2\xe3\x66\xb1\xca\xb2\xaf\x0f\x26\x6a\x64\x39\x97\x9d\x1d\x57\x5a\xaa\x59\x1c\xdd\x05\xa7\xe6\x18\xb5\xdc\x39\x59\xe8\x7c\xbf\x9b\x18\x8b\x60\x35\x78\xb2\x57\x5b\x39\x6d\xbf\x55\x56\x6c\xdf\xb1\x82\x77\x7b\xb6\xde\xc9\xc6\x14\xd4\x19\xba\x3d\x0d\xef\x4a\x29\x37\xe7\x66\x6b\xba\x6e\xef\x80\xe4\x59\xe6\xbb\x8f\x61\x93\xe4\x2b\x80\xc7\x29\xbe\x6e\xfa\x7d\x77\x05\xbe\xa6\x71\x39\x1e\xe7\x42\x50\xe2\xb5\x2a\x5c\x2c\x33\x34\xba\xbb\xbb\xc1\xa9\x98\x0d\xe1\xaf\x07\x3d\x48\x72\xc6\xa5\x8e\x04\x6a\xbd\x42\x2c\xe8\xc1\x3c\x66\x53\x4c\x9e\x53\x21\xe8\x7c\x08\x3f\x1c\xac\xfc\x16\x4c\x3a\xb0\xa3\x40\xf7\xe6\x37\xeb\x94\x9c\x64\x38\xb9\x1a\xdd\xb5\xa6\x95\x37\x31\xfb\x9d\x5c\x39\x27\xcd\x91\x0e\x74\xe7\x4f\xbe\x00\x0f\x6c\xc9\x6b\x34\x11\x43\x38\x7c\x28\x9e\xd4\xbc\xa8\x4d\xd9\x61\xe4\x79\x1b\x8e\x2c\xec\xc0\xd5\xff\x78\x7f\xb1\x7a\x72\xcc\x05\xa3\x64\xfa\xc4\xf6\x0e\x32\x28\x18\xc2\xf1\xc0\x94\xdf\x39\x46\x47\x5e\x82\x28\xa2\x86\xe3\xc1\x62\xc7\x1e\x64\xe0\xd4\xd9\x83\x6c\x70\xaf\x1e\xa4\xfb\xd7\xd9\x83\x6c\x70\xaf\x1e\x52\x86\xaf\xd7\x8c\x42\x37\xb9\x57\x2f\xda\xad\xed\xec\xc5\x86\x87\xf7\xe8\x45\xba\x32\x9d\x7d\xc8\x06\xbe\x1e\x8e\x07\x15\xc3\x54\x2c\xa8\xc5\x75\x9d\xaa\x15\xed\xbe\xaf\x73\x89\xf9\x2f\x34\x27\x02\xa5\x30\xd2\x8b\xb5\xf1\xd9\x77\xb8\xc8\x93\x21\xa1\x6c\xb6\x73\x0f\x65\xa1\x7d\x9d\xb6\xcb\x3e\xb2\x6e\xa8\x60\x56\x8d\x4b\x32\xd2\x48\x9d\x39\xd8\xca\x92\xb6\x0b\x2d\x89\x1d\xdb\x0b\x9c\xaa\x41\x55\xfc\x0a\x85\xa4\x32\x5c\x19\x3f\x94\xdd\x72\x24\x5e\x49\x0b\x74\x1d\x67\x61\xad\xc3\x1e\x3c\x3e\x38\xa8\xf5\xe5\xd2\xe7\x0b\xbf\x52\x2a\x6b\x82\x7e\xd0\xce\x1f\x3d\xd9\x55\xfe\xc8\x36\x38\xbd\x95\x4d\xe4\x48\x31\x49\xd1\xed\xdb\x49\x18\xf4\x83\xa8\xea\x55\xa8\x46\xa3\x11\xec\x1f\x36\xa2\x4f\x74\x2b\x1e\x8d\x52\x2a\xba\xd7\x45\x43\x24\xb7\x5d\xf1\x0c\x27\x48\xa2\xed\xa9\x3f\xb5\xef\x5e\x73\xf7\x64\xc7\x12\xc6\xcd\xf9\x3b\x94\x97\x29\xeb\x85\x3f\x80\x2f\x6e\x68\x52\xd6\x19\xec\x5b\x5e\x29\xea\xf2\x31\x17\x2c\x3c\xe8\x49\xee\xd4\xfd\x5a\xcf\x8a\x2e\x29\xad\xcd\xf8\x9a\x28\x5d\xc9\xa2\xfc\x67\xd5\xbc\x56\x58\x15\xaf\x7f\xe2\x2c\xfb\x95\xcc\x37\x90\x30\xa3\x50\xdd\x0e\xaf\xdf\xf9\x98\x1d\x7a\x7c\x8f\x9a\xd8\xac\x6a\x76\xc1\x85\x89\xea\x56\x41\x27\x1d\x49\xf3\xf3\x45\xaf\xf0\xd9\xa8\xe4\xfc\xa2\xfd\x2e\x1d\x35\x09\x26\x27\x2a\x48\x94\xc7\xb8\x30\x6a\xab\x69\xbf\x0d\x67\xdb\xaa\x81\xfa\x90\x14\x15\xbb\x19\x90\xb5\x97\xc8\xa1\x72\x19\xa1\x6c\x75\xd2\xbc\x09\x20\x9b\x49\x7e\x95\x8d\xa4\x14\x72\x7d\x1b\xfc\xfc\xa2\xba\x6f\x62\x3a\x8d\x19\x72\x6f\x76\xeb\x25\x48\xa5\x16\xde\x20\xe0\x54\x6f\xaa\xcc\xcb\x2a\x98\x60\xc6\x85\xca\xb0\x94\x81\xa6\xac\x90\x4e\x84\xb3\xc1\xa2\xf0\xea\xbb\xc5\x61\xdc\x83\xb1\x32\x63\x61\x5c\xbf\x9b\x1e\xa9\x58\x38\x43\x52\x50\x62\x86\xc2\x71\xa3\x81\xfc\x5f\x6c\x7d\x94\x66\x6b\x5d\x1e\x45\x7b\x9d\xd7\x83\x6b\xf7\xed\x3d\x57\x82\x0d\x73\xf5\xcd\xf0\xea\x96\xa6\xef\x06\xb4\x99\x87\xb6\x1b\xd0\xae\xa9\x30\x4d\xd5\xc5\x7c\x37\x9b\xa8\xbc\xd2\xcf\xcf\x3f\x9a\x6b\xfe\x6d\x3b\x22\x92\x32\x7d\x95\xbb\xc4\xd6\xdc\x1f\x58\xd5\x4c\x57\xe5\xcf\xc1\xc0\x1c\x98\x97\x69\xa8\x32\x86\x22\x26\xdd\x0e\x33\xa0\x2c\x45\xcc\xfc\xa9\x09\xd3\xb7\xfa\x2d\x88\x79\x2f\x61\xbc\x84\x85\x4c\x38\xa5\x39\x87\x6b\xc4\xb8\x3a\x5c\xa7\x93\x7a\x67\x3a\xb7\xd5\x1c\xd8\xeb\x84\x68\x58\x50\x8e\xe5\x8e\x80\x92\x2c\x95\xa0\x3a\xa5\x90\xc5\x5c\xf4\xf7\x1a\xa3\xf5\x88\x8e\x05\xd7\xb2\xf1\x8a\x4c\x30\xc1\x62\x19\xc1\x3e\x84\xe3\xf6\xda\x6d\xe5\xc7\xd0\x3f\xc1\x24\xce\xb2\xa5\x54\x80\x8c\xd2\x45\x71\xf5\x8d\xd1\x7c\x3a\x2b\x59\x54\x32\xb4\xc8\x36\xc6\x13\x95\xfb\x3d\x8b\x39\xc4\xf0\xf2\xcd\xaf\xbf\xa8\xf8\xa1\x5f\xef\xe0\xd5\x04\x38\xed\x49\xfc\x04\xa1\x14\x04\x35\x59\xaf\x10\x43\x92\x73\x41\xe7\xee\x99\xbc\xd9\xd5\x8a\xad\x05\xe8\xfb\x25\xf4\x4a\x4b\xe8\x95\x96\xd0\x52\x3c\xaf\x3a\xc5\x93\x9f\x5f\x5d\x9c\x07\xb3\x98\x5f\x22\x92\xcf\xbb\xa5\x50\x35\x4d\xc7\xea\xe2\x8a\x94\x61\x08\xe4\x08\xc3\x00\xe0\x51\x59\x2f\xd1\x5c\x5e\xc7\x59\x8e\xb8\xdd\x48\x53\x77\xc0\xa3\x60\x3b\x99\x75\xb4\xa3\xb8\xf2\xa4\x4e\x14\x32\xde\xd6\x8e\x1b\x5b\x37\x82\x50\xfd\xda\x9f\x48\x7b\x1c\x72\x9d\xa7\x51\xe4\x45\xf8\x95\xef\xd3\x27\xb8\x0b\x78\x2c\xaf\x3e\xf2\x40\xae\x2b\xab\xe8\xbc\xf8\xfb\x62\xcf\x43\x65\x7d\xa1\xd7\x2b\x92\xfe\xdf\x2a\x72\x17\x00\x9d\xf3\xad\xd7\x09\xac\xf6\xc5\x62\xb6\x94\x64\x22\x8f\x87\xa7\x5f\xfa\x50\xbe\x1a\xea\x8b\x98\x4d\x91\xe8\x4f\x91\x78\x26\x04\xc3\xe3\x5c\xa0\x30\x90\x96\x7d\x5f\x35\xdb\xc7\xe9\x6d\x50\x77\x95\x65\xc5\x9b\x78\x8e\x36\x42\xa0\x74\xa0\x86\xe1\x6b\x6e\xf1\x6b\x56\x05\x9e\x21\xd5\xdc\x5b\x3d\x7f\x96\x59\xad\x4b\xa5\x6a\xe0\x88\x90\xb7\x9d\xf2\x5e\x46\x8d\x05\x52\x1f\x2c\xc4\xfc\x4a\xa9\xbc\x3a\x21\xc4\x13\x98\xa1\x01\x9f\x21\x60\x48\x9b\x88\x98\x08\x0e\x82\x9a\x39\x2e\x12\x91\x20\x2d\x27\xda\xbd\x8b\xd1\xdf\x6b\x3f\x25\x0b\x9e\x31\xa4\x6e\x23\xf0\xdc\xfc\x62\x6f\x7f\xb8\xc8\xab\x78\x25\x4e\x7d\xe8\x54\x4c\xfb\xa3\xc0\xbd\xef\xa1\xde\x20\xf1\x9c\xb0\xd5\x36\x94\x57\x7b\x7b\xf7\x5c\xf3\x0c\x3f\xd5\xa3\x2b\x75\x93\xae\x26\xe0\x52\x3f\x66\x03\xee\xa2\x88\x53\x77\x4a\x8a\xd6\x95\xfc\xa3\x1a\x48\xa5\xae\x01\x6b\xa7\xb3\x58\x31\x23\xef\x10\x77\x3a\xd2\x91\x3f\x63\x9a\x2e\x87\xf0\x7f\x4f\xdf\xbe\xe9\x73\xc1\x30\x99\xe2\xc9\x32\xf4\xc4\x18\x6a\xb1\xc7\xe9\xd0\x0a\xa0\x1c\x68\xaf\xa5\x59\x25\xff\xca\xb4\xaf\x8c\xb2\x09\x68\x46\x7a\x29\x45\x6d\xe8\x8e\xbb\x63\x67\xbe\xfd\x38\x6a\xeb\xd7\x01\x4a\xb1\x73\xe5\x0e\x82\xf2\xa0\xdd\x5e\x73\xe9\x7a\x2a\xe0\xbf\xf9\x71\xec\x46\x67\xab\x8d\x05\xa0\xdc\x76\xfe\x4c\xf6\x7f\xe1\x74\x50\x20\x50\xde\x09\x4a\xbf\x35\x3b\xbf\x5f\x10\xeb\xb3\xf8\xdc\x67\xf2\x8f\xbe\x9e\x16\x3b\xcb\x4d\x9b\x42\x17\xe3\x19\x96\xbf\x7e\x66\x95\x6c\x92\x56\x32\x55\xda\xce\xe2\xaf\xa6\x0a\x78\x3d\x98\x55\x74\xf4\x3f\x54\x4b\x7d\x91\xfe\xe7\x73\xd1\x5a\xa4\xbb\x7d\x5a\xeb\x8b\x62\xd1\xb5\xf2\xb6\x8f\xf6\x36\x9a\xd5\xda\x50\x2b\x5b\x23\x9f\x65\xac\x09\xcd\xd6\x43\x27\x34\x7b\x38\x3e\x15\xbe\xde\xb9\xee\xfb\x81\x59\xa7\xb6\xda\xf4\x0e\x4d\xfb\x3e\x4e\x07\xc1\x95\x27\xfb\xfa\xf3\x78\x11\xea\x5b\x6a\x3d\xc0\x8d\x8c\xb0\x63\xd5\x4f\x43\x29\xae\xd0\x72\x74\x87\x57\x7e\x53\xf0\x2a\xbd\xf5\x56\x6a\x53\xfc\x0f\x14\xa7\x88\x8d\xee\x8c\x9b\x51\x6c\xb8\x7c\xf7\x5d\xf9\x96\xd7\xa7\x4f\x96\xab\x78\xff\xd0\x7d\x52\xf0\x4f\xa3\xc2\xa1\xb6\x65\x11\x3c\xad\x17\xc1\x10\x82\xa0\x85\x38\xe9\x28\x14\x9d\x9b\xb3\xa7\x96\x61\xbc\x28\xda\xe1\xb4\xb5\xd5\xcf\x98\xa4\x45\x3b\x9d\x1b\x27\xc9\x37\xa1\x5e\x0b\xd0\x0b\xa4\xf6\x07\x30\x25\x05\x68\x5a\x14\xb5\x76\xe5\x5c\x03\x71\xc0\xdc\x3c\x79\x3f\xdc\x3b\x86\xe7\x31\x5b\xfe\x2c\xe7\xcc\x80\x2d\x74\xd1\xe5\x15\x5a\xb6\x82\xfd\x44\x19\xc2\x53\xf2\x33\x5a\xf2\x02\x6e\xa2\xcb\x24\x5c\xb1\xa7\xd7\xc6\x3e\xb9\xc3\x8f\x4a\x50\xac\xff\x5e\x03\xa5\xce\xe5\x4b\x20\xe5\x35\xac\x83\x79\x17\x33\xa1\x38\x57\x19\xa1\x2d\xec\x1c\x63\x01\xca\x9b\x80\xeb\x07\x38\x43\x0c\x0b\x77\x84\xba\x60\xed\x10\x63\xc6\x91\x70\xc6\xa8\xfe\x6e\xa5\xf1\x84\x66\x59\x5c\x99\xf4\xc4\x96\x74\xc1\x48\x0b\xe4\x42\x68\x8b\xd4\xd2\x5e\x6d\xd2\x96\x3a\xa9\xb7\x31\x5a\xc5\xc9\x2c\xe7\x8e\x34\x95\xcb\xfd\xa7\x4f\x26\x35\xa6\x01\xdc\xb4\xf8\xe6\xc0\xba\x59\xd1\x0e\x5c\xac\x8c\x35\xd8\xa2\x7c\xb5\xd7\x72\x3a\x7e\x1a\x5f\x23\x03\xe4\xdd\x0d\x69\xef\xd3\x1d\x70\x0d\xbc\xa8\xaa\x42\x0f\x9a\xc7\x15\xdb\x1d\x92\xf8\x33\x34\x54\xef\x15\xeb\xef\x49\xd5\xb0\x97\x1f\x64\x2c\x36\xf0\x1c\xe1\xaa\xf4\xe9\xb6\xca\x53\xf4\x7b\x8e\x48\xd2\x01\x4d\x17\xcf\x05\x19\x6c\x79\x70\xeb\xd2\xf4\x45\x0f\x69\xec\xd3\x3c\x9b\x1d\xd3\x18\x32\x7d\x47\x2c\x4e\xd5\xee\x87\x2c\xac\xfe\x82\xd0\xfb\xe2\xe5\x20\xdf\xf1\x48\xd1\xdc\x7b\x40\x02\x19\xbe\x42\x4e\x7e\x5c\xcf\x1e\x88\x94\x37\x6a\xa6\x14\xe2\x89\x40\x3a\x23\xb1\xb8\x37\xa2\xda\x95\x57\x47\xca\xfd\x20\xdb\xdf\x03\x1d\x9c\x54\x26\x22\x8c\x8b\xd5\x51\x2e\xf1\x81\xa1\x31\xb0\xfb\xe7\xfe\xba\x6d\x77\xcf\x6b\xde\x53\x39\xfb\xf6\x37\xdf\xae\xa8\x9d\xda\xb5\xfb\xa2\x06\xc9\x7a\x67\xd2\x34\xf4\x39\x94\xdf\x40\xc4\x6b\xa8\xab\xc5\xbb\xa6\xb4\xea\x39\x5a\xb6\x9d\x97\x43\x77\x65\xf4\x41\x36\x10\x25\x89\x06\x7d\x21\x06\xf6\xc5\xd6\xa2\xbc\x7c\x2b\xe2\x9e\x3b\x8b\x9f\x3b\x60\xb7\x0f\x7a\xc9\x90\xbd\xa0\xde\x1f\xa9\x57\x36\xdd\x8a\xb6\x15\x87\xee\x8b\xec\xa5\x6d\xc1\xfc\xff\xdd\x5d\xeb\x8e\xdb\xab\x0b\xc8\x67\x33\x22\xce\x3a\xe2\x51\xd6\xa3\x86\x41\x77\xd5\x77\xe7\x50\xb4\x34\xa1\x1b\xe6\x7d\x78\x48\xd3\xc1\xa6\xf9\xcb\x84\x9b\x9e\x4d\x7c\x29\x88\xaf\xe3\x31\xca\x60\x54\x37\x0d\x32\x79\x89\x3d\x13\xe1\x41\xd4\x17\xf4\xd7\xc5\x02\xb1\x13\x75\x1f\xa7\x69\x44\x4c\xde\xcf\x61\xd4\x40\x4f\xf4\xd1\x54\x29\xf8\xc5\xda\xf5\x14\x9a\x65\x8f\x20\xe8\x07\x2a\xa2\x8c\x9a\x4a\xd1\x54\xbc\x2a\x11\xf0\xa7\xea\x82\xd6\x94\x6f\x45\x8c\xbc\x6b\x10\x56\x74\x2e\x66\xd3\x5c\xdd\x2d\x0f\x2e\x3c\x67\x97\x2b\x8f\xb9\xab\xb9\x90\xd6\x8d\xb4\xa1\x7a\x91\xa3\xaa\x73\x4e\xcf\xe8\x62\x08\x8f\x0f\x56\x2b\x7f\x32\x68\x57\xa2\xdf\x5d\x31\x3d\x2b\x37\xcd\x4f\x0e\xc4\x9f\x35\xb8\x0e\xa1\x13\xd7\x0e\x0b\x7c\xed\x98\xe4\xa0\xec\x60\x52\xcc\x17\x59\xbc\x1c\x42\x30\xc9\xd0\x6d\xd0\x36\x1c\x05\x27\xed\x44\xcc\x50\xdc\xda\x42\xfe\xd4\x55\xcf\xbb\x91\xe1\x0b\x12\x6a\xf1\x88\x63\x09\xba\xe1\x19\xbd\xe1\xa3\xe0\xc7\xa0\xb3\x91\x3c\x73\x1e\x05\x7f\x3b\xe8\x6e\xa5\xf4\x77\x74\xe7\x5f\x4a\xda\xa9\x18\x74\xf0\xac\x23\xe7\xd9\xfe\x6c\x92\xfb\xbc\xea\xe6\xc1\xfa\x0c\xe7\xfb\x4c\x8f\x93\x1a\xdd\xe2\xf0\xb5\xa3\xe8\xbe\x1e\x29\xdf\x40\x6b\xe7\x5d\x6b\xde\xb4\x27\x64\x72\x7f\xca\x09\xd4\x8a\x6d\xb3\x1d\x9e\x76\x2a\xd1\x7b\xd5\x96\xbb\x1a\xe9\xc7\xa3\x74\x14\x86\xea\xca\xc0\x6a\x0d\x01\x36\x5f\xa1\xbb\xeb\x9f\x30\x43\xfe\x8e\xd1\xb5\x7e\xa3\x01\x28\x69\x62\x5d\x4f\x48\x57\xa7\xaf\x63\x32\xcd\xe3\x29\xf2\xf6\x9b\x99\xca\xd6\x3c\xe6\x26\xf7\x5d\xe7\xaa\x91\x61\xb8\x5c\x7c\x85\xd8\x55\xdf\x01\xde\x28\x70\x95\x04\x7a\x13\x03\x97\x8b\x7b\x86\xac\xa2\xf2\x70\xec\x99\x7e\x30\xd6\x9b\xcb\xb7\x5c\x6c\x1a\xa9\x3a\x89\x7a\xcb\x45\x33\xdc\x74\xa2\xcd\xf6\x30\xf3\xde\xb1\xa1\xe1\xae\xfa\x9f\x37\x57\x66\xb9\x70\x2c\x44\xeb\xd9\xc4\x72\xb1\xc9\xd1\xc4\x72\xf1\xad\x06\x83\xca\x26\x1c\x35\xc6\x54\x3b\x40\x90\x4c\x3a\x37\x63\xfd\x2c\x01\xa0\xc4\x5d\x09\x40\x64\x81\x53\xf3\xdf\x26\xfe\x53\xaf\x23\xab\xf3\xda\xe5\x62\xb3\xc8\x4f\x37\xfc\xf2\x61\xdf\xe6\x2c\xff\xdf\xa8\xaf\x3b\xea\x73\xac\xef\x67\x30\x12\xd6\x02\xd7\xf5\xf1\xa8\x6a\x45\x0b\xf5\xdc\xfd\xac\x51\x9b\xc2\xed\xa3\x3b\x6d\xc5\xf5\x39\xe2\x72\xb1\x61\x5c\xe7\x8a\x5f\x47\x50\x57\x69\xd6\x1a\xd1\x95\x87\x67\x30\x82\x20\x68\xe8\x85\x8b\x45\xef\x31\xaa\x5c\x53\x9f\x72\x54\x50\x19\x40\x9b\x4c\xaa\xd7\xbd\xa8\xed\x72\xb6\x73\x41\xb4\xd9\xa1\x5a\x6a\x39\x16\x68\xe3\x5e\x63\x2b\x20\x4e\xcf\x8a\xc9\xb1\x5d\x26\x1d\x15\x95\x2a\x61\x1e\x67\x0f\xd6\x52\xb8\xa6\xff\xf3\xd6\x6b\x88\xe7\x81\xbc\xe1\x65\x1c\xc9\x5e\x47\x33\x42\xc5\xa5\xf4\xeb\x94\xef\x18\xbc\x79\x7b\x06\x6f\x7e\x7d\xfd\x5a\x87\xd1\x5d\x70\x29\x9a\xc4\x79\x26\x34\xd8\x8b\x97\x3f\x3d\xfb\xf5\xf5\x99\x63\x93\xca\x6a\x3f\xa2\x8b\x7e\x42\x49\x12\x8b\xd0\xb0\xb0\x7a\x7c\x68\xd8\x57\x3c\x8b\xe2\xb0\xef\xe4\x1f\x2f\x4f\x7e\xd6\x9f\x01\x93\x2f\x93\x64\xf2\xca\xbe\x8d\xbc\xa3\xa8\x3f\xc1\x99\x40\x2c\x7c\x4e\x69\x86\x62\xd2\xce\xde\x6f\x24\x2c\x57\xdc\xaa\x5c\xc1\x53\xdc\xa8\x6d\x69\x54\x0d\xfd\xbf\x48\xe8\x6e\xad\xe8\xae\x71\xbb\xb5\xe5\x5f\x3a\x68\xf7\x78\x01\xff\x42\x11\xfb\x36\xb3\xd2\x08\xd7\xab\x9e\xf8\xb7\x15\xab\x77\xeb\x86\xb5\xa9\xae\x2a\x96\x96\xf6\x41\x42\xd3\xca\x81\xed\x17\x0d\x4f\xb9\xed\xd9\x1b\xa2\x1a\xff\x61\x30\x28\xdb\xa9\xe0\x50\x7d\x81\x33\xc3\xd7\x08\x26\x8c\xce\x6b\xcf\xe7\x63\x92\x20\x73\x1d\xc8\x7e\x50\x40\x2f\xbd\xe6\x56\x2a\xc4\x59\x56\x3c\xa4\xd2\xdf\x30\x82\xfd\xaa\x9f\x7a\xb3\x63\x0f\x8e\x76\xfa\xd6\xd2\x43\x7b\xfe\xdb\x7e\x37\xa9\xfe\xd9\xaf\x72\xca\xf5\x7e\x40\x39\xbe\x0b\xcf\x8b\x31\x5f\xd2\x17\xdf\xc8\x51\x2d\xc8\xd5\x8e\x00\x47\xbf\x6f\xe6\xab\x72\xf4\x7b\xd5\x99\x8b\x73\x41\x2f\x31\x49\x98\xfa\xf0\x6f\x20\x9d\x95\x67\xb9\xa0\xe0\x14\x0d\x21\xb0\xba\x19\x34\x90\xe7\x3c\x9e\xa2\x53\x69\x31\x2c\xf6\x9b\x98\x11\x9d\x40\xf3\x14\x8c\x2f\x30\x04\x69\x98\x69\xa6\xec\x32\x43\x69\x20\xbf\x33\x4b\xc4\x3f\x91\xfc\x62\xda\x10\x82\x31\xcd\xd2\x60\x05\x43\xb0\xa6\xe7\x1b\x3c\x14\x50\x63\xc3\xfa\xbd\x38\xfd\x87\x14\x1c\xbb\x13\x19\xb5\x7a\x0a\xba\xed\x86\xdb\x8f\x08\xa5\xbc\xd1\xa9\x81\x5d\xf5\xf5\x9f\xc5\x3b\xb6\xf7\xda\x74\x7c\x65\xe7\xb7\x39\xc6\x62\xe6\x37\xf2\xa4\x4a\x01\xe8\x72\x7b\x4c\x0f\x27\xae\x2d\x6c\xf4\x6c\x2c\xa5\xbe\xf1\xa6\xf6\x5a\x27\x86\x7d\xf3\xf8\xb6\x2c\x35\xec\xcf\xcd\x53\x7b\x7f\x86\x9c\xa3\x34\x6a\x59\xef\x1e\x60\xcb\x54\xb2\xbf\x6d\x3d\xba\x73\x75\x56\xe6\x17\xb6\x3c\x35\x70\xa5\xab\xce\x6b\x77\xa6\x16\x57\xb5\x87\x18\xaa\x49\x8e\xd5\xe7\x04\x16\x57\x75\xab\x22\xb1\xea\xcb\x39\x7b\x9e\x09\x92\x9a\x11\x2c\xae\x82\xa6\x10\xec\x75\xcd\x91\xe9\x5e\x82\xbb\x33\xb4\xb8\x2a\xfc\x6b\x08\xd5\x5f\x45\x26\x9e\x13\x44\x78\x6c\x67\x63\x0e\x22\xcf\x5b\x00\x75\x1e\x38\x19\x9b\xfd\x09\x65\x2f\xe3\x64\x16\x86\x93\x2b\xbf\x91\x5b\xcf\x86\xbb\x60\x72\x25\x97\x33\xbc\xda\x92\x19\x86\x8e\x3a\x33\x26\x15\x66\x4c\x5a\x99\x01\x0c\x4d\x10\xd3\x3e\x83\x6a\xa6\xb7\x2f\x2e\xad\x4a\x43\x78\x17\x3a\xc5\x05\x92\x66\x78\xbe\x8a\xe0\xed\x1b\x78\xf1\xf2\xf5\xcb\xb3\x97\x1a\x95\x7e\x43\xf4\x92\xe5\x1a\xd3\xdb\x37\xf0\xeb\xbb\x17\xcf\x6c\xad\x76\x38\x6d\xed\x56\x93\x12\xb5\xce\x8a\x49\x84\x2d\x67\x44\x3e\x45\xb1\xf3\x94\xc8\x1d\xa2\x5d\xe6\xe4\x0e\xa7\xb7\xe7\x41\x4e\xf0\xef\xb9\x36\xa9\xc1\xaf\xea\x77\x50\x79\xb9\x43\x50\x2b\xd6\x2b\xf3\xfb\xea\x78\x50\x01\xb3\xb3\x96\x73\x4c\xa6\xa0\xcb\xb4\xa3\xa2\xa7\x43\x15\xe8\x9c\x60\xb5\xb4\x5e\xc9\xb1\x5d\x95\x56\x57\xce\x8c\x3e\xe0\xbe\x3a\x0f\xd0\xad\xfa\x4a\x91\xde\x9d\xd2\x11\x76\x65\xca\x34\xb6\x05\x43\xea\xa1\x17\x43\x2c\xfc\xf3\x1f\x2f\xdf\xbf\x54\x5b\x01\x8d\x6a\x7f\xb6\xf7\x6e\x93\xa5\xf3\x8f\xcb\xb9\x4a\x66\xf7\x50\x9f\x64\xb6\x9b\xfe\x28\x22\x5c\xcd\x49\x66\x8e\xea\x98\xbd\x0a\x5d\x68\xb7\x2a\xb6\x34\x21\x51\xf3\x3d\x11\x97\x09\x36\xbf\x78\x07\xd3\x59\xe6\x26\x6f\x3d\xe8\x98\xc5\x89\x40\x0c\x38\x6a\xbe\x1f\x54\x99\x22\x95\x2f\xbd\xea\x15\xa0\x45\x9f\x9d\x60\xb6\xd1\x6a\x37\x63\xeb\x63\x94\x4d\x01\xef\x78\x56\x72\x03\x96\x15\x79\xe3\x5b\x72\xcc\xf6\xae\x42\xa9\xae\xa1\x17\x64\x3a\x6a\xb6\x1b\x13\xcc\x3b\x0e\x65\x8e\xbc\x0a\xea\x32\xcc\x85\xf9\x2a\x59\xf1\x9e\x83\xb9\x51\xa2\xde\x59\x90\x6d\x08\x15\x90\xd2\x44\x25\xb5\xe8\xcf\x62\xa8\x16\xea\x4b\x78\x73\x8e\xb2\x6b\xf7\x5c\xd0\xc7\x6b\x37\xcb\x7f\x97\x25\xdd\x82\xef\xcb\xfb\x00\xdb\xae\xee\x16\x58\x1d\x6b\x76\xb1\xda\xa5\x72\xf5\x30\xab\x7a\x81\xd2\x31\x4b\x72\x34\xbb\xdb\x25\x09\xbd\x93\x61\x2a\x48\xa9\xf8\x38\x31\x2b\x5f\xd7\x06\xf3\xe7\x98\xe6\x24\xdd\x7d\x0d\x35\xd1\x8b\x1c\x4c\x6d\x3b\x41\x3b\x8d\xea\xbe\x82\x7a\x42\x44\x09\x90\x7a\xc6\xa3\xed\xe3\x83\x36\x2f\x1a\x33\x98\x32\x7a\x23\x66\xe5\x46\x83\x79\x49\x46\x3e\xbb\xd9\x33\x0f\x55\xab\x19\x96\x75\xb6\x43\x07\xab\x88\xaf\x10\x01\x4a\x00\x5d\x23\xb6\x54\x60\x7d\xc7\x93\x3d\x35\xef\xde\x78\x1f\x2d\xd6\xef\x38\x78\x1c\x57\x05\x55\x7b\x58\x53\x37\xee\xfc\x00\xa5\xe1\x90\x8c\x63\x3c\x32\x24\xbb\xd4\x63\x1b\xd9\xae\xcf\x0f\x6a\x6e\x74\x16\x57\xaa\x6b\x9d\xee\xc3\x61\xad\xbd\x61\xde\x08\x42\x69\x6f\x7a\x20\xa8\xe4\x19\x9b\xc7\xa2\x25\x84\x4e\xf1\x64\x22\x07\x4c\x61\x5f\x99\xa8\xa3\x46\x8b\x05\x62\x09\x52\x5b\x28\xb2\x5e\x9a\x4f\xb5\xd6\x2b\x4f\x21\x54\xe0\x4f\x46\xba\xec\x51\x99\x82\xa7\x2b\xfe\x0f\x1c\x1e\x1c\xc0\x40\x01\xca\x63\xa3\x9f\xf0\x2d\x4a\xc3\x43\xd9\x20\xf8\x73\xa4\x5b\x1f\x79\x23\x62\x0f\xde\x7d\x85\x58\x0f\x26\xfc\x25\x16\xb3\x7e\x3c\xe6\xaa\x61\x24\x2b\x0c\x99\x2d\x7c\x4e\xf5\xee\x58\x28\x37\xa5\x14\x27\x08\xba\x81\x17\x72\xc3\x44\x95\xf4\x05\x7d\xad\xb2\x13\x4e\xd5\xb1\x71\x18\xed\x72\xf5\xc3\x17\x94\x76\x6a\xea\x69\x21\xb5\x8e\xaa\xfe\xff\x3b\x39\xe7\xf2\xab\xc3\x37\x72\xc7\x46\x6d\x63\xf7\x8c\x92\x70\xfc\x07\x82\x3b\xcd\x82\xe7\x4b\x81\x78\xa8\xdb\xaa\xda\x4b\x59\x1b\x5c\x44\x2b\xff\xd9\x8d\xb9\xda\xd5\x8a\x43\xd5\x17\x38\x20\x34\x9f\x79\xa7\x04\xee\x24\xf3\x6c\x4f\x58\xa5\x6c\x6c\xe2\xc9\xc8\x1f\x33\x16\xf9\xef\xe5\x75\x9c\xe4\xf9\x5c\xfb\x99\x4e\x71\x4c\xe2\x6c\xf9\x87\xf2\x20\x37\x0e\xf5\xd7\x46\xe3\xaf\xa5\xda\xe8\x0e\x5d\x2b\xe8\xa3\xe6\x29\x38\xc3\xab\x54\x45\x52\xea\x88\x34\x23\xc1\xaa\xb7\xb6\x4b\x35\x26\x30\xa3\x69\xe9\xd4\x19\x6b\xa3\xd7\xa2\xce\xed\xb6\x35\xf4\x6f\xdd\x1d\xb9\xab\x99\x88\x27\x70\xd8\xc6\xd8\x14\x89\x18\x67\xbc\x8b\x9b\xf9\x5c\x06\xcc\xdd\x9b\xf6\x96\x01\x7f\x77\xed\xb6\x96\x19\x65\xdd\x4a\xa1\x71\xb8\xd2\x89\xf1\x4e\x5b\x31\x0b\xae\x35\xa1\x07\xae\x5e\xf4\x20\x24\x5a\x8f\x23\xa3\x22\x9d\x18\x5d\xf5\xa9\x22\x77\x55\xc7\x76\x51\x2d\x73\x54\x25\x5a\x75\x77\x53\xd5\xb1\x6a\x47\xae\x7e\xd9\x8e\xaa\x65\x95\x8e\x3a\x8e\x41\xd6\xce\xca\xb1\x19\x6e\x45\x6d\x54\xd9\x6a\xcd\x54\x8a\x19\x8a\xd3\x75\x6d\x58\x77\x03\x83\xc8\xdf\xfd\xa9\xb5\x2a\xc7\x03\x31\xbb\x07\x9e\xf7\xf4\x86\xdf\x13\xc5\x59\x21\x13\xf7\x44\xf4\xca\x99\xf5\xf5\xa8\x8e\x07\xeb\x18\x78\x3c\xd8\x68\x1a\x64\xb2\xd3\x1a\x3d\xb2\xb6\x40\xef\xa4\xab\x3f\x7c\xf7\xc7\x5b\xa6\xd9\x6e\x40\xaf\x6f\xac\x01\x52\x3f\x7b\xb4\x29\xd0\xbd\x97\xb6\xe0\x78\x20\xd2\xfb\x22\xb6\x38\xcd\x52\xf9\x10\x28\xdd\x75\xb1\x20\xb9\xb2\xba\x7e\xae\x5e\xaa\xeb\xef\x66\xbd\xac\x97\xa5\x68\xb5\x4e\xd4\xba\xc5\xe8\x78\xa0\x68\x6e\x3d\x73\x35\x4b\x88\x77\x39\xaa\xdf\x38\xf5\x06\x09\xe6\x45\x6b\x27\x4c\x68\x46\x08\x9a\x45\xa9\x3d\x67\xa4\x13\x88\xed\x07\xf0\xe4\xd1\x62\x2e\x8a\x78\xc0\x79\xd6\xe6\x23\x1d\xbb\x7e\xbf\xed\x67\x04\xa1\x6e\x53\x4f\x02\x53\x79\x8a\x45\xd5\xb6\x7e\xbc\x21\xad\xc4\x7e\x1e\x08\xba\xb8\xac\x27\x25\x49\x45\xbc\x96\xfd\x5e\x9b\x84\x25\x93\xda\xa2\x9c\xe9\x6b\xb9\x17\x97\x13\xa1\xcb\xaa\x3b\x6e\xeb\xfd\x51\x10\x58\x64\xa8\x78\xb1\x5e\xbb\x6e\x12\x6d\xe1\xe9\x96\x94\x69\x1d\x6c\x38\xbd\x8a\x14\xe5\xe4\x4b\xb8\xa2\xb9\x61\xff\xa5\x56\x33\xd5\xc8\x14\xa9\x95\x37\xf0\x18\x08\xc9\x29\x0e\x77\x65\x97\xb2\xe0\x72\x22\xf7\x8e\xf4\x96\xa2\x8a\x0c\xdc\x90\x60\xf5\xe7\x1e\xdc\x15\xcd\x53\x29\x00\x24\x11\x97\x86\x21\x2b\xb0\x25\xc7\x63\xe6\xc9\xac\x98\x63\xe2\x40\xcf\xb1\x3c\x44\x92\x2f\xb7\xdf\xba\xa5\xf1\xad\x34\x12\x5e\xf8\xb2\x55\x7c\x3d\xbd\xd4\xae\x93\x3e\xd3\xe2\x8b\x98\x3c\x89\xaf\xa7\xa0\x0b\x5b\x5a\x6a\xb4\xc7\x03\xd5\xba\xdd\x37\x33\x52\xf2\x14\xa4\x70\xe8\x14\x49\x53\xb4\xad\xfe\x68\xa1\x3e\x29\x1e\xb0\xab\x87\xb3\xee\xb9\x6a\x75\x7f\x4d\x02\x98\x1c\x2b\x9a\x95\xdb\x13\xcd\xb3\x55\xb4\xf4\xe7\xe9\xa9\xa7\xd7\x30\xbf\xac\x3c\x4f\xe1\x3b\xc8\x36\x18\xde\xfd\x1c\xb4\x65\xe0\x59\x54\xce\x8b\x15\x9d\xa8\x7e\xaa\xa3\x6a\x92\x9d\x8e\xcf\x74\x6a\xb4\xc2\x5d\x3c\x43\xe9\x1f\x45\x51\x5d\x4b\x69\x54\xe7\xc7\xff\xf9\xec\xfd\xc9\x3f\x9e\xbd\xf7\xa7\x02\xda\x5e\xcc\x2f\x8f\xcc\xce\xb9\xc2\x5a\x88\x4f\xf3\x4e\x18\xac\xfc\x84\xa8\x8c\x64\xf7\xf0\x3a\xa5\xf3\x18\x93\xee\xbe\x15\x64\xce\x11\x33\x83\xd0\x96\x44\x43\x02\xbd\x46\x0c\x0a\x92\x9c\x8c\x40\x1f\x55\x15\x9e\x28\xf8\x4b\x9b\x95\xd7\xf6\xd2\xa7\x1d\xf8\x08\x82\x5e\xd9\x4f\x0d\xb8\xeb\x0b\x39\xab\x4e\xa1\x68\x30\x64\x4d\x6a\x66\x37\x4f\x0a\xe0\x28\xd8\xae\x57\x26\x33\x5a\xba\x7b\x0c\x1b\x5d\x7e\xfa\x64\x6a\xb5\x41\x0d\x15\x12\x9b\x44\xbf\xc1\x74\xac\xa5\x2a\x66\x2c\x5e\x6e\xc0\x87\x5a\x37\xe7\x17\x5d\x17\x14\xcd\xae\xb1\xc9\xde\x84\x62\xbb\x5e\x12\xbe\xa0\x5c\x4c\x19\xe2\x80\xb9\xfe\x82\x12\xcd\xe5\xce\xa6\x80\x1b\x9a\x67\x29\x8c\xed\x43\xc1\xc5\xae\x9b\x7a\x3c\xdd\xf7\x0e\x6d\x31\x28\xe7\x79\x14\xf9\xaa\x50\xa3\x4c\xdd\xc9\x34\xc4\x74\x0d\xf5\xd1\xc8\x2e\x9f\xe7\x1a\x87\x7d\xa8\xa5\xd7\xc0\x79\xd1\x91\x22\xba\xf6\xfe\xa6\x61\x8f\x92\x6c\x93\xa4\x15\x63\xe7\xb3\xc2\x66\x17\xb3\x78\x33\x39\xe6\x70\x83\xb2\x0c\x28\xa9\x3c\xaa\xac\xb6\xda\x8b\xa3\xd3\x7e\xf3\x49\x78\xcf\x31\x97\x26\x3a\x54\x5d\xeb\xb7\x1e\xd4\xaf\xce\xc9\xac\x75\x2f\x30\x49\xb2\x3c\x45\x5c\xb3\xd8\x5e\xaf\x71\x0f\xca\x2c\x8a\x4e\x7e\xf6\x2a\xa9\xb6\xa6\xaf\x4a\xba\x6d\xfd\xf2\x43\xd3\x0e\xcb\xe5\xcc\xbc\xc7\xaa\x89\x31\x7f\x1b\x19\x16\x2c\x47\x72\x1d\xfc\x7f\x2f\x4f\xd5\xe6\xde\x9b\xb7\x41\x13\x87\x3e\x08\xb5\x18\x30\xbf\x2c\x4e\x46\x37\x46\x61\x24\xe8\x3f\xe5\x2a\xeb\xcd\x9a\xd6\x66\xcb\xa6\x2c\xf7\xfc\xf5\x38\x45\x44\x60\xb1\xd4\xc7\x9c\xf6\x2f\x28\x4d\xbe\xd3\x40\xf1\xa7\x25\xf5\x59\xb5\x9d\x22\x82\x98\x12\xc9\xcb\xca\x29\xeb\x53\xb0\x55\xfa\xe8\xa5\x40\xde\x06\xd0\xd1\x07\xba\x15\x2c\xae\x0f\xa7\x4b\xfe\xf7\x3c\xfe\x6e\xb8\xb7\x75\x50\xd8\x1e\xf2\x5c\xa1\x65\x47\x50\xd3\x0e\xe7\x48\xf2\x4e\xf0\x5a\xac\x77\x02\xb5\x22\xbb\x13\xb0\x16\xd5\xdd\x48\x76\x64\x76\x27\x04\xe5\x13\x44\x36\xec\xd1\x06\xc1\x7e\x18\xb1\x33\xbe\x3c\x16\x69\x6b\x34\x58\xbe\xe1\x37\xd2\x13\xe3\xbe\x56\xbf\xea\x06\xd3\xc6\x6a\x1f\xa7\x06\xd4\xff\x5c\x9b\xfd\xf1\x0d\xcc\xdb\xf8\x61\x12\xd2\xed\xb3\x84\xa3\xe6\xb1\x6c\x7a\xbb\xda\x10\xc1\x56\x3c\x71\xf9\xb2\x29\x53\x6a\x30\x5b\xe7\xcf\xeb\x61\x6d\xf2\x92\x97\xfb\xa3\xf3\xe8\x1f\x6f\x92\x47\xff\x78\xb3\x3c\xfa\xe6\xe3\xcd\x5b\xa7\xd1\xfb\xe5\xb7\xb9\x1f\xe2\x49\x7f\xeb\xfa\xf4\x5f\xe5\xf2\x53\x4d\x12\xe4\x23\x82\x5d\x97\xa0\x7c\xcd\x1b\x97\xa1\xda\xc3\xf7\x8d\xb3\x3c\xdd\xb9\x74\x5f\x6b\x94\xd1\xea\xec\x87\x27\xfa\x2b\xc3\xc3\xd6\x66\xab\xe3\xc1\xec\x87\xf6\x18\x75\x87\xfc\xd1\xfa\xc8\xdf\xb4\x3e\x32\xf1\x30\xb7\x54\x76\xb9\xa1\xb2\xde\x18\xdc\xcb\x10\x74\x2a\x5a\xc7\xb3\x77\x1b\xdf\x55\x59\x7f\x4f\xc5\xe8\x56\x9d\x6c\x87\xad\xab\xbd\x6d\x14\x6c\xcd\x1d\x95\x7b\xde\x4f\xd9\xec\x6e\x4a\xed\x0b\x04\x23\xbf\xa8\x7d\xb6\x29\xad\x7e\xaf\x4f\xcf\xa8\x7d\xa5\x70\xdb\xd5\xa8\xf5\x6a\x4b\xc7\xa7\xfb\x06\xf7\x3c\x66\xce\xa4\xaa\x76\x2c\x90\x98\x2c\x72\xb1\xc1\x15\x22\x15\x02\x8c\xe9\x6d\xf0\x79\x57\x52\xf3\xfa\x78\x13\xb2\xe5\x01\xc7\xad\x94\x70\x03\x1c\x83\x27\xf6\xfb\x8a\xe5\xf7\x19\x54\x74\x87\x39\xdc\xf9\xcc\x7b\xdb\xf1\x6c\x0b\xdf\xfd\x27\xe4\x8e\xb3\x26\x73\x82\x7d\xcf\x45\xba\x6d\x54\x26\x4a\x7b\xa3\x8a\xe6\x17\xd7\x45\x65\x6e\x3e\x43\x4d\xb1\xe9\x80\x91\x06\x9c\xa1\x8e\x75\x62\xdb\x33\xc6\xae\xb3\xc5\xce\x33\xc5\xd6\x73\xb7\x9f\xd1\xb2\xfb\xb8\xad\x15\xb2\xb8\xd6\xbd\x23\xbc\xba\xc7\x25\xe3\x8a\x1d\xe1\xdf\x98\xc8\x62\x47\x70\x9d\x14\xbc\x2b\xed\x3a\xba\xd8\x11\xda\x28\xc8\xce\x7d\x17\x8b\x51\x3b\x86\xf6\x43\xad\xce\x83\xd1\x75\x07\xa2\xae\x12\x99\x6d\xf0\x30\x6a\xd3\xe0\x16\x5c\xde\xd3\xb0\xb5\x0f\xab\xea\xe7\x58\xb7\xbe\xf7\x77\x8f\x6b\x7f\x63\x41\x2e\x8d\x63\x74\xa9\x66\x40\x66\x94\x50\x82\xea\xcf\x65\x08\x72\x49\x17\x71\x82\xc5\x72\x08\x07\xfd\x1f\x5b\x9f\xb0\x99\xc5\x24\xcd\xd0\x69\xc2\x68\x56\x78\xc6\x6e\xd9\x16\x4f\xd7\x94\x84\x9a\xdb\x7b\x71\x9a\xbe\x94\x2f\x10\xbd\xc6\x5c\x20\x82\x58\xf8\x3d\x57\x38\xbf\xef\x35\xfb\x89\x8e\xb6\xfa\x24\xa2\xe9\x81\xa1\x39\xbd\x46\x3b\x76\xe2\xd6\x34\xc2\x05\x29\x25\xba\xea\x1d\x55\x0f\xf0\x98\xdc\xd9\xbe\xac\xe8\xeb\x2e\xce\xe8\xe2\xa8\xf6\x6d\xce\xc4\x0b\x63\x7f\x79\x99\xa9\x3b\x4a\x3e\x70\xb9\x7b\x5a\xed\xf3\x09\xfc\x70\xa0\x3f\x81\x99\x54\x0b\xa3\xce\xcf\x4d\x7a\x25\x64\x9c\xd1\xe4\x2a\xa8\xbc\x65\xe2\x79\x89\x60\x13\x4c\x6f\xa4\xac\x41\xdb\xa7\x2c\x3d\xb1\x97\x92\x7e\x73\x47\x4e\x7d\x1a\xc8\xb9\x8a\x69\x4d\x08\x5d\x5c\x8e\x05\x69\xc8\x7a\x11\x00\x38\x77\x08\x1b\x34\x55\xe5\xde\x7e\x07\x4e\x06\x0d\xf2\xf8\xb1\xae\x16\xe5\xc7\x9f\x2b\xe5\x4c\xdf\xe9\xfb\x4b\xad\xf8\x0f\x73\x21\xe3\x3f\xfe\xa3\x57\xfb\xe4\x1a\x11\xa7\x58\xe6\x82\x1d\xfe\xad\xde\x01\x4b\x11\xf3\xeb\x24\xcd\x45\x86\x49\x8b\xc2\xda\x8b\x86\x53\x86\x96\xf5\xaa\xc6\xa7\xac\xab\x43\x8e\xd3\x54\x7d\x6f\xe4\xf0\xb1\x8f\x96\xf7\x71\x8a\x73\x3e\x84\x1f\x6b\xc4\x58\xe3\x50\xe3\xad\x29\x77\xed\xc5\x4e\x09\x93\xeb\xbf\xd6\x5d\x9d\x7c\xbf\xb9\xc6\xe9\x28\x98\x2f\x9f\x8b\x75\x1f\xde\x36\x67\xa4\x1b\x68\x1c\x8c\xe0\x00\x5a\xa2\x17\x4a\x7e\xa1\x39\x47\x6f\xaf\x11\xb3\x28\x7d\x2a\xe1\xd8\xd5\xbf\xc1\x2a\x82\x6e\x64\xb9\xd8\x10\xd7\x8f\x7e\x5c\xfe\x65\xef\xef\x14\x84\xfc\x6f\xb1\xe1\xf7\xc2\x5b\x0e\x9c\xe5\x42\x56\xd3\x4f\x55\xb3\x18\x3a\xaa\x58\x5c\x87\xd5\x40\x5a\x8e\xd4\x6a\xe9\xb6\x2a\x24\xff\x70\x71\x0b\x9c\x66\x38\x85\x71\x16\x27\x57\x81\x0b\x25\x43\xa5\xe7\x82\x0c\x2b\xf6\x41\x0b\xfe\xcd\x0c\x0b\x57\x29\x0a\xb1\x0e\x0e\x1f\x2f\x6e\xe1\x2f\x3f\x2c\x6e\x9d\x5a\x19\xf3\x3f\xcb\xf0\x54\x2a\x7a\x82\x6a\x5a\xe1\xa8\xe7\x5f\x7b\x7b\x1b\xa8\xd1\x38\x4e\xae\xe4\xb3\x69\x24\x3d\x31\xd4\xfc\xdb\xc1\xc1\xdf\x4e\x9e\x3f\x0b\x7a\x35\x2e\xe8\x6f\xc5\xff\xa5\xb7\xd7\xa2\xd4\x75\xbe\xa6\x74\x7e\x42\x89\x88\x31\x41\xcc\x5d\x11\x7e\xcf\x11\x5b\x9e\xa2\x0c\x29\xaf\xe0\xfb\x7f\xb3\x97\xf0\x5f\x91\x09\xfd\x3e\x3a\xf2\x40\x9f\xdd\xd0\x2e\x04\xa2\xf8\x0a\xaf\x04\x57\x8e\xc8\x8b\xb7\xbf\x18\xa7\x28\x44\xe1\x0b\xa7\x83\xa8\x57\xc1\xec\x6d\x5f\x7e\xd5\xb7\xd6\xfa\xec\x86\x46\x47\x7b\xff\x35\x00\x61\x39\xd8\x98\x99\xb5\x00\x00")
 
 func assetsAppJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -94,7 +94,7 @@ func assetsAppJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/app.js", size: 18298, mode: os.FileMode(420), modTime: time.Unix(1593369997, 0)}
+	info := bindataFileInfo{name: "assets/app.js", size: 46489, mode: os.FileMode(420), modTime: time.Unix(1792264651, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Modes of the write_comments command.
+const (
+	writeCommentsApply  = "apply"
+	writeCommentsScript = "script"
+)
+
+// CommentWriter writes the descriptions stored in godic back into the database as comments, so any other tool
+// reading the database shows the same documentation. Introspectors of database engines that support comments
+// implement CommentWriter.
+type CommentWriter interface {
+	// CommentStatements returns the sql statements that write the given descriptions as comments of the tables
+	// and columns described in the given *catalog.
+	CommentStatements(cat *catalog, comments []descriptionComment) ([]string, error)
+
+	// ExecStatements runs the given statements against the database.
+	ExecStatements(statements []string) error
+}
+
+// commentWriter returns the given Introspector as a CommentWriter or an error if the database behind the
+// Introspector cannot be commented.
+func commentWriter(introspector Introspector) (CommentWriter, error) {
+	if _, ok := introspector.(*dumpIntrospector); ok {
+		return nil, fmt.Errorf("comments can only be written into a live database, not into a dump file")
+	}
+	writer, ok := introspector.(CommentWriter)
+	if !ok {
+		return nil, fmt.Errorf("the database driver does not support writing comments")
+	}
+	return writer, nil
+}
+
+// getCommentsToWrite returns the stored descriptions that are not yet written as comments in the database.
+// Empty descriptions are ignored, so writing comments never removes a comment from the database.
+func getCommentsToWrite(repo Repository, cat *catalog) ([]descriptionComment, error) {
+	comments := make([]descriptionComment, 0)
+
+	storedTables, err := repo.GetTables()
+	if err != nil {
+		return comments, err
+	}
+
+	storedColumns, err := repo.GetColumns()
+	if err != nil {
+		return comments, err
+	}
+
+	for _, t := range storedTables {
+		// We cannot comment a table that does not exist anymore in the database.
+		if !cat.hasTable(t.ID) {
+			continue
+		}
+		comment := cat.comment("", t.ID)
+		if t.Description != "" && strings.TrimSpace(t.Description) != strings.TrimSpace(comment) {
+			comments = append(comments, descriptionComment{Table: t.ID, Description: t.Description, Comment: comment})
+		}
+	}
+
+	for _, col := range storedColumns {
+		if _, err := cat.column(col.Name, col.TBName); err != nil {
+			continue
+		}
+		comment := cat.comment(col.Name, col.TBName)
+		if col.Description != "" && strings.TrimSpace(col.Description) != strings.TrimSpace(comment) {
+			comments = append(comments, descriptionComment{Table: col.TBName, Column: col.Name, ColumnID: col.ID,
+				Description: col.Description, Comment: comment})
+		}
+	}
+
+	return comments, nil
+}
+
+// getCommentStatements returns the sql statements needed to write the stored descriptions of the database behind
+// the given Introspector as comments.
+func getCommentStatements(repo Repository, introspector Introspector) (CommentWriter, []string, error) {
+	writer, err := commentWriter(introspector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cat, err := introspector.Catalog()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comments, err := getCommentsToWrite(repo, cat)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	statements, err := writer.CommentStatements(cat, comments)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return writer, statements, nil
+}
+
+// printCommentsScript writes the given statements to w as a sql script.
+func printCommentsScript(w io.Writer, statements []string) error {
+	_, err := fmt.Fprintf(w, "-- %d comment(s) written from the godic data dictionary.\n", len(statements))
+	if err != nil {
+		return err
+	}
+	for _, s := range statements {
+		if _, err := fmt.Fprintf(w, "%s;\n", s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeComments writes the stored descriptions into the database behind the given Introspector as comments.
+// With the writeCommentsScript mode the statements are only written to w so they can be reviewed and applied
+// by hand, with the writeCommentsApply mode they are run against the database.
+func writeComments(repo Repository, introspector Introspector, mode string, w io.Writer) error {
+	writer, statements, err := getCommentStatements(repo, introspector)
+	if err != nil {
+		return err
+	}
+
+	switch mode {
+	case writeCommentsScript:
+		return printCommentsScript(w, statements)
+	case writeCommentsApply:
+		if err := writer.ExecStatements(statements); err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%d comment(s) written into the database.\n", len(statements))
+		return err
+	default:
+		return fmt.Errorf("unknown write_comments mode %s", mode)
+	}
+}
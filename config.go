@@ -4,8 +4,13 @@ import (
 	"bytes"
 	"flag"
 	"github.com/ian-kent/envconf"
+	"strings"
+	"time"
 )
 
+// allSchemas is the value of DatabaseSchema used to read every non system schema of a postgres database.
+const allSchemas = "*"
+
 // Config holds the different configuration options of the database as well as some options for the godic app.
 type Config struct {
 	ServerPort       int    `json:"server_port"`
@@ -16,7 +21,30 @@ type Config struct {
 	DatabaseName     string `json:"database_name"`
 	DatabaseDriver   string `json:"database_driver"`
 	DatabaseSchema   string `json:"database_schema"`
+	DatabaseDump     string `json:"database_dump"`
 	ForceDelete      bool   `json:"force_delete"`
+	CommentsEndpoint bool   `json:"comments_endpoint"`
+	WriteComments    string `json:"write_comments"`
+	Profile          bool   `json:"profile"`
+	ProfileBudget    int    `json:"profile_budget"`
+	ProfileSample    int    `json:"profile_sample"`
+}
+
+// schemas returns the schemas given in DatabaseSchema. Postgres databases can be read from a comma separated
+// list of schemas, e.g. "public,billing,audit".
+func (c *Config) schemas() []string {
+	schemas := make([]string, 0)
+	for _, s := range strings.Split(c.DatabaseSchema, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			schemas = append(schemas, s)
+		}
+	}
+	return schemas
+}
+
+// profileOptions returns the limits of the profiling job given in the Config.
+func (c *Config) profileOptions() profileOptions {
+	return profileOptions{Budget: time.Duration(c.ProfileBudget) * time.Second, SampleRows: int64(c.ProfileSample)}
 }
 
 // validate validates the configuration options given to Config.
@@ -28,6 +56,31 @@ func (c *Config) validate() (ok bool, msg string) {
 		return
 	}
 
+	if c.ProfileBudget <= 0 || c.ProfileSample <= 0 {
+		return false, "The profile_budget and profile_sample flags must be greater than zero."
+	}
+
+	if c.WriteComments != "" && c.WriteComments != writeCommentsApply && c.WriteComments != writeCommentsScript {
+		return false, "The write_comments flag only accepts the values apply or script."
+	}
+
+	// When reading the database from a dump file we do not need any connection options, but we still need
+	// the driver to understand the sql dialect of the dump.
+	if c.DatabaseDump != "" {
+		if c.DatabaseDriver == "" || c.DatabaseName == "" || c.DatabaseSchema == "" {
+			return
+		}
+		return true, ""
+	}
+
+	// sqlite databases are just files, so we only need the path to the file given in DatabaseName.
+	if c.DatabaseDriver == "sqlite" {
+		if c.DatabaseName == "" {
+			return
+		}
+		return true, ""
+	}
+
 	if c.DatabaseUser == "" || c.DatabasePassword == "" || c.DatabaseHost == "" || c.DatabasePort == 0 ||
 		c.DatabaseSchema == "" || c.DatabaseDriver == "" || c.DatabaseName == "" {
 		return
@@ -48,10 +101,16 @@ func ParseFlags(programName string, args []string) (config *Config, output strin
 	flags.StringVar(&conf.DatabasePassword, "db_password", envconf.FromEnvP("GODIC_DB_PASSWORD", "").(string), "database password")
 	flags.StringVar(&conf.DatabaseHost, "db_host", envconf.FromEnvP("GODIC_DB_HOST", "").(string), "database host")
 	flags.IntVar(&conf.DatabasePort, "db_port", envconf.FromEnvP("GODIC_DB_PORT", 5432).(int), "database port")
-	flags.StringVar(&conf.DatabaseName, "db_name", envconf.FromEnvP("GODIC_DB_NAME", "").(string), "database name")
+	flags.StringVar(&conf.DatabaseName, "db_name", envconf.FromEnvP("GODIC_DB_NAME", "").(string), "database name (for sqlite the path to the database file)")
 	flags.StringVar(&conf.DatabaseDriver, "db_driver", envconf.FromEnvP("GODIC_DB_DRIVER", "").(string), "database driver")
-	flags.StringVar(&conf.DatabaseSchema, "db_schema", envconf.FromEnvP("GODIC_DB_SCHEMA", "public").(string), "database schema")
+	flags.StringVar(&conf.DatabaseSchema, "db_schema", envconf.FromEnvP("GODIC_DB_SCHEMA", "public").(string), "database schema (for postgres a comma separated list of schemas, or * for all the non system schemas)")
+	flags.StringVar(&conf.DatabaseDump, "db_dump", envconf.FromEnvP("GODIC_DB_DUMP", "").(string), "path to a schema only dump file (pg_dump --schema-only or mysqldump --no-data) used instead of a database connection")
 	flags.BoolVar(&conf.ForceDelete, "force_delete", envconf.FromEnvP("GODIC_FORCE_DELETE", false).(bool), "deletes completely any stored metadata of a database in order to start fresh")
+	flags.BoolVar(&conf.CommentsEndpoint, "comments_endpoint", envconf.FromEnvP("GODIC_COMMENTS_ENDPOINT", false).(bool), "enables the /write-comments endpoint that writes the stored descriptions into the database as comments")
+	flags.StringVar(&conf.WriteComments, "write_comments", envconf.FromEnvP("GODIC_WRITE_COMMENTS", "").(string), "writes the stored descriptions into the database as comments and exits, use apply to run the statements or script to print them as a sql script")
+	flags.BoolVar(&conf.Profile, "profile", envconf.FromEnvP("GODIC_PROFILE", false).(bool), "profiles the columns of the tables opted in for profiling and exits")
+	flags.IntVar(&conf.ProfileBudget, "profile_budget", envconf.FromEnvP("GODIC_PROFILE_BUDGET", 60).(int), "seconds after which the profiling job stops, the columns left are profiled first in the next run")
+	flags.IntVar(&conf.ProfileSample, "profile_sample", envconf.FromEnvP("GODIC_PROFILE_SAMPLE", 10000).(int), "maximum number of rows of a table sampled to profile its columns")
 
 	err = flags.Parse(args)
 	if err != nil {
@@ -0,0 +1,990 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+// psqlTestDump is the output of pg_dump --schema-only for the psql testing database.
+const psqlTestDump = `
+--
+-- PostgreSQL database dump
+--
+
+SET statement_timeout = 0;
+SET client_encoding = 'UTF8';
+SET standard_conforming_strings = on;
+SELECT pg_catalog.set_config('search_path', '', false);
+
+--
+-- Name: counting_option; Type: TYPE; Schema: public; Owner: test
+--
+
+CREATE TYPE public.counting_option AS ENUM (
+    'unit',
+    'decimal'
+);
+
+ALTER TYPE public.counting_option OWNER TO test;
+
+CREATE FUNCTION public.touch() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+    NEW.name := 'touched; ' || $1;
+    RETURN NEW;
+END;
+$$;
+
+SET default_tablespace = '';
+
+--
+-- Name: order; Type: TABLE; Schema: public; Owner: test
+--
+
+CREATE TABLE public."order" (
+    id integer NOT NULL
+);
+
+CREATE TABLE public.order_line (
+    id integer NOT NULL,
+    order_id integer NOT NULL,
+    product_id integer NOT NULL
+);
+
+CREATE SEQUENCE public.order_line_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+CREATE TABLE public.product (
+    id integer NOT NULL,
+    name character varying(200) NOT NULL,
+    counting_option public.counting_option NOT NULL
+);
+
+CREATE TABLE audit.log (
+    id integer NOT NULL
+);
+
+ALTER TABLE ONLY public.order_line ALTER COLUMN id SET DEFAULT nextval('public.order_line_id_seq'::regclass);
+
+ALTER TABLE ONLY public.order_line
+    ADD CONSTRAINT order_line_pk PRIMARY KEY (id);
+
+ALTER TABLE ONLY public."order"
+    ADD CONSTRAINT order_pk PRIMARY KEY (id);
+
+ALTER TABLE ONLY public.product
+    ADD CONSTRAINT product_pk PRIMARY KEY (id);
+
+CREATE UNIQUE INDEX order_id_uindex ON public."order" USING btree (id);
+
+CREATE UNIQUE INDEX order_line_id_uindex ON public.order_line USING btree (id);
+
+CREATE UNIQUE INDEX product_id_uindex ON public.product USING btree (id);
+
+CREATE UNIQUE INDEX product_name_uindex ON public.product USING btree (name);
+
+CREATE INDEX product_lower_name_index ON public.product USING btree (lower((name)::text));
+
+CREATE INDEX order_line_product_id_index ON public.order_line USING hash (product_id) WHERE (product_id > 0);
+
+ALTER TABLE ONLY public.order_line
+    ADD CONSTRAINT order_line_order_id_fk FOREIGN KEY (order_id) REFERENCES public."order"(id) ON DELETE RESTRICT;
+
+ALTER TABLE ONLY public.order_line
+    ADD CONSTRAINT order_line_product_id_fk FOREIGN KEY (product_id) REFERENCES public.product(id) ON DELETE RESTRICT;
+
+COMMENT ON TABLE public.product IS 'The products we sell.';
+
+COMMENT ON COLUMN public.product.name IS 'The name of the product.';
+`
+
+// mysqlTestDump is the output of mysqldump --no-data for the mysql testing database.
+const mysqlTestDump = "" +
+	"-- MySQL dump 10.13  Distrib 8.0.20, for Linux (x86_64)\n" +
+	"/*!40101 SET @OLD_CHARACTER_SET_CLIENT=@@CHARACTER_SET_CLIENT */;\n" +
+	"/*!40101 SET NAMES utf8mb4 */;\n" +
+	"DROP TABLE IF EXISTS `order`;\n" +
+	"/*!40101 SET @saved_cs_client     = @@character_set_client */;\n" +
+	"CREATE TABLE `order` (\n" +
+	"  `id` bigint unsigned NOT NULL AUTO_INCREMENT,\n" +
+	"  PRIMARY KEY (`id`),\n" +
+	"  UNIQUE KEY `id` (`id`)\n" +
+	") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;\n" +
+	"DROP TABLE IF EXISTS `order_line`;\n" +
+	"CREATE TABLE `order_line` (\n" +
+	"  `id` bigint unsigned NOT NULL AUTO_INCREMENT,\n" +
+	"  `order_id` bigint unsigned DEFAULT NULL,\n" +
+	"  `product_id` bigint unsigned DEFAULT NULL,\n" +
+	"  PRIMARY KEY (`id`),\n" +
+	"  UNIQUE KEY `order_line_id_uindex` (`id`),\n" +
+	"  KEY `order_line_order_id_fk` (`order_id`),\n" +
+	"  KEY `order_line_product_id_fk` (`product_id`),\n" +
+	"  CONSTRAINT `order_line_order_id_fk` FOREIGN KEY (`order_id`) REFERENCES `order` (`id`) ON DELETE RESTRICT,\n" +
+	"  CONSTRAINT `order_line_product_id_fk` FOREIGN KEY (`product_id`) REFERENCES `product` (`id`) ON DELETE RESTRICT\n" +
+	") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;\n" +
+	"DROP TABLE IF EXISTS `product`;\n" +
+	"CREATE TABLE `product` (\n" +
+	"  `id` bigint unsigned NOT NULL AUTO_INCREMENT,\n" +
+	"  `name` varchar(200) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL COMMENT 'it''s the name',\n" +
+	"  `counting_option` enum('unit','decimal') NOT NULL,\n" +
+	"  PRIMARY KEY (`id`),\n" +
+	"  UNIQUE KEY `product_id_uindex` (`id`),\n" +
+	"  UNIQUE KEY `product_name_uindex` (`name`)\n" +
+	") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;\n" +
+	"/*!40101 SET character_set_client = @saved_cs_client */;\n"
+
+func Test_parseDump_for_psql_dump(t *testing.T) {
+	cat, err := parseDump(psqlTestDump, "postgres", []string{"public"})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from parseDump; got %s", err)
+	}
+
+	expectedTables := []string{"public.order", "public.order_line", "public.product"}
+	if !reflect.DeepEqual(cat.Tables, expectedTables) {
+		t.Errorf("expected tables %v; got %v", expectedTables, cat.Tables)
+	}
+
+	expectedTable := table{ID: "public.order", Schema: "public", Name: "order", Kind: tableKind,
+		PrimaryKey: &keyConstraint{Name: "order_pk", Columns: []string{"id"}}, ForeignKeys: []keyConstraint{},
+		Indexes: []tableIndex{testIndex("order_pk", true, "id"), testIndex("order_id_uindex", true, "id")},
+		Checks:  []checkConstraint{}}
+	if tb := cat.table("public.order"); !reflect.DeepEqual(tb, expectedTable) {
+		t.Errorf("expected table %+v; got %+v", expectedTable, tb)
+	}
+
+	expectedNameCol := column{Name: "name", DBType: "VARCHAR", Nullable: false, GoType: "string", Length: 200}
+	nameCol, err := cat.column("name", "public.product")
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from column; got %s", err)
+	}
+	if !reflect.DeepEqual(nameCol, expectedNameCol) {
+		t.Errorf("expected column %+v; got %+v", expectedNameCol, nameCol)
+	}
+
+	for _, tb := range expectedTables {
+		if !cat.PrimaryKeys.exists("id", tb) {
+			t.Errorf("expected primary key id in table %s", tb)
+		}
+	}
+
+	expectedForeignKeys := ForeignKeys{
+		{
+			Table:       "public.order_line",
+			TargetTable: "public.order",
+			Col:         "order_id",
+			DeleteRule:  "RESTRICT",
+			UpdateRule:  "NO ACTION",
+			TargetCol:   "id",
+			Name:        "order_line_order_id_fk",
+		}, {
+			Table:       "public.order_line",
+			TargetTable: "public.product",
+			Col:         "product_id",
+			DeleteRule:  "RESTRICT",
+			UpdateRule:  "NO ACTION",
+			TargetCol:   "id",
+			Name:        "order_line_product_id_fk",
+		},
+	}
+	if !reflect.DeepEqual(cat.ForeignKeys, expectedForeignKeys) {
+		t.Errorf("expected foreign keys %+v; got %+v", expectedForeignKeys, cat.ForeignKeys)
+	}
+
+	expectedEnums := ColumnsAndEnums{
+		{
+			Table:      "public.product",
+			Col:        "counting_option",
+			EnumName:   "counting_option",
+			EnumValues: "unit,decimal",
+		},
+	}
+	if !reflect.DeepEqual(cat.Enums, expectedEnums) {
+		t.Errorf("expected enums %+v; got %+v", expectedEnums, cat.Enums)
+	}
+
+	if !cat.Uniques.exists("name", "public.product") {
+		t.Errorf("expected unique column name in table product")
+	}
+	if cat.Uniques.exists("lower", "public.product") {
+		t.Errorf("expression indexes shouldn't be read as unique columns")
+	}
+
+	if tb := cat.table("public.product"); tb.Description != "The products we sell." {
+		t.Errorf("expected the description of table product to be its comment; got (%s)", tb.Description)
+	}
+	if comment := cat.comment("name", "public.product"); comment != "The name of the product." {
+		t.Errorf("expected the comment of column name of table product; got (%s)", comment)
+	}
+
+	def, err := cat.Defaults.get("id", "public.order_line")
+	if err != nil {
+		t.Fatalf("expected column id in table order_line to have a default; got %s", err)
+	}
+	if def.Default != "nextval('public.order_line_id_seq'::regclass)" {
+		t.Errorf("expected default nextval('public.order_line_id_seq'::regclass); got (%s)", def.Default)
+	}
+}
+
+func Test_parseDump_for_psql_dump_with_several_schemas(t *testing.T) {
+	cat, err := parseDump(psqlTestDump, "postgres", []string{allSchemas})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from parseDump; got %s", err)
+	}
+
+	expectedTables := []string{"public.order", "public.order_line", "public.product", "audit.log"}
+	if !reflect.DeepEqual(cat.Tables, expectedTables) {
+		t.Errorf("expected tables %v; got %v", expectedTables, cat.Tables)
+	}
+
+	cat, err = parseDump(psqlTestDump, "postgres", []string{"audit"})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from parseDump; got %s", err)
+	}
+
+	expectedTables = []string{"audit.log"}
+	if !reflect.DeepEqual(cat.Tables, expectedTables) {
+		t.Errorf("expected tables %v; got %v", expectedTables, cat.Tables)
+	}
+	if len(cat.PrimaryKeys) != 0 {
+		t.Errorf("expected no primary keys from other schemas; got %+v", cat.PrimaryKeys)
+	}
+}
+
+func Test_parseDump_for_mysql_dump(t *testing.T) {
+	cat, err := parseDump(mysqlTestDump, "mysql", []string{"test_db"})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from parseDump; got %s", err)
+	}
+
+	expectedTables := []string{"order", "order_line", "product"}
+	if !reflect.DeepEqual(cat.Tables, expectedTables) {
+		t.Errorf("expected tables %v; got %v", expectedTables, cat.Tables)
+	}
+
+	expectedCols := map[string]column{
+		"id":              {Name: "id", DBType: "BIGINT", Nullable: false, GoType: "uint64"},
+		"name":            {Name: "name", DBType: "VARCHAR", Nullable: false, GoType: "sql.RawBytes", Length: 200},
+		"counting_option": {Name: "counting_option", DBType: "CHAR", Nullable: false, GoType: "sql.RawBytes"},
+	}
+	for name, e := range expectedCols {
+		col, err := cat.column(name, "product")
+		if err != nil {
+			t.Fatalf("we shouldn't get an error from column; got %s", err)
+		}
+		if !reflect.DeepEqual(col, e) {
+			t.Errorf("expected column %+v; got %+v", e, col)
+		}
+	}
+
+	orderID, _ := cat.column("order_id", "order_line")
+	if !orderID.Nullable {
+		t.Errorf("expected column order_id of table order_line to be nullable")
+	}
+
+	if len(cat.PrimaryKeys) != 3 {
+		t.Errorf("expected 3 primary keys; got %d", len(cat.PrimaryKeys))
+	}
+
+	fk, err := cat.ForeignKeys.get("product_id", "order_line")
+	if err != nil {
+		t.Fatalf("expected foreign key product_id in table order_line; got %s", err)
+	}
+	if fk.TargetTable != "product" || fk.DeleteRule != "RESTRICT" || fk.UpdateRule != "NO ACTION" {
+		t.Errorf("unexpected foreign key %+v", fk)
+	}
+
+	enum, err := cat.Enums.get("counting_option", "product")
+	if err != nil {
+		t.Fatalf("expected enum column counting_option in table product; got %s", err)
+	}
+	if enum.EnumValues != "unit,decimal" {
+		t.Errorf("expected enum values (unit,decimal); got (%s)", enum.EnumValues)
+	}
+
+	if len(cat.Uniques) != 4 {
+		t.Errorf("expected 4 unique columns; got %d", len(cat.Uniques))
+	}
+
+	if comment := cat.comment("name", "product"); comment != "it's the name" {
+		t.Errorf("expected the comment of column name of table product; got (%s)", comment)
+	}
+
+	def, err := cat.Defaults.get("id", "order")
+	if err != nil {
+		t.Fatalf("expected column id in table order to have a default; got %s", err)
+	}
+	if def.Extra != "auto_increment" {
+		t.Errorf("expected extra information (auto_increment); got (%s)", def.Extra)
+	}
+}
+
+func Test_parseDump_with_composite_keys(t *testing.T) {
+	dump := `
+		CREATE TABLE public.product (
+		    id integer PRIMARY KEY
+		);
+
+		CREATE TABLE public.tenant_order (
+		    tenant_id integer NOT NULL,
+		    id integer NOT NULL,
+		    CONSTRAINT tenant_order_pk PRIMARY KEY (tenant_id, id)
+		);
+
+		CREATE TABLE public.order_line (
+		    id integer NOT NULL,
+		    product_id integer REFERENCES public.product,
+		    order_id integer,
+		    tenant_id integer,
+		    FOREIGN KEY (order_id, tenant_id) REFERENCES public.tenant_order (id, tenant_id) ON DELETE CASCADE
+		);
+	`
+	cat, err := parseDump(dump, "postgres", []string{"public"})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from parseDump; got %s", err)
+	}
+
+	expectedPK := &keyConstraint{Name: "tenant_order_pk", Columns: []string{"tenant_id", "id"}}
+	if pk := cat.table("public.tenant_order").PrimaryKey; !reflect.DeepEqual(pk, expectedPK) {
+		t.Errorf("expected primary key %+v; got %+v", expectedPK, pk)
+	}
+
+	expectedFKs := []keyConstraint{
+		{
+			Name:          "order_line_product_id_fkey",
+			Columns:       []string{"product_id"},
+			TargetTable:   "public.product",
+			TargetColumns: []string{"id"},
+			DeleteRule:    "NO ACTION",
+			UpdateRule:    "NO ACTION",
+		}, {
+			Name:          "order_line_order_id_tenant_id_fkey",
+			Columns:       []string{"order_id", "tenant_id"},
+			TargetTable:   "public.tenant_order",
+			TargetColumns: []string{"id", "tenant_id"},
+			DeleteRule:    "CASCADE",
+			UpdateRule:    "NO ACTION",
+		},
+	}
+	if fks := cat.table("public.order_line").ForeignKeys; !reflect.DeepEqual(fks, expectedFKs) {
+		t.Errorf("expected foreign keys %+v; got %+v", expectedFKs, fks)
+	}
+
+	if cat.table("public.order_line").PrimaryKey != nil {
+		t.Errorf("expected table order_line to not have a primary key")
+	}
+}
+
+func Test_parseDump_with_views(t *testing.T) {
+	psqlDump := `
+CREATE TABLE public.product (
+    id integer NOT NULL,
+    name character varying(200) NOT NULL
+);
+
+CREATE VIEW public.product_name AS
+ SELECT product.id,
+    product.name AS product_name
+   FROM public.product;
+
+CREATE MATERIALIZED VIEW public.product_count AS
+ SELECT count(*) AS products
+   FROM public.product
+  WITH NO DATA;
+`
+	cat, err := parseDump(psqlDump, "postgres", []string{"public"})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from parseDump; got %s", err)
+	}
+
+	expectedView := table{ID: "public.product_name", Schema: "public", Name: "product_name", Kind: viewKind,
+		Definition: "SELECT product.id, product.name AS product_name FROM public.product", ForeignKeys: []keyConstraint{},
+		Indexes: []tableIndex{}, Checks: []checkConstraint{}}
+	if tb := cat.table("public.product_name"); !reflect.DeepEqual(tb, expectedView) {
+		t.Errorf("expected view %+v; got %+v", expectedView, tb)
+	}
+	expectedCols := []column{{Name: "id", Nullable: true}, {Name: "product_name", Nullable: true}}
+	if cols := cat.tableColumns("public.product_name"); !reflect.DeepEqual(cols, expectedCols) {
+		t.Errorf("expected view columns %+v; got %+v", expectedCols, cols)
+	}
+
+	mv := cat.table("public.product_count")
+	if mv.Kind != materializedViewKind || mv.Definition != "SELECT count(*) AS products FROM public.product" {
+		t.Errorf("expected the materialized view product_count; got %+v", mv)
+	}
+
+	// mysqldump first writes a stand-in view and replaces it with the real one at the end of the dump.
+	mysqlDump := "" +
+		"CREATE TABLE `product` (\n" +
+		"  `id` bigint unsigned NOT NULL AUTO_INCREMENT,\n" +
+		"  `name` varchar(200) NOT NULL,\n" +
+		"  PRIMARY KEY (`id`)\n" +
+		") ENGINE=InnoDB;\n" +
+		"/*!50001 CREATE VIEW `product_name` AS SELECT \n" +
+		" 1 AS `id`,\n" +
+		" 1 AS `name`*/;\n" +
+		"/*!50001 DROP VIEW IF EXISTS `product_name`*/;\n" +
+		"/*!50001 CREATE ALGORITHM=UNDEFINED */\n" +
+		"/*!50013 DEFINER=`root`@`localhost` SQL SECURITY DEFINER */\n" +
+		"/*!50001 VIEW `product_name` AS select `product`.`id` AS `id`,`product`.`name` AS `name` " +
+		"from `product` */;\n"
+	cat, err = parseDump(mysqlDump, "mysql", []string{"test_db"})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from parseDump; got %s", err)
+	}
+
+	expectedTables := []string{"product", "product_name"}
+	if !reflect.DeepEqual(cat.Tables, expectedTables) {
+		t.Errorf("expected tables %v; got %v", expectedTables, cat.Tables)
+	}
+	view := cat.table("product_name")
+	expectedDefinition := "select `product`.`id` AS `id`, `product`.`name` AS `name` from `product`"
+	if view.Kind != viewKind || view.Definition != expectedDefinition {
+		t.Errorf("expected view product_name with the definition (%s); got %+v", expectedDefinition, view)
+	}
+	expectedCols = []column{{Name: "id", Nullable: true}, {Name: "name", Nullable: true}}
+	if cols := cat.tableColumns("product_name"); !reflect.DeepEqual(cols, expectedCols) {
+		t.Errorf("expected view columns %+v; got %+v", expectedCols, cols)
+	}
+}
+
+func Test_parseDump_with_generated_columns(t *testing.T) {
+	psqlDump := `
+CREATE TABLE public.product (
+    id integer NOT NULL,
+    price numeric DEFAULT 0 NOT NULL,
+    double_price numeric GENERATED ALWAYS AS ((price * (2)::numeric)) STORED,
+    created_at timestamp without time zone DEFAULT now()
+);
+
+ALTER TABLE public.product ALTER COLUMN id ADD GENERATED BY DEFAULT AS IDENTITY (
+    SEQUENCE NAME public.product_id_seq
+    START WITH 1
+);
+`
+	cat, err := parseDump(psqlDump, "postgres", []string{"public"})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from parseDump; got %s", err)
+	}
+
+	expectedDefaults := ColumnsDefaults{
+		{Table: "public.product", Col: "price", Default: "0"},
+		{Table: "public.product", Col: "double_price", Generation: "(price * (2)::numeric)", Extra: "STORED GENERATED"},
+		{Table: "public.product", Col: "created_at", Default: "now()"},
+		{Table: "public.product", Col: "id", Identity: "BY DEFAULT"},
+	}
+	if !reflect.DeepEqual(cat.Defaults, expectedDefaults) {
+		t.Errorf("expected defaults %+v; got %+v", expectedDefaults, cat.Defaults)
+	}
+
+	mysqlDump := "" +
+		"CREATE TABLE `product` (\n" +
+		"  `price` decimal(10,2) NOT NULL DEFAULT '0.00',\n" +
+		"  `double_price` decimal(10,2) GENERATED ALWAYS AS ((`price` * 2)) VIRTUAL,\n" +
+		"  `updated_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP\n" +
+		") ENGINE=InnoDB;\n"
+	cat, err = parseDump(mysqlDump, "mysql", []string{"test_db"})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from parseDump; got %s", err)
+	}
+
+	price, _ := cat.column("price", "product")
+	if price.Precision != 10 || price.Scale != 2 {
+		t.Errorf("expected decimal(10,2) to have precision 10 and scale 2; got %d and %d", price.Precision, price.Scale)
+	}
+
+	expectedDefaults = ColumnsDefaults{
+		{Table: "product", Col: "price", Default: "0.00"},
+		{Table: "product", Col: "double_price", Generation: "(`price` * 2)", Extra: "VIRTUAL GENERATED"},
+		{Table: "product", Col: "updated_at", Default: "CURRENT_TIMESTAMP", Extra: "on update CURRENT_TIMESTAMP"},
+	}
+	if !reflect.DeepEqual(cat.Defaults, expectedDefaults) {
+		t.Errorf("expected defaults %+v; got %+v", expectedDefaults, cat.Defaults)
+	}
+}
+
+func Test_parseDump_with_indexes(t *testing.T) {
+	cat, err := parseDump(psqlTestDump, "postgres", []string{"public"})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from parseDump; got %s", err)
+	}
+
+	expectedIndexes := []tableIndex{
+		testIndex("product_pk", true, "id"),
+		testIndex("product_id_uindex", true, "id"),
+		testIndex("product_name_uindex", true, "name"),
+		{Name: "product_lower_name_index", Keys: []indexKey{{Expression: "lower((name)::text)"}}, Method: "btree"},
+	}
+	if indexes := cat.table("public.product").Indexes; !reflect.DeepEqual(indexes, expectedIndexes) {
+		t.Errorf("expected indexes %+v; got %+v", expectedIndexes, indexes)
+	}
+
+	expectedIndex := tableIndex{Name: "order_line_product_id_index", Keys: []indexKey{{Column: "product_id"}},
+		Method: "hash", Predicate: "(product_id > 0)"}
+	if indexes := cat.table("public.order_line").Indexes; !reflect.DeepEqual(indexes[len(indexes)-1], expectedIndex) {
+		t.Errorf("expected index %+v; got %+v", expectedIndex, indexes[len(indexes)-1])
+	}
+
+	mysqlDump := "" +
+		"CREATE TABLE `product` (\n" +
+		"  `id` bigint unsigned NOT NULL AUTO_INCREMENT,\n" +
+		"  `sku` varchar(20) NOT NULL UNIQUE,\n" +
+		"  `name` varchar(200) NOT NULL,\n" +
+		"  PRIMARY KEY (`id`),\n" +
+		"  KEY `product_name_index` (`name`(10),`id` DESC) USING HASH,\n" +
+		"  KEY `product_lower_name_index` ((lower(`name`))),\n" +
+		"  FULLTEXT KEY `product_name_fulltext` (`name`)\n" +
+		") ENGINE=InnoDB;\n"
+	cat, err = parseDump(mysqlDump, "mysql", []string{"test_db"})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from parseDump; got %s", err)
+	}
+
+	expectedIndexes = []tableIndex{
+		{Name: "sku", Keys: []indexKey{{Column: "sku"}}, Unique: true, Method: "btree"},
+		testIndex("PRIMARY", true, "id"),
+		{Name: "product_name_index", Keys: []indexKey{{Column: "name"}, {Column: "id"}}, Method: "hash"},
+		{Name: "product_lower_name_index", Keys: []indexKey{{Expression: "lower(`name`)"}}, Method: "btree"},
+		{Name: "product_name_fulltext", Keys: []indexKey{{Column: "name"}}, Method: "fulltext"},
+	}
+	if indexes := cat.table("product").Indexes; !reflect.DeepEqual(indexes, expectedIndexes) {
+		t.Errorf("expected indexes %+v; got %+v", expectedIndexes, indexes)
+	}
+}
+
+func Test_parseDump_with_checks(t *testing.T) {
+	psqlDump := `
+CREATE TABLE public.payment (
+    amount numeric(10,2) NOT NULL CHECK ((amount >= (0)::numeric)),
+    refunded numeric(10,2) NOT NULL,
+    CONSTRAINT payment_refunded_check CHECK ((refunded <= amount))
+);
+
+ALTER TABLE public.payment
+    ADD CONSTRAINT payment_status_check CHECK ((refunded IN (0, amount))) NOT VALID;
+`
+	cat, err := parseDump(psqlDump, "postgres", []string{"public"})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from parseDump; got %s", err)
+	}
+
+	expectedChecks := []checkConstraint{
+		{Name: "payment_amount_check", Clause: "amount >= (0)::numeric", Columns: []string{"amount"}},
+		{Name: "payment_refunded_check", Clause: "refunded <= amount", Columns: []string{"amount", "refunded"}},
+		{Name: "payment_status_check", Clause: "refunded IN (0, amount)", Columns: []string{"amount", "refunded"}},
+	}
+	if checks := cat.table("public.payment").Checks; !reflect.DeepEqual(checks, expectedChecks) {
+		t.Errorf("expected check constraints %+v; got %+v", expectedChecks, checks)
+	}
+
+	mysqlDump := "" +
+		"CREATE TABLE `payment` (\n" +
+		"  `amount` decimal(10,2) NOT NULL,\n" +
+		"  CONSTRAINT `payment_chk_1` CHECK ((`amount` >= 0))\n" +
+		") ENGINE=InnoDB;\n"
+	cat, err = parseDump(mysqlDump, "mysql", []string{"test_db"})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from parseDump; got %s", err)
+	}
+
+	expectedChecks = []checkConstraint{{Name: "payment_chk_1", Clause: "`amount` >= 0", Columns: []string{"amount"}}}
+	if checks := cat.table("payment").Checks; !reflect.DeepEqual(checks, expectedChecks) {
+		t.Errorf("expected check constraints %+v; got %+v", expectedChecks, checks)
+	}
+}
+
+func Test_parseDump_with_routines(t *testing.T) {
+	psqlDump := `
+CREATE TABLE public.product (
+    id integer NOT NULL,
+    name character varying(200) NOT NULL
+);
+
+CREATE FUNCTION public.product_name_trim() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+    NEW.name := trim(NEW.name);
+    RETURN NEW;
+END;
+$$;
+
+CREATE PROCEDURE public.archive_order(order_id integer, reason text DEFAULT 'done'::text)
+    LANGUAGE sql
+    AS $_$
+    DELETE FROM order_line WHERE order_line.order_id = $1;
+$_$;
+
+CREATE TRIGGER product_name_trim BEFORE INSERT OR UPDATE OF name ON public.product FOR EACH ROW EXECUTE FUNCTION public.product_name_trim();
+`
+	cat, err := parseDump(psqlDump, "postgres", []string{"public"})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from parseDump; got %s", err)
+	}
+
+	routines := cat.routines()
+	if len(routines) != 3 {
+		t.Fatalf("expected 3 routines; got %d", len(routines))
+	}
+	function := routines[0]
+	if function.ID != "public.product_name_trim" || function.ReturnType != "trigger" ||
+		function.Language != "plpgsql" || function.BodyHash != routineBodyHash("BEGIN\n    NEW.name := trim(NEW.name);\n    RETURN NEW;\nEND;") {
+		t.Errorf("expected the function product_name_trim; got %+v", function)
+	}
+	procedure := routines[1]
+	if procedure.ID != "public.archive_order(order_id integer, reason text)" || procedure.Kind != procedureKind ||
+		procedure.Arguments != "order_id integer, reason text DEFAULT 'done'::text" || procedure.Language != "sql" {
+		t.Errorf("expected the procedure archive_order; got %+v", procedure)
+	}
+	trigger := routines[2]
+	if trigger.ID != "public.product_name_trim ON public.product" || trigger.Table != "public.product" ||
+		trigger.Event != "BEFORE INSERT OR UPDATE FOR EACH ROW" || trigger.Language != "plpgsql" {
+		t.Errorf("expected the trigger product_name_trim on table public.product; got %+v", trigger)
+	}
+
+	mysqlDump := "" +
+		"CREATE TABLE `product` (\n" +
+		"  `id` bigint unsigned NOT NULL AUTO_INCREMENT,\n" +
+		"  `name` varchar(200) NOT NULL,\n" +
+		"  PRIMARY KEY (`id`)\n" +
+		") ENGINE=InnoDB;\n" +
+		"DELIMITER ;;\n" +
+		"/*!50003 CREATE*/ /*!50017 DEFINER=`root`@`localhost`*/ /*!50003 TRIGGER `product_name_trim` " +
+		"BEFORE INSERT ON `product` FOR EACH ROW BEGIN\n" +
+		"  SET NEW.name = TRIM(NEW.name);\n" +
+		"END */;;\n" +
+		"DELIMITER ;\n" +
+		"DELIMITER ;;\n" +
+		"CREATE DEFINER=`root`@`localhost` FUNCTION `order_total`(order_id bigint) RETURNS decimal(10,2)\n" +
+		"    READS SQL DATA\n" +
+		"    DETERMINISTIC\n" +
+		"BEGIN\n" +
+		"  RETURN 0;\n" +
+		"END ;;\n" +
+		"DELIMITER ;\n"
+	cat, err = parseDump(mysqlDump, "mysql", []string{"test_db"})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from parseDump; got %s", err)
+	}
+
+	routines = cat.routines()
+	if len(routines) != 2 {
+		t.Fatalf("expected 2 routines; got %d", len(routines))
+	}
+	trigger = routines[0]
+	if trigger.ID != "product_name_trim ON product" || trigger.Event != "BEFORE INSERT FOR EACH ROW" ||
+		trigger.BodyHash != routineBodyHash("BEGIN SET NEW.name = TRIM(NEW.name); END") {
+		t.Errorf("expected the trigger product_name_trim on table product; got %+v", trigger)
+	}
+	function = routines[1]
+	if function.ID != "order_total" || function.Arguments != "order_id bigint" ||
+		function.ReturnType != "decimal(10, 2)" || function.BodyHash != routineBodyHash("BEGIN RETURN 0; END") {
+		t.Errorf("expected the function order_total; got %+v", function)
+	}
+	if !cat.hasTable("product") {
+		t.Errorf("expected the table product to be read next to the routines")
+	}
+}
+
+func Test_parseDump_with_sequences(t *testing.T) {
+	psqlDump := `
+CREATE TABLE public."order" (
+    id integer NOT NULL
+);
+
+CREATE SEQUENCE public.order_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER SEQUENCE public.order_id_seq OWNED BY public."order".id;
+
+CREATE TABLE public.order_line (
+    id bigint NOT NULL
+);
+
+ALTER TABLE public.order_line ALTER COLUMN id ADD GENERATED BY DEFAULT AS IDENTITY (
+    SEQUENCE NAME public.order_line_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1
+);
+
+CREATE SEQUENCE public.ticket_seq INCREMENT BY 10 MAXVALUE 1000;
+
+SELECT pg_catalog.setval('public.order_id_seq', 1932735282, true);
+SELECT pg_catalog.setval('public.ticket_seq', 500, false);
+`
+	cat, err := parseDump(psqlDump, "postgres", []string{"public"})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from parseDump; got %s", err)
+	}
+
+	expected := Sequences{
+		{ID: "public.order_id_seq", Schema: "public", Name: "order_id_seq", Kind: sequenceKind,
+			Table: "public.order", Column: "id", DataType: "integer", Increment: 1, CurrentValue: 1932735282,
+			MaxValue: 2147483647, Usage: 90, Warning: true},
+		{ID: "public.order_line_id_seq", Schema: "public", Name: "order_line_id_seq", Kind: sequenceKind,
+			Table: "public.order_line", Column: "id", DataType: "bigint", Increment: 1, CurrentValue: 0,
+			MaxValue: 9223372036854775807},
+		{ID: "public.ticket_seq", Schema: "public", Name: "ticket_seq", Kind: sequenceKind, DataType: "bigint",
+			Increment: 10, CurrentValue: 490, MaxValue: 1000, Usage: 49},
+	}
+	if sequences := cat.sequences(); !reflect.DeepEqual(sequences, expected) {
+		t.Errorf("expected sequences %+v; got %+v", expected, sequences)
+	}
+
+	mysqlDump := "" +
+		"CREATE TABLE `order` (\n" +
+		"  `id` mediumint unsigned NOT NULL AUTO_INCREMENT,\n" +
+		"  PRIMARY KEY (`id`)\n" +
+		") ENGINE=InnoDB AUTO_INCREMENT=15000000 DEFAULT CHARSET=utf8mb4;\n" +
+		"CREATE TABLE `product` (\n" +
+		"  `id` int NOT NULL,\n" +
+		"  PRIMARY KEY (`id`)\n" +
+		") ENGINE=InnoDB;\n"
+	cat, err = parseDump(mysqlDump, "mysql", []string{"test_db"})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from parseDump; got %s", err)
+	}
+
+	expected = Sequences{
+		{ID: "order", Name: "order", Kind: autoIncrementKind, Table: "order", Column: "id",
+			DataType: "mediumint unsigned", Increment: 1, CurrentValue: 14999999, MaxValue: 16777215,
+			Usage: 89.41, Warning: true},
+	}
+	if sequences := cat.sequences(); !reflect.DeepEqual(sequences, expected) {
+		t.Errorf("expected auto increment counters %+v; got %+v", expected, sequences)
+	}
+}
+
+func Test_parseDump_with_user_defined_types(t *testing.T) {
+	psqlDump := `
+CREATE TYPE public.mood AS ENUM (
+    'sad',
+    'happy'
+);
+
+CREATE DOMAIN public.email AS character varying(200) NOT NULL
+	CONSTRAINT email_check CHECK (((VALUE)::text ~~ '%@%'::text));
+
+CREATE TYPE public.address AS (
+	street text,
+	zip integer
+);
+
+CREATE TYPE public.floatrange AS RANGE (
+    subtype = double precision
+);
+
+CREATE TABLE public.customer (
+    id integer NOT NULL,
+    email public.email,
+    address public.address,
+    tags text[],
+    moods public.mood[],
+    mood public.mood,
+    active_during tstzrange,
+    weight public.floatrange
+);
+`
+	cat, err := parseDump(psqlDump, "postgres", []string{"public"})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from parseDump; got %s", err)
+	}
+
+	expectedTypes := DataTypes{
+		{ID: "public.mood", Schema: "public", Name: "mood", Kind: enumTypeKind, Values: []string{"sad", "happy"}},
+		{ID: "public.email", Schema: "public", Name: "email", Kind: domainTypeKind, BaseType: "character varying(200)",
+			NotNull: true, Checks: []checkConstraint{{Name: "email_check", Clause: "(VALUE)::text ~~ '%@%'::text",
+				Columns: []string{}}}},
+		{ID: "public.address", Schema: "public", Name: "address", Kind: compositeTypeKind,
+			Attributes: []typeAttribute{{Name: "street", Type: "text"}, {Name: "zip", Type: "integer"}}},
+		{ID: "public.floatrange", Schema: "public", Name: "floatrange", Kind: rangeTypeKind,
+			BaseType: "double precision"},
+	}
+	if types := cat.dataTypes(); !reflect.DeepEqual(types, expectedTypes) {
+		t.Errorf("expected types %+v; got %+v", expectedTypes, types)
+	}
+
+	expectedColTypes := ColumnsTypes{
+		{Table: "public.customer", Col: "email", Kind: domainTypeKind, UserType: "public.email",
+			BaseType: "character varying(200)"},
+		{Table: "public.customer", Col: "address", Kind: compositeTypeKind, UserType: "public.address"},
+		{Table: "public.customer", Col: "tags", Kind: arrayTypeKind, BaseType: "text"},
+		{Table: "public.customer", Col: "moods", Kind: arrayTypeKind, BaseType: "mood"},
+		{Table: "public.customer", Col: "active_during", Kind: rangeTypeKind, BaseType: "timestamp with time zone"},
+		{Table: "public.customer", Col: "weight", Kind: rangeTypeKind, UserType: "public.floatrange",
+			BaseType: "double precision"},
+	}
+	if !reflect.DeepEqual(cat.ColTypes, expectedColTypes) {
+		t.Errorf("expected column types %+v; got %+v", expectedColTypes, cat.ColTypes)
+	}
+
+	if checks := cat.domainChecks("public.email"); !reflect.DeepEqual(checks,
+		[]string{"email_check CHECK ((VALUE)::text ~~ '%@%'::text)"}) {
+		t.Errorf("expected the check constraint of the domain email; got %v", checks)
+	}
+}
+
+func Test_parseDump_with_partitions_and_inheritance(t *testing.T) {
+	psqlDump := `
+CREATE TABLE public.events (
+    id integer NOT NULL,
+    created_at date NOT NULL
+)
+PARTITION BY RANGE (created_at);
+
+CREATE TABLE public.events_2020_01 (
+    id integer NOT NULL,
+    created_at date NOT NULL
+);
+
+CREATE TABLE public.events_2020_02 PARTITION OF public.events FOR VALUES FROM ('2020-02-01') TO ('2020-03-01');
+
+CREATE TABLE public.city (
+    name text NOT NULL
+);
+
+CREATE TABLE public.capital (
+    state character(2) NOT NULL
+)
+INHERITS (public.city);
+
+ALTER TABLE ONLY public.events ATTACH PARTITION public.events_2020_01 FOR VALUES FROM ('2020-01-01') TO ('2020-02-01');
+`
+	cat, err := parseDump(psqlDump, "postgres", []string{"public"})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from parseDump; got %s", err)
+	}
+
+	if expectedTables := []string{"public.events", "public.city", "public.capital"}; !reflect.DeepEqual(cat.Tables,
+		expectedTables) {
+		t.Errorf("expected tables %v; got %v", expectedTables, cat.Tables)
+	}
+
+	events := cat.table("public.events")
+	expectedPartitions := []tablePartition{
+		{Name: "public.events_2020_02", Bound: "FOR VALUES FROM ('2020-02-01') TO ('2020-03-01')"},
+		{Name: "public.events_2020_01", Bound: "FOR VALUES FROM ('2020-01-01') TO ('2020-02-01')"},
+	}
+	if events.PartitionKey != "RANGE (created_at)" || !reflect.DeepEqual(events.Partitions, expectedPartitions) {
+		t.Errorf("expected the table events partitioned by RANGE (created_at) into %+v; got %q %+v",
+			expectedPartitions, events.PartitionKey, events.Partitions)
+	}
+
+	if capital := cat.table("public.capital"); !reflect.DeepEqual(capital.Inherits, []string{"public.city"}) {
+		t.Errorf("expected the table capital to inherit from public.city; got %v", capital.Inherits)
+	}
+
+	mysqlDump := "" +
+		"CREATE TABLE `sale` (\n" +
+		"  `id` int NOT NULL,\n" +
+		"  `sold` date NOT NULL\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4\n" +
+		"/*!50100 PARTITION BY RANGE (year(`sold`))\n" +
+		"(PARTITION p2019 VALUES LESS THAN (2020) ENGINE = InnoDB,\n" +
+		" PARTITION pmax VALUES LESS THAN MAXVALUE ENGINE = InnoDB) */;\n" +
+		"CREATE TABLE `visit` (\n" +
+		"  `id` int NOT NULL\n" +
+		") ENGINE=InnoDB\n" +
+		"/*!50100 PARTITION BY HASH (`id`)\n" +
+		"PARTITIONS 2 */;\n"
+	cat, err = parseDump(mysqlDump, "mysql", []string{"test_db"})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from parseDump; got %s", err)
+	}
+
+	sale := cat.table("sale")
+	expectedPartitions = []tablePartition{
+		{Name: "p2019", Bound: "VALUES LESS THAN (2020)"},
+		{Name: "pmax", Bound: "VALUES LESS THAN (MAXVALUE)"},
+	}
+	if sale.PartitionKey != "RANGE (year(`sold`))" || !reflect.DeepEqual(sale.Partitions, expectedPartitions) {
+		t.Errorf("expected the table sale partitioned by RANGE (year(`sold`)) into %+v; got %q %+v",
+			expectedPartitions, sale.PartitionKey, sale.Partitions)
+	}
+	visit := cat.table("visit")
+	expectedPartitions = []tablePartition{{Name: "p0"}, {Name: "p1"}}
+	if visit.PartitionKey != "HASH (`id`)" || !reflect.DeepEqual(visit.Partitions, expectedPartitions) {
+		t.Errorf("expected the table visit partitioned by HASH (`id`) into %+v; got %q %+v",
+			expectedPartitions, visit.PartitionKey, visit.Partitions)
+	}
+}
+
+func Test_parseDump_with_collations(t *testing.T) {
+	mysqlDump := "" +
+		"CREATE TABLE `customer` (\n" +
+		"  `id` int NOT NULL,\n" +
+		"  `name` varchar(200) NOT NULL,\n" +
+		"  `code` char(3) CHARACTER SET latin1 COLLATE latin1_bin DEFAULT NULL,\n" +
+		"  `notes` text CHARACTER SET utf8 COLLATE utf8_general_ci\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;\n"
+	cat, err := parseDump(mysqlDump, "mysql", []string{"test_db"})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from parseDump; got %s", err)
+	}
+
+	if customer := cat.table("customer"); customer.Charset != "utf8mb4" || customer.Collation != "utf8mb4_0900_ai_ci" {
+		t.Errorf("expected the table customer in utf8mb4 (utf8mb4_0900_ai_ci); got %s (%s)", customer.Charset,
+			customer.Collation)
+	}
+	expectedCollations := map[string][2]string{
+		"name":  {"utf8mb4", "utf8mb4_0900_ai_ci"},
+		"code":  {"latin1", "latin1_bin"},
+		"notes": {"utf8", "utf8_general_ci"},
+	}
+	for _, col := range cat.tableColumns("customer") {
+		colMeta, err := columnMetadataBuilder("customer", col, cat)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := expectedCollations[col.Name]
+		if colMeta.Charset != expected[0] || colMeta.Collation != expected[1] {
+			t.Errorf("expected column %s in %s (%s); got %s (%s)", col.Name, expected[0], expected[1],
+				colMeta.Charset, colMeta.Collation)
+		}
+	}
+
+	psqlDump := `
+CREATE TABLE public.customer (
+    id integer NOT NULL,
+    name character varying(200) NOT NULL,
+    code text COLLATE pg_catalog."C"
+);
+`
+	cat, err = parseDump(psqlDump, "postgres", []string{"public"})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from parseDump; got %s", err)
+	}
+
+	expected := DBCollations{
+		{Table: "public.customer", Col: "name", Collation: "default"},
+		{Table: "public.customer", Col: "code", Collation: "C"},
+	}
+	if !reflect.DeepEqual(cat.Collations, expected) {
+		t.Errorf("expected collations %+v; got %+v", expected, cat.Collations)
+	}
+}
+
+func Test_profiler_for_dump(t *testing.T) {
+	introspector, err := newDumpIntrospector(&Config{DatabaseDriver: "postgres", DatabaseSchema: "public"})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the introspector; got %s", err)
+	}
+
+	_, err = profiler(introspector)
+	if err == nil {
+		t.Errorf("expected an error since a dump file does not have any data to profile")
+	}
+}
@@ -7,6 +7,7 @@ require (
 	github.com/ian-kent/envconf v0.0.0-20141026121121-c19809918c02
 	github.com/jcelliott/lumber v0.0.0-20160324203708-dd349441af25 // indirect
 	github.com/lib/pq v1.6.0
+	github.com/mattn/go-sqlite3 v1.14.6
 	github.com/nanobox-io/golang-scribble v0.0.0-20190309225732-aa3e7c118975
 	github.com/pkg/errors v0.9.1
 	golang.org/x/net v0.0.0-20200226121028-0de0cce0169b
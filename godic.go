@@ -1,31 +1,20 @@
 package main
 
 import (
-	"database/sql"
 	"encoding/json"
 	"flag"
 	"fmt"
-	_ "github.com/go-sql-driver/mysql"
-	_ "github.com/lib/pq"
 	"html/template"
 	"log"
 	"net/http"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 )
 
-var DB *sql.DB
 var _logger *log.Logger
 
-var allowedDrivers = [2]string{
-	"mysql",
-	"postgres",
-}
-
-const psqlDbSource string = "user=%s password=%s host=%s port=%d dbname=%s sslmode=disable"
-const mysqlDbSource string = "{user}:{password}@tcp({host}:{port})/{database}"
-
 func main() {
 	logFile, err := os.OpenFile("./error.log", os.O_RDWR|os.O_CREATE|os.O_APPEND, 0666)
 	if err != nil {
@@ -64,47 +53,52 @@ func run(conf *Config) error {
 		return err
 	}
 
-	source := ""
-	if conf.DatabaseDriver == "mysql" {
-		source = formatMysqlSource(conf)
-	} else if conf.DatabaseDriver == "postgres" {
-		source = fmt.Sprintf(psqlDbSource, conf.DatabaseUser, conf.DatabasePassword, conf.DatabaseHost,
-			conf.DatabasePort, conf.DatabaseName)
-	}
-
 	storage, err := NewJsonStorage()
 	if err != nil {
 		panic(err)
 	}
 
-	db, err := sql.Open(conf.DatabaseDriver, source)
+	introspector, err := openIntrospector(conf)
 	if err != nil {
 		panic(err)
 	}
-	if err = db.Ping(); err != nil {
-		panic(err)
-	}
 
-	DB = db
-	log.Println("You connected to your database: ", conf.DatabaseName)
-
-	if conf.DatabaseDriver == "postgres" {
-		_, err = DB.Exec(fmt.Sprintf("SET search_path=%s", conf.DatabaseSchema))
-		if err != nil {
-			panic(err)
-		}
+	if conf.DatabaseDump != "" {
+		log.Println("You are reading your database from the dump file: ", conf.DatabaseDump)
+	} else {
+		log.Println("You connected to your database: ", conf.DatabaseName)
 	}
 
-	err = setupInitialMetadata(storage, conf)
+	err = setupInitialMetadata(storage, conf, introspector)
 	if err != nil {
 		return err
 	}
 
+	if conf.WriteComments != "" {
+		return writeComments(storage, introspector, conf.WriteComments, os.Stdout)
+	}
+
+	if conf.Profile {
+		report, err := profileTables(storage, introspector, conf.profileOptions())
+		if err != nil {
+			return err
+		}
+		return printProfileReport(os.Stdout, report)
+	}
+
 	mux := http.NewServeMux()
 	mux.HandleFunc("/", index(storage))
 	mux.HandleFunc("/update", updateTableDictionary(storage))
-	mux.HandleFunc("/check-changes", checkDatabaseChanges(storage, conf))
-	mux.HandleFunc("/sync-db", syncDatabase(storage, conf))
+	mux.HandleFunc("/update-routine", updateRoutineDictionary(storage))
+	mux.HandleFunc("/update-type", updateDataTypeDictionary(storage))
+	mux.HandleFunc("/check-changes", checkDatabaseChanges(storage, introspector))
+	mux.HandleFunc("/sync-db", syncDatabase(storage, introspector))
+	mux.HandleFunc("/sequences", getSequences(introspector))
+	mux.HandleFunc("/update-profiling", updateTableProfiling(storage))
+	mux.HandleFunc("/profile", profileDatabase(storage, introspector, conf.profileOptions()))
+	if conf.CommentsEndpoint {
+		mux.HandleFunc("/write-comments", writeDatabaseComments(storage, introspector))
+	}
 	mux.Handle("/favicon.ico", http.NotFoundHandler())
 	mux.HandleFunc("/js/app.js", serveJSDevelopment())
 	mux.Handle("/react-compiled/", http.StripPrefix("/react-compiled", http.FileServer(http.Dir("./react"))))
@@ -156,6 +150,27 @@ func index(repo Repository) http.HandlerFunc {
 			return
 		}
 
+		routines, err := repo.GetRoutines()
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
+		types, err := repo.GetDataTypes()
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
+		stats, err := repo.GetTablesStats()
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
 		production := false
 		if onProduction, _ := strconv.ParseBool(os.Getenv("PRODUCTION")); onProduction {
 			production = onProduction
@@ -165,11 +180,17 @@ func index(repo Repository) http.HandlerFunc {
 			DatabaseInfo databaseInfo
 			Tables       Tables
 			Columns      ColumnsMetadata
+			Routines     Routines
+			Types        DataTypes
+			Stats        TablesStats
 			Production   bool
 		}{
 			info,
 			tables,
 			cols,
+			routines,
+			types,
+			stats,
 			production,
 		}
 
@@ -223,42 +244,201 @@ func updateTableDictionary(repo Repository) http.HandlerFunc {
 	}
 }
 
-func checkDatabaseChanges(repo Repository, conf *Config) http.HandlerFunc {
+// updateRoutineDictionary stores the description of a function, a stored procedure or a trigger.
+func updateRoutineDictionary(repo Repository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, http.StatusText(405), http.StatusMethodNotAllowed)
+			return
+		}
+
+		requestData := struct {
+			RoutineID   string `json:"routine_id"`
+			Description string `json:"description"`
+		}{}
+
+		err := json.NewDecoder(r.Body).Decode(&requestData)
+		// error managed like 500 for simplicity.
+		if err != nil {
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
+		err = repo.UpdateAddRoutineDescription(requestData.RoutineID, requestData.Description)
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// updateDataTypeDictionary stores the description of a user defined type.
+func updateDataTypeDictionary(repo Repository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, http.StatusText(405), http.StatusMethodNotAllowed)
+			return
+		}
+
+		requestData := struct {
+			TypeID      string `json:"type_id"`
+			Description string `json:"description"`
+		}{}
+
+		err := json.NewDecoder(r.Body).Decode(&requestData)
+		// error managed like 500 for simplicity.
+		if err != nil {
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
+		err = repo.UpdateAddDataTypeDescription(requestData.TypeID, requestData.Description)
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// updateTableProfiling opts a table in or out of the profiling job.
+func updateTableProfiling(repo Repository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, http.StatusText(405), http.StatusMethodNotAllowed)
+			return
+		}
+
+		requestData := struct {
+			TableID   string `json:"table_id"`
+			Profiling bool   `json:"profiling"`
+		}{}
+
+		err := json.NewDecoder(r.Body).Decode(&requestData)
+		// error managed like 500 for simplicity.
+		if err != nil {
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
+		err = repo.UpdateTableProfiling(requestData.TableID, requestData.Profiling)
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func checkDatabaseChanges(repo Repository, introspector Introspector) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
 			http.Error(w, http.StatusText(405), http.StatusMethodNotAllowed)
 			return
 		}
 
-		newTables, err := getNewTablesChanges(repo, conf)
+		cat, err := introspector.Catalog()
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
+		newTables, err := getNewTablesChanges(repo, cat)
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
+		deletedTables, err := getDeletedTablesChanges(repo, cat)
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
+		tbChanges, err := getTableChanges(repo, cat)
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
+		colChanges, err := getColumnChanges(repo, cat)
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
+		newCols, err := getNewColumnChanges(repo, cat)
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
+		deletedCols, err := getDeletedColumnsChanges(repo, cat)
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
+		newDescriptions, descriptionConflicts, err := getDescriptionComments(repo, cat)
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
+		newRoutines, err := getNewRoutinesChanges(repo, cat)
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
+		deletedRoutines, err := getDeletedRoutinesChanges(repo, cat)
 		if err != nil {
 			_logger.Println(err)
 			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
 			return
 		}
 
-		deletedTables, err := getDeletedTablesChanges(repo, conf)
+		rtChanges, err := getRoutineChanges(repo, cat)
 		if err != nil {
 			_logger.Println(err)
 			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
 			return
 		}
 
-		colChanges, err := getColumnChanges(repo, conf)
+		newTypes, err := getNewDataTypesChanges(repo, cat)
 		if err != nil {
 			_logger.Println(err)
 			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
 			return
 		}
 
-		newCols, err := getNewColumnChanges(repo, conf)
+		deletedTypes, err := getDeletedDataTypesChanges(repo, cat)
 		if err != nil {
 			_logger.Println(err)
 			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
 			return
 		}
 
-		deletedCols, err := getDeletedColumnsChanges(repo, conf)
+		typeChanges, err := getDataTypeChanges(repo, cat)
 		if err != nil {
 			_logger.Println(err)
 			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
@@ -266,17 +446,35 @@ func checkDatabaseChanges(repo Repository, conf *Config) http.HandlerFunc {
 		}
 
 		responseData := struct {
-			NewTables      []string        `json:"new_tables"`
-			DeletedTables  []string        `json:"deleted_tables"`
-			ColumnChanges  []columnChanges `json:"column_changes"`
-			NewColumns     []newColumn     `json:"new_columns"`
-			DeletedColumns []deletedColumn `json:"deleted_columns"`
+			NewTables            Tables               `json:"new_tables"`
+			DeletedTables        Tables               `json:"deleted_tables"`
+			TableChanges         []tableChanges       `json:"table_changes"`
+			ColumnChanges        []columnChanges      `json:"column_changes"`
+			NewColumns           []newColumn          `json:"new_columns"`
+			DeletedColumns       []deletedColumn      `json:"deleted_columns"`
+			NewDescriptions      []descriptionComment `json:"new_descriptions"`
+			DescriptionConflicts []descriptionComment `json:"description_conflicts"`
+			NewRoutines          Routines             `json:"new_routines"`
+			DeletedRoutines      Routines             `json:"deleted_routines"`
+			RoutineChanges       []routineChanges     `json:"routine_changes"`
+			NewTypes             DataTypes            `json:"new_types"`
+			DeletedTypes         DataTypes            `json:"deleted_types"`
+			TypeChanges          []dataTypeChanges    `json:"type_changes"`
 		}{
 			newTables,
 			deletedTables,
+			tbChanges,
 			colChanges,
 			newCols,
 			deletedCols,
+			newDescriptions,
+			descriptionConflicts,
+			newRoutines,
+			deletedRoutines,
+			rtChanges,
+			newTypes,
+			deletedTypes,
+			typeChanges,
 		}
 
 		sb, err := json.MarshalIndent(responseData, "", strings.Repeat(" ", 3))
@@ -295,15 +493,22 @@ func checkDatabaseChanges(repo Repository, conf *Config) http.HandlerFunc {
 	}
 }
 
-func syncDatabase(repo Repository, conf *Config) http.HandlerFunc {
+func syncDatabase(repo Repository, introspector Introspector) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
 			http.Error(w, http.StatusText(405), http.StatusMethodNotAllowed)
 			return
 		}
 
+		cat, err := introspector.Catalog()
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
 		// Let's remove the tables that do not exist anymore.
-		deletedTables, err := getDeletedTablesChanges(repo, conf)
+		deletedTables, err := getDeletedTablesChanges(repo, cat)
 		if err != nil {
 			_logger.Println(err)
 			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
@@ -311,7 +516,7 @@ func syncDatabase(repo Repository, conf *Config) http.HandlerFunc {
 		}
 
 		for _, dt := range deletedTables {
-			err := repo.RemoveTable(dt)
+			err := repo.RemoveTable(dt.ID)
 			if err != nil {
 				_logger.Println(err)
 				http.Error(w, http.StatusText(500), http.StatusInternalServerError)
@@ -320,7 +525,7 @@ func syncDatabase(repo Repository, conf *Config) http.HandlerFunc {
 		}
 
 		// Let's add the new tables and their columns metadata.
-		newTables, err := getNewTablesChanges(repo, conf)
+		newTables, err := getNewTablesChanges(repo, cat)
 		if err != nil {
 			_logger.Println(err)
 			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
@@ -328,27 +533,20 @@ func syncDatabase(repo Repository, conf *Config) http.HandlerFunc {
 		}
 
 		for _, nt := range newTables {
-			t := table{Name: nt}
-			err = repo.AddTable(t)
+			err = repo.AddTable(nt)
 			if err != nil {
 				_logger.Println(err)
 				http.Error(w, http.StatusText(500), http.StatusInternalServerError)
 				return
 			}
-			cols, err := getTableColumns(nt, conf)
-			if err != nil {
-				_logger.Println(err)
-				http.Error(w, http.StatusText(500), http.StatusInternalServerError)
-				return
-			}
-			for _, col := range cols {
-				colMeta, err := columnMetadataBuilder(nt, col, conf)
+			for _, col := range cat.tableColumns(nt.ID) {
+				colMeta, err := columnMetadataBuilder(nt.ID, col, cat)
 				if err != nil {
 					_logger.Println(err)
 					http.Error(w, http.StatusText(500), http.StatusInternalServerError)
 					return
 				}
-				err = repo.AddColMetaData(nt, colMeta)
+				err = repo.AddColMetaData(nt.ID, colMeta)
 				if err != nil {
 					_logger.Println(err)
 					http.Error(w, http.StatusText(500), http.StatusInternalServerError)
@@ -357,9 +555,44 @@ func syncDatabase(repo Repository, conf *Config) http.HandlerFunc {
 			}
 		}
 
+		// Let's update the keys of the existing tables, the descriptions of the tables are preserved.
+		tbChanges, err := getTableChanges(repo, cat)
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
+		for _, change := range tbChanges {
+			err = repo.UpdateTableMetadata(cat.table(change.ID))
+			if err != nil {
+				_logger.Println(err)
+				http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+				return
+			}
+		}
+
+		// The new and the dropped partitions are not reported as changes, but they are synced as well.
+		unreportedTbChanges, err := getUnreportedTableChanges(repo, cat)
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
+		for _, t := range unreportedTbChanges {
+			err = repo.UpdateTableMetadata(t)
+			if err != nil {
+				_logger.Println(err)
+				http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+				return
+			}
+		}
+
 		// Let update the existing columns with the new changes.
-		// For this, we are going to remove the old column and just create the same column, but with the updates.
-		colChanges, err := getColumnChanges(repo, conf)
+		// For this, we update the structural metadata of the stored columns in place, so the descriptions
+		// written by the users are preserved.
+		colChanges, err := getColumnChanges(repo, cat)
 		if err != nil {
 			_logger.Println(err)
 			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
@@ -368,25 +601,37 @@ func syncDatabase(repo Repository, conf *Config) http.HandlerFunc {
 
 		for _, change := range colChanges {
 			storedColMetadata := change.colMetadata
-			currentCol, err := getTableColumn(storedColMetadata.Name, storedColMetadata.TBName, conf)
+			currentCol, err := cat.column(storedColMetadata.Name, storedColMetadata.TBName)
 			if err != nil {
 				_logger.Println(err)
 				http.Error(w, http.StatusText(500), http.StatusInternalServerError)
 				return
 			}
-			currentColMetadata, err := columnMetadataBuilder(storedColMetadata.TBName, currentCol, conf)
+			currentColMetadata, err := columnMetadataBuilder(storedColMetadata.TBName, currentCol, cat)
 			if err != nil {
 				_logger.Println(err)
 				http.Error(w, http.StatusText(500), http.StatusInternalServerError)
 				return
 			}
-			err = repo.RemoveColMetadata(storedColMetadata.ID)
+			currentColMetadata.ID = storedColMetadata.ID
+			err = repo.UpdateColMetadata(currentColMetadata)
 			if err != nil {
 				_logger.Println(err)
 				http.Error(w, http.StatusText(500), http.StatusInternalServerError)
 				return
 			}
-			err = repo.AddColMetaData(currentColMetadata.TBName, currentColMetadata)
+		}
+
+		// The legacy columns, the widened decimal sizes and the positions shifted by other columns are synced as well.
+		unreportedColChanges, err := getUnreportedColumnChanges(repo, cat)
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
+		for _, col := range unreportedColChanges {
+			err = repo.UpdateColMetadata(col)
 			if err != nil {
 				_logger.Println(err)
 				http.Error(w, http.StatusText(500), http.StatusInternalServerError)
@@ -395,7 +640,7 @@ func syncDatabase(repo Repository, conf *Config) http.HandlerFunc {
 		}
 
 		// Let's add the new columns metadata of existing tables.
-		newCols, err := getNewColumnChanges(repo, conf)
+		newCols, err := getNewColumnChanges(repo, cat)
 		if err != nil {
 			_logger.Println(err)
 			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
@@ -403,13 +648,13 @@ func syncDatabase(repo Repository, conf *Config) http.HandlerFunc {
 		}
 
 		for _, nc := range newCols {
-			col, err := getTableColumn(nc.Name, nc.Table, conf)
+			col, err := cat.column(nc.Name, nc.Table)
 			if err != nil {
 				_logger.Println(err)
 				http.Error(w, http.StatusText(500), http.StatusInternalServerError)
 				return
 			}
-			colMetadata, err := columnMetadataBuilder(nc.Table, col, conf)
+			colMetadata, err := columnMetadataBuilder(nc.Table, col, cat)
 			if err != nil {
 				_logger.Println(err)
 				http.Error(w, http.StatusText(500), http.StatusInternalServerError)
@@ -423,8 +668,29 @@ func syncDatabase(repo Repository, conf *Config) http.HandlerFunc {
 			}
 		}
 
+		// Let's import the comments written in the database as the descriptions of the tables and columns that do
+		// not have one yet. The descriptions written by the users are never overwritten.
+		newDescriptions, _, err := getDescriptionComments(repo, cat)
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+		for _, nd := range newDescriptions {
+			if nd.ColumnID == "" {
+				err = repo.UpdateAddTableDescription(nd.Table, nd.Comment)
+			} else {
+				err = repo.UpdateAddColumnDescription(nd.ColumnID, nd.Comment)
+			}
+			if err != nil {
+				_logger.Println(err)
+				http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+				return
+			}
+		}
+
 		// Let's remove the deleted existing columns.
-		deletedCols, err := getDeletedColumnsChanges(repo, conf)
+		deletedCols, err := getDeletedColumnsChanges(repo, cat)
 		if err != nil {
 			_logger.Println(err)
 			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
@@ -439,11 +705,249 @@ func syncDatabase(repo Repository, conf *Config) http.HandlerFunc {
 			}
 		}
 
+		// Let's sync the functions, stored procedures and triggers. The descriptions of the changed routines are
+		// preserved.
+		deletedRoutines, err := getDeletedRoutinesChanges(repo, cat)
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+		for _, dr := range deletedRoutines {
+			err = repo.RemoveRoutine(dr.ID)
+			if err != nil {
+				_logger.Println(err)
+				http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+				return
+			}
+		}
+
+		rtChanges, err := getRoutineChanges(repo, cat)
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+		newRoutines, err := getNewRoutinesChanges(repo, cat)
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+		currentRoutines := cat.routines()
+		for _, change := range rtChanges {
+			current, err := currentRoutines.get(change.ID)
+			if err != nil {
+				_logger.Println(err)
+				http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+				return
+			}
+			err = repo.UpdateRoutineMetadata(current)
+			if err != nil {
+				_logger.Println(err)
+				http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+				return
+			}
+		}
+		for _, nr := range newRoutines {
+			err = repo.AddRoutine(nr)
+			if err != nil {
+				_logger.Println(err)
+				http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+				return
+			}
+		}
+
+		// Let's sync the user defined types. The descriptions of the changed types are preserved.
+		deletedTypes, err := getDeletedDataTypesChanges(repo, cat)
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+		for _, dt := range deletedTypes {
+			err = repo.RemoveDataType(dt.ID)
+			if err != nil {
+				_logger.Println(err)
+				http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+				return
+			}
+		}
+
+		typeChanges, err := getDataTypeChanges(repo, cat)
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+		newTypes, err := getNewDataTypesChanges(repo, cat)
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+		currentTypes := cat.dataTypes()
+		for _, change := range typeChanges {
+			current, err := currentTypes.get(change.ID)
+			if err != nil {
+				_logger.Println(err)
+				http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+				return
+			}
+			err = repo.UpdateDataTypeMetadata(current)
+			if err != nil {
+				_logger.Println(err)
+				http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+				return
+			}
+		}
+		for _, nt := range newTypes {
+			err = repo.AddDataType(nt)
+			if err != nil {
+				_logger.Println(err)
+				http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+				return
+			}
+		}
+
+		// Every sync adds a sample to the time series of statistics of the tables.
+		err = recordTablesStats(repo, cat, time.Now().UTC())
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
 		// If all goes well, we have successfully synced the database with the new changes.
 		w.WriteHeader(http.StatusOK)
 	}
 }
 
+// writeDatabaseComments writes the stored descriptions into the database as comments. A GET request returns the
+// statements as a sql script to be reviewed, a POST request runs them against the database.
+func writeDatabaseComments(repo Repository, introspector Introspector) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodPost {
+			http.Error(w, http.StatusText(405), http.StatusMethodNotAllowed)
+			return
+		}
+
+		writer, statements, err := getCommentStatements(repo, introspector)
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
+		if r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			w.WriteHeader(http.StatusOK)
+			err = printCommentsScript(w, statements)
+			if err != nil {
+				_logger.Println(err)
+			}
+			return
+		}
+
+		err = writer.ExecStatements(statements)
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
+		responseData := struct {
+			Statements []string `json:"statements"`
+		}{
+			statements,
+		}
+
+		sb, err := json.MarshalIndent(responseData, "", strings.Repeat(" ", 3))
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(sb)
+		if err != nil {
+			_logger.Println(err)
+		}
+	}
+}
+
+// getSequences returns the sequences and the auto increment counters of the database with their usage. They are
+// read live from the database instead of the repository, as their current values change all the time.
+func getSequences(introspector Introspector) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, http.StatusText(405), http.StatusMethodNotAllowed)
+			return
+		}
+
+		cat, err := introspector.Catalog()
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
+		responseData := struct {
+			Sequences Sequences `json:"sequences"`
+		}{
+			cat.sequences(),
+		}
+
+		sb, err := json.MarshalIndent(responseData, "", strings.Repeat(" ", 3))
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(sb)
+		if err != nil {
+			_logger.Println(err)
+		}
+	}
+}
+
+// profileDatabase runs the profiling job on the tables opted in for profiling and returns which columns were
+// profiled and which were left for the next run.
+func profileDatabase(repo Repository, introspector Introspector, opts profileOptions) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, http.StatusText(405), http.StatusMethodNotAllowed)
+			return
+		}
+
+		report, err := profileTables(repo, introspector, opts)
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
+		sb, err := json.MarshalIndent(report, "", strings.Repeat(" ", 3))
+		if err != nil {
+			_logger.Println(err)
+			http.Error(w, http.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(sb)
+		if err != nil {
+			_logger.Println(err)
+		}
+	}
+}
+
 func serveJSDevelopment() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		sb, err := Asset("assets/app.js")
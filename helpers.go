@@ -3,44 +3,16 @@ package main
 import (
 	"database/sql"
 	"fmt"
+	"reflect"
 	"strconv"
 	"strings"
+	"time"
 )
 
-// mysqlVars represents the information needed to make a connection with a mysql database.
-var mysqlVars = map[string]string{
-	"user":     "",
-	"password": "",
-	"host":     "",
-	"port":     "",
-	"database": "",
-}
-
-// formatMysqlSource formats the mysqlVars map into a valid dataSourceName url using the given *Config
-// so we can connect to a mysql database.
-func formatMysqlSource(conf *Config) string {
-	mysqlVars["user"] = conf.DatabaseUser
-	mysqlVars["password"] = conf.DatabasePassword
-	mysqlVars["host"] = conf.DatabaseHost
-	mysqlVars["port"] = strconv.Itoa(conf.DatabasePort)
-	mysqlVars["database"] = conf.DatabaseName
-	format := mysqlDbSource
-	for k, v := range mysqlVars {
-		format = strings.Replace(format, "{"+k+"}", v, -1)
-	}
-	return format
-}
-
 // validateSqlDriver validates whether the given *dbDriver flag to manage the database is allowed or not.
+// A driver is allowed when there is an Introspector registered for it.
 func validateSqlDriver(conf *Config) error {
-	allowed := false
-	for i := range allowedDrivers {
-		if conf.DatabaseDriver == allowedDrivers[i] {
-			allowed = true
-			break
-		}
-	}
-	if !allowed {
+	if _, allowed := introspectors[conf.DatabaseDriver]; !allowed {
 		return fmt.Errorf("the given driver %s is not supported", conf.DatabaseDriver)
 	}
 	return nil
@@ -66,93 +38,182 @@ func parseLengthFromCol(col *sql.ColumnType) int64 {
 	}
 }
 
-// getTableNames will get all table names of the database.
-func getTableNames(conf *Config) ([]string, error) {
-	tableNames := make([]string, 0)
-	q := fmt.Sprintf(`
-		SELECT TABLE_NAME as table_name
-		FROM   information_schema.tables 
-		WHERE  TABLE_TYPE = 'BASE TABLE'
-			   AND TABLE_SCHEMA = '%s';
-	`, conf.DatabaseSchema)
+// parseDecimalSizeFromCol allows us to handle the *sql.ColumnType method DecimalSize().
+// Only the precision and the scale of decimal columns are kept. Drivers that report the declared type of a column,
+// e.g. DECIMAL(12,2) in sqlite, get the sizes of that type. If DecimalSize() fails or the column does not have a
+// declared precision, e.g. a plain postgres numeric, parseDecimalSizeFromCol will gracefully return 0 as the
+// precision and the scale of the column.
+func parseDecimalSizeFromCol(col *sql.ColumnType) (precision int64, scale int64) {
+	dbType := strings.ToUpper(col.DatabaseTypeName())
+	if _, precision, scale := declaredTypeSize(dbType); precision > 0 {
+		return precision, scale
+	}
+	if dbType != "DECIMAL" && dbType != "NUMERIC" {
+		return 0, 0
+	}
+	precision, scale, ok := col.DecimalSize()
+	// Postgres reports an out of range precision for numeric columns without a declared precision.
+	if !ok || precision <= 0 || precision > maxDecimalPrecision {
+		return 0, 0
+	}
+	return precision, scale
+}
+
+// maxDecimalPrecision is the biggest precision of a decimal column supported by the database engines.
+const maxDecimalPrecision = 1000
+
+// declaredTypeSize returns the length of a character type and the precision and the scale of a decimal type given
+// the declared type of a column, e.g. VARCHAR(20) or DECIMAL(12,2). The sizes that are not declared are 0.
+func declaredTypeSize(declType string) (length int64, precision int64, scale int64) {
+	name := strings.ToUpper(declType)
+	start := strings.Index(name, "(")
+	end := strings.LastIndex(name, ")")
+	if start < 0 || end < start {
+		return 0, 0, 0
+	}
+	sizes := make([]int64, 0, 2)
+	for _, size := range strings.Split(name[start+1:end], ",") {
+		n, err := strconv.ParseInt(strings.TrimSpace(size), 10, 64)
+		if err != nil {
+			return 0, 0, 0
+		}
+		sizes = append(sizes, n)
+	}
+	switch name = strings.TrimSpace(name[:start]); {
+	case name == "DECIMAL" || name == "NUMERIC":
+		precision = sizes[0]
+		if len(sizes) > 1 {
+			scale = sizes[1]
+		}
+	case strings.Contains(name, "CHAR") || strings.Contains(name, "BINARY"):
+		length = sizes[0]
+	}
+	return length, precision, scale
+}
+
+// parseGoTypeFromCol allows us to handle the *sql.ColumnType method ScanType().
+// Some drivers (e.g. sqlite) cannot tell the scan type of a column when the query does not return
+// any rows, in that case parseGoTypeFromCol will derive the go type from the declared database type of the
+// column following the sqlite type affinity rules.
+func parseGoTypeFromCol(col *sql.ColumnType) string {
+	if scanType := col.ScanType(); scanType != nil {
+		return scanType.String()
+	}
+	dbType := strings.ToUpper(col.DatabaseTypeName())
+	switch {
+	case strings.Contains(dbType, "INT"):
+		return "int64"
+	case strings.Contains(dbType, "CHAR"), strings.Contains(dbType, "CLOB"), strings.Contains(dbType, "TEXT"):
+		return "string"
+	case dbType == "", strings.Contains(dbType, "BLOB"):
+		return "[]uint8"
+	case strings.Contains(dbType, "DATE"), strings.Contains(dbType, "TIME"):
+		return "time.Time"
+	case strings.Contains(dbType, "BOOL"):
+		return "bool"
+	default:
+		return "float64"
+	}
+}
+
+// columnFromColType creates a column with the structural metadata reported by the given *sql.ColumnType.
+func columnFromColType(col *sql.ColumnType) column {
+	precision, scale := parseDecimalSizeFromCol(col)
+	return column{
+		Name:      col.Name(),
+		DBType:    col.DatabaseTypeName(),
+		Nullable:  parseNullableFromCol(col),
+		GoType:    parseGoTypeFromCol(col),
+		Length:    parseLengthFromCol(col),
+		Precision: precision,
+		Scale:     scale,
+	}
+}
+
+// queryTableNames will get the schema and the name of all tables of the database with the given query.
+func queryTableNames(db *sql.DB, q string) (Tables, error) {
+	tables := make(Tables, 0)
 
-	rows, err := DB.Query(q)
+	rows, err := db.Query(q)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
 	for rows.Next() {
-		var name string
-		if err = rows.Scan(&name); err != nil {
+		var t table
+		if err = rows.Scan(&t.Schema, &t.Name); err != nil {
 			return nil, err
 		}
-		tableNames = append(tableNames, name)
+		tables = append(tables, t)
 	}
 
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
 
-	return tableNames, nil
+	return tables, nil
 }
 
-// getTableColumns will get all the columns of the given table as *sql.ColumnType.
-func getTableColumns(tableName string, conf *Config) ([]*sql.ColumnType, error) {
-	var q string
-
-	if conf.DatabaseDriver == "postgres" {
-		q = fmt.Sprintf(psqlQueryGetColumns, tableName)
-	} else if conf.DatabaseDriver == "mysql" {
-		q = fmt.Sprintf(mysqlQueryGetColumns, tableName)
+// queryViews will get the schema, the name, the kind and the definition of all views of the database with the
+// given query.
+func queryViews(db *sql.DB, q string) (Tables, error) {
+	views := make(Tables, 0)
+	if q == "" {
+		return views, nil
 	}
 
-	rows, err := DB.Query(q)
+	rows, err := db.Query(q)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	return rows.ColumnTypes()
-}
+	for rows.Next() {
+		var v table
+		if err = rows.Scan(&v.Schema, &v.Name, &v.Kind, &v.Definition); err != nil {
+			return nil, err
+		}
+		v.Definition = strings.TrimSuffix(strings.TrimSpace(v.Definition), ";")
+		views = append(views, v)
+	}
 
-// getTableColumn will get the column with the given ColName and in the given TableName as *sql.ColumnType
-func getTableColumn(colName string, tableName string, conf *Config) (*sql.ColumnType, error) {
-	var q string
-	if conf.DatabaseDriver == "postgres" {
-		q = fmt.Sprintf(psqlQueryGetColumn, colName, tableName)
-	} else if conf.DatabaseDriver == "mysql" {
-		q = fmt.Sprintf(mysqlQueryGetColumn, colName, tableName)
+	if err := rows.Err(); err != nil {
+		return nil, err
 	}
-	rows, err := DB.Query(q)
+
+	return views, nil
+}
+
+// queryTableColumns will get all the columns returned by the given query.
+func queryTableColumns(db *sql.DB, q string) ([]column, error) {
+	rows, err := db.Query(q)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	cols, err := rows.ColumnTypes()
+	colTypes, err := rows.ColumnTypes()
 	if err != nil {
 		return nil, err
 	}
-	if len(cols) != 1 {
-		return nil, fmt.Errorf(fmt.Sprintf("getTableColumns should return at least one column. We tried "+
-			"table=%s and col=%s", tableName, colName))
+
+	cols := make([]column, 0, len(colTypes))
+	for _, colType := range colTypes {
+		cols = append(cols, columnFromColType(colType))
 	}
-	return cols[0], nil
+
+	return cols, nil
 }
 
-// getPrimaryKeys will get all columns of the database tables that are primary keys.
-func getPrimaryKeys(conf *Config) (PrimaryKeys, error) {
+// queryPrimaryKeys will get all columns of the database tables that are primary keys with the given query.
+func queryPrimaryKeys(db *sql.DB, q string) (PrimaryKeys, error) {
 	pks := make(PrimaryKeys, 0)
-	var q string
-
-	if conf.DatabaseDriver == "mysql" {
-		q = fmt.Sprintf(mysqlQueryGetPks, conf.DatabaseSchema)
-	} else if conf.DatabaseDriver == "postgres" {
-		q = psqlQueryGetPKs
+	if q == "" {
+		return pks, nil
 	}
 
-	rows, err := DB.Query(q)
+	rows, err := db.Query(q)
 	if err != nil {
 		return pks, err
 	}
@@ -160,7 +221,7 @@ func getPrimaryKeys(conf *Config) (PrimaryKeys, error) {
 
 	for rows.Next() {
 		pk := primaryKey{}
-		if err := rows.Scan(&pk.Col, &pk.Table); err != nil {
+		if err := rows.Scan(&pk.Col, &pk.Table, &pk.Name); err != nil {
 			return pks, err
 		}
 		pks = append(pks, pk)
@@ -173,18 +234,14 @@ func getPrimaryKeys(conf *Config) (PrimaryKeys, error) {
 	return pks, nil
 }
 
-// getForeignKeys will get all columns of the DB tables that are foreign keys.
-func getForeignKeys(conf *Config) (ForeignKeys, error) {
+// queryForeignKeys will get all columns of the DB tables that are foreign keys with the given query.
+func queryForeignKeys(db *sql.DB, q string) (ForeignKeys, error) {
 	fks := make(ForeignKeys, 0)
-	var q string
-
-	if conf.DatabaseDriver == "mysql" {
-		q = fmt.Sprintf(mysqlQueryGetFKs, conf.DatabaseSchema)
-	} else if conf.DatabaseDriver == "postgres" {
-		q = psqlQueryGetFKs
+	if q == "" {
+		return fks, nil
 	}
 
-	rows, err := DB.Query(q)
+	rows, err := db.Query(q)
 	if err != nil {
 		return fks, err
 	}
@@ -192,7 +249,8 @@ func getForeignKeys(conf *Config) (ForeignKeys, error) {
 
 	for rows.Next() {
 		fk := foreignKey{}
-		if err := rows.Scan(&fk.Table, &fk.TargetTable, &fk.Col, &fk.DeleteRule, &fk.UpdateRule); err != nil {
+		if err := rows.Scan(&fk.Table, &fk.TargetTable, &fk.Col, &fk.DeleteRule, &fk.UpdateRule, &fk.TargetCol,
+			&fk.Name); err != nil {
 			return fks, err
 		}
 		fks = append(fks, fk)
@@ -205,18 +263,14 @@ func getForeignKeys(conf *Config) (ForeignKeys, error) {
 	return fks, nil
 }
 
-// getColsAndEnums will get all columns and their corresponding enum types from DB.
-func getColsAndEnums(conf *Config) (ColumnsAndEnums, error) {
+// queryColsAndEnums will get all columns and their corresponding enum types from DB with the given query.
+func queryColsAndEnums(db *sql.DB, q string) (ColumnsAndEnums, error) {
 	ces := make(ColumnsAndEnums, 0)
-	var q string
-
-	if conf.DatabaseDriver == "mysql" {
-		q = fmt.Sprintf(mysqlQueryEnumTypesAndCols, conf.DatabaseSchema)
-	} else if conf.DatabaseDriver == "postgres" {
-		q = psqlQueryEnumTypesAndCols
+	if q == "" {
+		return ces, nil
 	}
 
-	rows, err := DB.Query(q)
+	rows, err := db.Query(q)
 	if err != nil {
 		return ces, err
 	}
@@ -237,18 +291,14 @@ func getColsAndEnums(conf *Config) (ColumnsAndEnums, error) {
 	return ces, nil
 }
 
-// getUniqueCols will get all columns of tables in the database that have a unique index.
-func getUniqueCols(conf *Config) (UniqueCols, error) {
+// queryUniqueCols will get all columns of tables in the database that have a unique index with the given query.
+func queryUniqueCols(db *sql.DB, q string) (UniqueCols, error) {
 	ucs := make(UniqueCols, 0)
-	var q string
-
-	if conf.DatabaseDriver == "mysql" {
-		q = fmt.Sprintf(mysqlQueryGetUniquesColumns, conf.DatabaseSchema)
-	} else if conf.DatabaseDriver == "postgres" {
-		q = fmt.Sprintf(psqlQueryGetUniquesColumns, conf.DatabaseSchema)
+	if q == "" {
+		return ucs, nil
 	}
 
-	rows, err := DB.Query(q)
+	rows, err := db.Query(q)
 	if err != nil {
 		return ucs, err
 	}
@@ -269,312 +319,980 @@ func getUniqueCols(conf *Config) (UniqueCols, error) {
 	return ucs, nil
 }
 
-// compareStoredDatabaseInfoWithConfig is a helper function that checks if the
-// stored database info matches the configuration passed when running the application.
-// If there is no match we might tell the client to use the -force_delete flag.
-func compareStoredDatabaseInfoWithConf(dbInfo databaseInfo, conf *Config) (equal bool, message string) {
-	differences := make([]string, 0)
-	if dbInfo.User != conf.DatabaseUser {
-		differences = append(differences, fmt.Sprintf("stored user %s != %s", dbInfo.User, conf.DatabaseUser))
+// queryIndexes will get the keys of every index of the tables in the database with the given query.
+func queryIndexes(db *sql.DB, q string) (IndexColumns, error) {
+	ics := make(IndexColumns, 0)
+	if q == "" {
+		return ics, nil
 	}
-	if dbInfo.Password != conf.DatabasePassword {
-		differences = append(differences, fmt.Sprintf("stored db password %s != %s", dbInfo.Password, conf.DatabasePassword))
+
+	rows, err := db.Query(q)
+	if err != nil {
+		return ics, err
 	}
-	if dbInfo.Name != conf.DatabaseName {
-		differences = append(differences, fmt.Sprintf("stored db name %s != %s", dbInfo.Name, conf.DatabaseName))
+	defer rows.Close()
+
+	for rows.Next() {
+		ic := indexColumn{}
+		if err := rows.Scan(&ic.Table, &ic.Name, &ic.Unique, &ic.Method, &ic.Predicate, &ic.Col,
+			&ic.Expression); err != nil {
+			return ics, err
+		}
+		ics = append(ics, ic)
 	}
-	if dbInfo.Driver != conf.DatabaseDriver {
-		differences = append(differences, fmt.Sprintf("stored db driver %s != %s", dbInfo.Driver, conf.DatabaseDriver))
+
+	if err := rows.Err(); err != nil {
+		return ics, err
 	}
-	if dbInfo.Host != conf.DatabaseHost {
-		differences = append(differences, fmt.Sprintf("stored db host %s != %s", dbInfo.Host, conf.DatabaseHost))
+
+	return ics, nil
+}
+
+// queryChecks will get the columns of every check constraint of the tables in the database with the given query.
+func queryChecks(db *sql.DB, q string) (CheckColumns, error) {
+	ccs := make(CheckColumns, 0)
+	if q == "" {
+		return ccs, nil
 	}
-	if dbInfo.Port != conf.DatabasePort {
-		differences = append(differences, fmt.Sprintf("stored db port %d != %d", dbInfo.Port, conf.DatabasePort))
+
+	rows, err := db.Query(q)
+	if err != nil {
+		return ccs, err
 	}
-	if dbInfo.Schema != conf.DatabaseSchema {
-		differences = append(differences, fmt.Sprintf("stored db schema %s != %s", dbInfo.Schema, conf.DatabaseSchema))
+	defer rows.Close()
+
+	for rows.Next() {
+		cc := checkColumn{}
+		if err := rows.Scan(&cc.Table, &cc.Name, &cc.Clause, &cc.Col); err != nil {
+			return ccs, err
+		}
+		ccs = append(ccs, cc)
 	}
 
-	if len(differences) > 0 {
-		message = strings.Join(differences, ".\n")
-	} else if len(differences) == 0 {
-		equal = true
+	if err := rows.Err(); err != nil {
+		return ccs, err
 	}
 
-	return
+	return ccs, nil
 }
 
-// getNewTablesChanges will return a [] with the names of all new tables in the database.
-func getNewTablesChanges(repo Repository, conf *Config) (newTables []string, err error) {
-	newTables = make([]string, 0)
-
-	storedTables, err := repo.GetTables()
-	if err != nil {
-		return newTables, err
+// queryComments will get the comments written in the database for its tables and columns with the given query.
+func queryComments(db *sql.DB, q string) (Comments, error) {
+	cs := make(Comments, 0)
+	if q == "" {
+		return cs, nil
 	}
 
-	databaseTableNames, err := getTableNames(conf)
+	rows, err := db.Query(q)
 	if err != nil {
-		return newTables, err
+		return cs, err
 	}
+	defer rows.Close()
 
-	for _, name := range databaseTableNames {
-		isNew := true
-		for _, storedTable := range storedTables {
-			if storedTable.Name == name {
-				isNew = false
-				break
-			}
-		}
-		if isNew {
-			newTables = append(newTables, name)
+	for rows.Next() {
+		c := dbComment{}
+		if err := rows.Scan(&c.Table, &c.Col, &c.Comment); err != nil {
+			return cs, err
 		}
+		cs = append(cs, c)
 	}
 
-	return newTables, nil
-}
+	if err := rows.Err(); err != nil {
+		return cs, err
+	}
 
-// getDeletedTablesChanges will return a [] with the names of the tables that were deleted in the database.
-func getDeletedTablesChanges(repo Repository, conf *Config) (deletedTables []string, err error) {
-	deletedTables = make([]string, 0)
+	return cs, nil
+}
 
-	storedTables, err := repo.GetTables()
-	if err != nil {
-		return deletedTables, err
+// queryColsDefaults will get the defaults and the generation of the columns of the database with the given query.
+func queryColsDefaults(db *sql.DB, q string) (ColumnsDefaults, error) {
+	cds := make(ColumnsDefaults, 0)
+	if q == "" {
+		return cds, nil
 	}
-	databaseTableNames, err := getTableNames(conf)
+
+	rows, err := db.Query(q)
 	if err != nil {
-		return deletedTables, err
+		return cds, err
 	}
+	defer rows.Close()
 
-	for _, storedTable := range storedTables {
-		deleted := true
-		for _, databaseTableName := range databaseTableNames {
-			if storedTable.Name == databaseTableName {
-				deleted = false
-				break
-			}
-		}
-		if deleted {
-			deletedTables = append(deletedTables, storedTable.Name)
+	for rows.Next() {
+		cd := colDefault{}
+		if err := rows.Scan(&cd.Table, &cd.Col, &cd.Default, &cd.Identity, &cd.Generation, &cd.Extra); err != nil {
+			return cds, err
 		}
+		cds = append(cds, cd)
 	}
-	return
+
+	if err := rows.Err(); err != nil {
+		return cds, err
+	}
+
+	return cds, nil
 }
 
-// getNewColumnChanges will return all new columns created in the database of existing stored tables.
-func getNewColumnChanges(repo Repository, conf *Config) ([]newColumn, error) {
-	newCols := make([]newColumn, 0)
+// queryColumnsTypes will get the columns typed with a domain, a composite type, a range type or an array together
+// with their resolved types with the given query.
+func queryColumnsTypes(db *sql.DB, q string) (ColumnsTypes, error) {
+	cts := make(ColumnsTypes, 0)
+	if q == "" {
+		return cts, nil
+	}
 
-	storedTables, err := repo.GetTables()
+	rows, err := db.Query(q)
 	if err != nil {
-		return newCols, err
+		return cts, err
 	}
+	defer rows.Close()
 
-	storedColumnsMetadata, err := repo.GetColumns()
-	if err != nil {
-		return newCols, err
+	for rows.Next() {
+		ct := colType{}
+		if err := rows.Scan(&ct.Table, &ct.Col, &ct.Kind, &ct.UserType, &ct.BaseType); err != nil {
+			return cts, err
+		}
+		cts = append(cts, ct)
+	}
+
+	if err := rows.Err(); err != nil {
+		return cts, err
+	}
+
+	return cts, nil
+}
+
+// queryDataTypes will get the user defined types of the database with the given query.
+func queryDataTypes(db *sql.DB, q string) (DBDataTypes, error) {
+	types := make(DBDataTypes, 0)
+	if q == "" {
+		return types, nil
 	}
 
-	tables, err := getTableNames(conf)
+	rows, err := db.Query(q)
 	if err != nil {
-		return newCols, err
+		return types, err
 	}
+	defer rows.Close()
 
-	for _, name := range tables {
-		// We don't care about new tables's columns so we continue to the next iteration.
-		if !storedTables.exists(name) {
-			continue
-		}
-		tableCols, err := getTableColumns(name, conf)
-		if err != nil {
-			return newCols, err
-		}
-		for _, col := range tableCols {
-			// if err != nil, we understand that we are dealing with a new column in an existing table, in that
-			// case we store the new column in our slice newCols.
-			if _, err := storedColumnsMetadata.getByColNameAndTableName(col.Name(), name); err != nil {
-				nc := newColumn{
-					Name:  col.Name(),
-					Table: name,
-				}
-				newCols = append(newCols, nc)
-			}
+	for rows.Next() {
+		dt := dbDataType{}
+		if err := rows.Scan(&dt.Schema, &dt.Name, &dt.Kind, &dt.BaseType, &dt.NotNull, &dt.Default, &dt.Item,
+			&dt.Definition); err != nil {
+			return types, err
 		}
+		types = append(types, dt)
 	}
 
-	return newCols, nil
+	if err := rows.Err(); err != nil {
+		return types, err
+	}
+
+	return types, nil
 }
 
-// getDeletedColumnsChanges will return a []deletedColumn of all columns that were deleted in the database.
-func getDeletedColumnsChanges(repo Repository, conf *Config) (deletedCols []deletedColumn, err error) {
-	deletedCols = make([]deletedColumn, 0)
+// queryCollations will get the character sets and the collations of the columns and tables with the given query.
+func queryCollations(db *sql.DB, q string) (DBCollations, error) {
+	cs := make(DBCollations, 0)
+	if q == "" {
+		return cs, nil
+	}
 
-	storedTables, err := repo.GetTables()
+	rows, err := db.Query(q)
 	if err != nil {
-		return deletedCols, err
+		return cs, err
 	}
+	defer rows.Close()
 
-	storedColumnsMetadata, err := repo.GetColumns()
-	if err != nil {
-		return deletedCols, err
+	for rows.Next() {
+		c := dbCollation{}
+		if err := rows.Scan(&c.Table, &c.Col, &c.Charset, &c.Collation); err != nil {
+			return cs, err
+		}
+		cs = append(cs, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return cs, err
+	}
+
+	return cs, nil
+}
+
+// queryPartitions will get the partitioned tables with their partitions with the given query.
+func queryPartitions(db *sql.DB, q string) (DBPartitions, error) {
+	ps := make(DBPartitions, 0)
+	if q == "" {
+		return ps, nil
 	}
 
-	tables, err := getTableNames(conf)
+	rows, err := db.Query(q)
 	if err != nil {
-		return deletedCols, err
+		return ps, err
 	}
+	defer rows.Close()
 
-	for _, name := range tables {
-		// We don't care about new tables's columns so we continue to the next iteration.
-		if !storedTables.exists(name) {
-			continue
-		}
-		tableCols, err := getTableColumns(name, conf)
-		if err != nil {
-			return deletedCols, err
+	for rows.Next() {
+		p := dbPartition{}
+		if err := rows.Scan(&p.Table, &p.Key, &p.Partition, &p.Bound); err != nil {
+			return ps, err
 		}
-		storedTableCols := storedColumnsMetadata.getAllColumnsFromTable(name)
+		ps = append(ps, p)
+	}
 
-		for _, storedCol := range storedTableCols {
-			hasBeenDeleted := true
-			for _, currentCol := range tableCols {
-				if storedCol.Name == currentCol.Name() {
-					hasBeenDeleted = false
-					break
-				}
-			}
-			if hasBeenDeleted {
-				dc := deletedColumn{
-					ID:    storedCol.ID,
-					Name:  storedCol.Name,
-					Table: storedCol.TBName,
-				}
-				deletedCols = append(deletedCols, dc)
-			}
-		}
+	if err := rows.Err(); err != nil {
+		return ps, err
 	}
 
-	return deletedCols, nil
+	return ps, nil
 }
 
-// getColumnChanges will return all changes of the columns of the existing stored tables of the database.
-// getColumnChanges will not care about new columns in new tables, but on existing ones.
-func getColumnChanges(repo Repository, conf *Config) (colChanges []columnChanges, err error) {
-	changes := make([]columnChanges, 0)
-
-	storedColumnsMetadata, err := repo.GetColumns()
-	if err != nil {
-		return changes, err
+// queryInherits will get the tables that inherit from other tables with the given query.
+func queryInherits(db *sql.DB, q string) (DBInheritances, error) {
+	is := make(DBInheritances, 0)
+	if q == "" {
+		return is, nil
 	}
 
-	currentTablesNames, err := getTableNames(conf)
+	rows, err := db.Query(q)
 	if err != nil {
-		return changes, err
+		return is, err
 	}
+	defer rows.Close()
 
-	for _, currentTableName := range currentTablesNames {
-		currentColumns, err := getTableColumns(currentTableName, conf)
-		if err != nil {
-			return changes, err
-		}
-		for _, currentCol := range currentColumns {
-			currentColMetadata, err := columnMetadataBuilder(currentTableName, currentCol, conf)
-			if err != nil {
-				return changes, err
-			}
-			storedColMetadata, err := storedColumnsMetadata.getByColNameAndTableName(currentColMetadata.Name, currentColMetadata.TBName)
-			// if there is an err we know here that we are dealing with a new column. If the column is coming from
-			// a non-existent table (meaning new table) we go to the next iteration since we only care about changes in
-			// columns in existing tables.
-			if err != nil {
-				continue
-			}
-			// Here we compare the stored metadata of a column with the current metadata of the same column,
-			// if there are differences we register the changes in columnChanges.
-			if equal, msg, err := compareColumnMetadata(storedColMetadata, currentColMetadata); err != nil {
-				return changes, err
-			} else if !equal {
-				change := columnChanges{
-					colMetadata:    storedColMetadata,
-					ChangesMessage: msg,
-				}
-				changes = append(changes, change)
-			}
+	for rows.Next() {
+		i := dbInheritance{}
+		if err := rows.Scan(&i.Table, &i.Parent); err != nil {
+			return is, err
 		}
+		is = append(is, i)
+	}
 
+	if err := rows.Err(); err != nil {
+		return is, err
 	}
 
-	return changes, nil
+	return is, nil
 }
 
-// columnMetadataBuilder is a helper func that creates a colMetadata object with the correct attributes.
-func columnMetadataBuilder(tableName string, col *sql.ColumnType, conf *Config) (colMetadata, error) {
-	colMetadata := colMetadata{}
-
-	primaryKeys, err := getPrimaryKeys(conf)
-	if err != nil {
-		return colMetadata, err
+// queryRoutines will get the functions, stored procedures or triggers of the database with the given query.
+func queryRoutines(db *sql.DB, q string) (DBRoutines, error) {
+	rs := make(DBRoutines, 0)
+	if q == "" {
+		return rs, nil
 	}
 
-	foreignKeys, err := getForeignKeys(conf)
+	rows, err := db.Query(q)
 	if err != nil {
-		return colMetadata, err
+		return rs, err
 	}
+	defer rows.Close()
 
-	enums, err := getColsAndEnums(conf)
-	if err != nil {
-		return colMetadata, err
+	for rows.Next() {
+		r := dbRoutine{}
+		if err := rows.Scan(&r.Schema, &r.Name, &r.Kind, &r.Signature, &r.Arguments, &r.ReturnType, &r.Language,
+			&r.Table, &r.Event, &r.Body); err != nil {
+			return rs, err
+		}
+		rs = append(rs, r)
 	}
 
-	uniques, err := getUniqueCols(conf)
-	if err != nil {
-		return colMetadata, err
+	if err := rows.Err(); err != nil {
+		return rs, err
 	}
 
-	colMetadata.Name = col.Name()
-	colMetadata.DBType = col.DatabaseTypeName()
-	colMetadata.Nullable = parseNullableFromCol(col)
-	colMetadata.GoType = col.ScanType().String()
-	colMetadata.Length = parseLengthFromCol(col)
-	colMetadata.TBName = tableName
+	return rs, nil
+}
 
-	if isPK := primaryKeys.exists(colMetadata.Name, tableName); isPK {
-		colMetadata.IsPrimaryKey = true
+// querySequences will get the sequences and the auto increment counters of the database with the given query.
+func querySequences(db *sql.DB, q string) (DBSequences, error) {
+	seqs := make(DBSequences, 0)
+	if q == "" {
+		return seqs, nil
 	}
 
-	if isFK := foreignKeys.exists(colMetadata.Name, tableName); isFK {
-		fk, err := foreignKeys.get(colMetadata.Name, tableName)
-		if err != nil {
-			return colMetadata, err
-		}
-		colMetadata.IsForeignKey = true
-		colMetadata.TargetTableFK = fk.TargetTable
-		colMetadata.DeleteRule = fk.DeleteRule
-		colMetadata.UpdateRule = fk.UpdateRule
+	rows, err := db.Query(q)
+	if err != nil {
+		return seqs, err
 	}
+	defer rows.Close()
 
-	if hasEnum := enums.exists(colMetadata.Name, tableName); hasEnum {
-		enum, err := enums.get(colMetadata.Name, tableName)
-		if err != nil {
-			return colMetadata, err
+	for rows.Next() {
+		s := dbSequence{}
+		if err := rows.Scan(&s.Schema, &s.Name, &s.Kind, &s.Table, &s.Col, &s.DataType, &s.Increment,
+			&s.CurrentValue, &s.MaxValue); err != nil {
+			return seqs, err
 		}
-		colMetadata.HasENUM = true
-		colMetadata.ENUMName = enum.EnumName
-		colMetadata.ENUMValues = strings.Split(enum.EnumValues, ",")
+		seqs = append(seqs, s)
 	}
 
-	if hasUniqueIndex := uniques.exists(colMetadata.Name, tableName); hasUniqueIndex {
-		colMetadata.IsUnique = true
+	if err := rows.Err(); err != nil {
+		return seqs, err
 	}
 
-	return colMetadata, nil
+	return seqs, nil
 }
 
-// compareColumnMetadata is a helper function that compares two versions of a column's metadata, the stored one and one
-// created dynamically. This is handy when checking if there are changes in a column of a database table, for example.
-// The returned msg -if any- contains information about the changes in the column. This helper func should always
+// queryTablesStats will get the statistics of the tables of the database with the given query.
+func queryTablesStats(db *sql.DB, q string) (DBTablesStats, error) {
+	stats := make(DBTablesStats, 0)
+	if q == "" {
+		return stats, nil
+	}
+
+	rows, err := db.Query(q)
+	if err != nil {
+		return stats, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		s := dbTableStats{}
+		if err := rows.Scan(&s.Table, &s.Rows, &s.TableSize, &s.IndexSize, &s.LastVacuum, &s.LastAnalyze); err != nil {
+			return stats, err
+		}
+		stats = append(stats, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return stats, err
+	}
+
+	return stats, nil
+}
+
+// recordTablesStats stores the statistics of the tables of the given *catalog taken at the given time, so the
+// repository keeps a time series of them with one sample for every sync.
+func recordTablesStats(repo Repository, cat *catalog, at time.Time) error {
+	for _, s := range cat.Stats {
+		if !cat.hasTable(s.Table) {
+			continue
+		}
+		err := repo.AddTableStats(s.Table, tableStats{
+			Time:        at,
+			Rows:        s.Rows,
+			TableSize:   s.TableSize,
+			IndexSize:   s.IndexSize,
+			LastVacuum:  s.LastVacuum,
+			LastAnalyze: s.LastAnalyze,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// execStatements runs the given statements in a single transaction. Database engines with implicit commits for
+// ddl statements, like mysql, will still keep the statements that ran before a failing one.
+func execStatements(db *sql.DB, statements []string) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+
+	for _, s := range statements {
+		if _, err := tx.Exec(s); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// compareStoredDatabaseInfoWithConfig is a helper function that checks if the
+// stored database info matches the configuration passed when running the application.
+// If there is no match we might tell the client to use the -force_delete flag.
+func compareStoredDatabaseInfoWithConf(dbInfo databaseInfo, conf *Config) (equal bool, message string) {
+	differences := make([]string, 0)
+	if dbInfo.User != conf.DatabaseUser {
+		differences = append(differences, fmt.Sprintf("stored user %s != %s", dbInfo.User, conf.DatabaseUser))
+	}
+	if dbInfo.Password != conf.DatabasePassword {
+		differences = append(differences, fmt.Sprintf("stored db password %s != %s", dbInfo.Password, conf.DatabasePassword))
+	}
+	if dbInfo.Name != conf.DatabaseName {
+		differences = append(differences, fmt.Sprintf("stored db name %s != %s", dbInfo.Name, conf.DatabaseName))
+	}
+	if dbInfo.Driver != conf.DatabaseDriver {
+		differences = append(differences, fmt.Sprintf("stored db driver %s != %s", dbInfo.Driver, conf.DatabaseDriver))
+	}
+	if dbInfo.Host != conf.DatabaseHost {
+		differences = append(differences, fmt.Sprintf("stored db host %s != %s", dbInfo.Host, conf.DatabaseHost))
+	}
+	if dbInfo.Port != conf.DatabasePort {
+		differences = append(differences, fmt.Sprintf("stored db port %d != %d", dbInfo.Port, conf.DatabasePort))
+	}
+	// The schemas added to the configured ones are compatible with the stored data dictionary, as their tables are
+	// synced like new tables, so only the removed schemas are a difference.
+	removed := make([]string, 0)
+	for _, stored := range strings.Split(dbInfo.Schema, ",") {
+		found := false
+		for _, schema := range conf.schemas() {
+			if schema == strings.TrimSpace(stored) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			removed = append(removed, stored)
+		}
+	}
+	if len(removed) > 0 {
+		differences = append(differences, fmt.Sprintf("stored db schemas %s are not in %s anymore",
+			strings.Join(removed, ","), strings.Join(conf.schemas(), ",")))
+	}
+
+	if len(differences) > 0 {
+		message = strings.Join(differences, ".\n")
+	} else if len(differences) == 0 {
+		equal = true
+	}
+
+	return
+}
+
+// getNewTablesChanges will return all new tables in the database.
+func getNewTablesChanges(repo Repository, cat *catalog) (newTables Tables, err error) {
+	newTables = make(Tables, 0)
+
+	storedTables, err := repo.GetTables()
+	if err != nil {
+		return newTables, err
+	}
+
+	for _, id := range cat.Tables {
+		if !storedTables.exists(id) {
+			newTables = append(newTables, cat.table(id))
+		}
+	}
+
+	return newTables, nil
+}
+
+// getDeletedTablesChanges will return the stored tables that were deleted in the database.
+func getDeletedTablesChanges(repo Repository, cat *catalog) (deletedTables Tables, err error) {
+	deletedTables = make(Tables, 0)
+
+	storedTables, err := repo.GetTables()
+	if err != nil {
+		return deletedTables, err
+	}
+
+	for _, storedTable := range storedTables {
+		if !cat.hasTable(storedTable.ID) {
+			deletedTables = append(deletedTables, storedTable)
+		}
+	}
+	return
+}
+
+// getTableChanges will return all changes of the kind, the definition, the keys, the indexes and the check
+// constraints of the existing stored tables of the database.
+func getTableChanges(repo Repository, cat *catalog) ([]tableChanges, error) {
+	changes := make([]tableChanges, 0)
+
+	storedTables, err := repo.GetTables()
+	if err != nil {
+		return changes, err
+	}
+
+	for _, id := range cat.Tables {
+		storedTable, err := storedTables.get(id)
+		// if there is an err we know here that we are dealing with a new table, so we go to the next iteration.
+		if err != nil {
+			continue
+		}
+		if equal, msg := compareTableMetadata(storedTable, cat.table(id)); !equal {
+			changes = append(changes, tableChanges{table: storedTable, ChangesMessage: msg})
+		}
+	}
+
+	return changes, nil
+}
+
+// getUnreportedTableChanges will return the existing stored tables, as they are in the given catalog, with changes
+// that are not reported as changes of the tables but are synced anyway: new or dropped partitions, and the
+// character set and the collation not stored by previous versions of godic.
+func getUnreportedTableChanges(repo Repository, cat *catalog) (Tables, error) {
+	changed := make(Tables, 0)
+
+	storedTables, err := repo.GetTables()
+	if err != nil {
+		return changed, err
+	}
+
+	for _, id := range cat.Tables {
+		storedTable, err := storedTables.get(id)
+		if err != nil {
+			continue
+		}
+		t := cat.table(id)
+		if !reflect.DeepEqual(storedTable.Partitions, t.Partitions) ||
+			(storedTable.Collation == "" && t.Collation != "") {
+			changed = append(changed, t)
+		}
+	}
+
+	return changed, nil
+}
+
+// getDescriptionComments will return the comments written in the database for the stored tables and columns.
+// The comments of the tables and columns without a description are returned as newComments, so they can be
+// imported as descriptions, while the comments that disagree with the stored description are returned as
+// conflicts. The stored descriptions are never overwritten by the comments.
+func getDescriptionComments(repo Repository, cat *catalog) (newComments []descriptionComment,
+	conflicts []descriptionComment, err error) {
+	newComments = make([]descriptionComment, 0)
+	conflicts = make([]descriptionComment, 0)
+
+	storedTables, err := repo.GetTables()
+	if err != nil {
+		return newComments, conflicts, err
+	}
+
+	storedColumns, err := repo.GetColumns()
+	if err != nil {
+		return newComments, conflicts, err
+	}
+
+	for _, c := range cat.Comments {
+		dc := descriptionComment{Table: c.Table, Column: c.Col, Comment: c.Comment}
+		if c.Col == "" {
+			storedTable, err := storedTables.get(c.Table)
+			// if there is an err we know here that we are dealing with a new table, so we go to the next iteration.
+			if err != nil {
+				continue
+			}
+			dc.Description = storedTable.Description
+		} else {
+			storedCol, err := storedColumns.getByColNameAndTableName(c.Col, c.Table)
+			// if there is an err we know here that we are dealing with a new column, so we go to the next iteration.
+			if err != nil {
+				continue
+			}
+			dc.ColumnID = storedCol.ID
+			dc.Description = storedCol.Description
+		}
+
+		if dc.Description == "" {
+			newComments = append(newComments, dc)
+		} else if strings.TrimSpace(dc.Description) != strings.TrimSpace(dc.Comment) {
+			conflicts = append(conflicts, dc)
+		}
+	}
+
+	return newComments, conflicts, nil
+}
+
+// getNewColumnChanges will return all new columns created in the database of existing stored tables.
+func getNewColumnChanges(repo Repository, cat *catalog) ([]newColumn, error) {
+	newCols := make([]newColumn, 0)
+
+	storedTables, err := repo.GetTables()
+	if err != nil {
+		return newCols, err
+	}
+
+	storedColumnsMetadata, err := repo.GetColumns()
+	if err != nil {
+		return newCols, err
+	}
+
+	for _, name := range cat.Tables {
+		// We don't care about new tables's columns so we continue to the next iteration.
+		if !storedTables.exists(name) {
+			continue
+		}
+		for _, col := range cat.tableColumns(name) {
+			// if err != nil, we understand that we are dealing with a new column in an existing table, in that
+			// case we store the new column in our slice newCols.
+			if _, err := storedColumnsMetadata.getByColNameAndTableName(col.Name, name); err != nil {
+				nc := newColumn{
+					Name:  col.Name,
+					Table: name,
+				}
+				newCols = append(newCols, nc)
+			}
+		}
+	}
+
+	return newCols, nil
+}
+
+// getDeletedColumnsChanges will return a []deletedColumn of all columns that were deleted in the database.
+func getDeletedColumnsChanges(repo Repository, cat *catalog) (deletedCols []deletedColumn, err error) {
+	deletedCols = make([]deletedColumn, 0)
+
+	storedTables, err := repo.GetTables()
+	if err != nil {
+		return deletedCols, err
+	}
+
+	storedColumnsMetadata, err := repo.GetColumns()
+	if err != nil {
+		return deletedCols, err
+	}
+
+	for _, name := range cat.Tables {
+		// We don't care about new tables's columns so we continue to the next iteration.
+		if !storedTables.exists(name) {
+			continue
+		}
+		storedTableCols := storedColumnsMetadata.getAllColumnsFromTable(name)
+
+		for _, storedCol := range storedTableCols {
+			if _, err := cat.column(storedCol.Name, name); err != nil {
+				dc := deletedColumn{
+					ID:    storedCol.ID,
+					Name:  storedCol.Name,
+					Table: storedCol.TBName,
+				}
+				deletedCols = append(deletedCols, dc)
+			}
+		}
+	}
+
+	return deletedCols, nil
+}
+
+// getColumnChanges will return all changes of the columns of the existing stored tables of the database.
+// getColumnChanges will not care about new columns in new tables, but on existing ones.
+func getColumnChanges(repo Repository, cat *catalog) (colChanges []columnChanges, err error) {
+	changes := make([]columnChanges, 0)
+
+	storedColumnsMetadata, err := repo.GetColumns()
+	if err != nil {
+		return changes, err
+	}
+
+	for _, currentTableName := range cat.Tables {
+		moved := movedColumns(storedColumnsMetadata.getAllColumnsFromTable(currentTableName),
+			cat.tableColumns(currentTableName))
+		for _, currentCol := range cat.tableColumns(currentTableName) {
+			currentColMetadata, err := columnMetadataBuilder(currentTableName, currentCol, cat)
+			if err != nil {
+				return changes, err
+			}
+			storedColMetadata, err := storedColumnsMetadata.getByColNameAndTableName(currentColMetadata.Name, currentColMetadata.TBName)
+			// if there is an err we know here that we are dealing with a new column. If the column is coming from
+			// a non-existent table (meaning new table) we go to the next iteration since we only care about changes in
+			// columns in existing tables.
+			if err != nil {
+				continue
+			}
+			// Here we compare the stored metadata of a column with the current metadata of the same column,
+			// if there are differences we register the changes in columnChanges.
+			equal, msg, err := compareColumnMetadata(storedColMetadata, currentColMetadata)
+			if err != nil {
+				return changes, err
+			}
+			if moved[currentColMetadata.Name] {
+				move := fmt.Sprintf("column moved from position %d to %d.", storedColMetadata.Position,
+					currentColMetadata.Position)
+				if equal {
+					msg = move
+				} else {
+					msg = strings.Join([]string{msg, move}, ".\n")
+				}
+				equal = false
+			}
+			if !equal {
+				change := columnChanges{
+					colMetadata:    storedColMetadata,
+					ChangesMessage: msg,
+				}
+				changes = append(changes, change)
+			}
+		}
+
+	}
+
+	return changes, nil
+}
+
+// getUnreportedColumnChanges will return the existing stored columns, as they are in the given catalog, that are
+// legacy (see colMetadata.legacy), whose decimal sizes were widened, or whose position only shifted because other
+// columns were added, dropped or moved. They are not reported as changes of the columns but are synced anyway.
+func getUnreportedColumnChanges(repo Repository, cat *catalog) (ColumnsMetadata, error) {
+	changed := make(ColumnsMetadata, 0)
+
+	storedColumnsMetadata, err := repo.GetColumns()
+	if err != nil {
+		return changed, err
+	}
+
+	for _, currentTableName := range cat.Tables {
+		for _, currentCol := range cat.tableColumns(currentTableName) {
+			currentColMetadata, err := columnMetadataBuilder(currentTableName, currentCol, cat)
+			if err != nil {
+				return changed, err
+			}
+			storedColMetadata, err := storedColumnsMetadata.getByColNameAndTableName(currentColMetadata.Name,
+				currentColMetadata.TBName)
+			if err != nil {
+				continue
+			}
+			if storedColMetadata.legacy() || storedColMetadata.Precision != currentColMetadata.Precision ||
+				storedColMetadata.Scale != currentColMetadata.Scale ||
+				storedColMetadata.Position != currentColMetadata.Position {
+				currentColMetadata.ID = storedColMetadata.ID
+				changed = append(changed, currentColMetadata)
+			}
+		}
+	}
+
+	return changed, nil
+}
+
+// movedColumns returns the names of the current columns of a table that were moved by the database, e.g. with the
+// AFTER clause of mysql, given the stored columns of the table sorted by position. The columns keeping their
+// relative order are the longest run of columns in the stored order, so the columns that only shifted because
+// other columns were added, dropped or moved are not moved themselves.
+func movedColumns(storedCols ColumnsMetadata, currentCols []column) map[string]bool {
+	moved := make(map[string]bool)
+	storedRanks := make(map[string]int, len(storedCols))
+	for i, c := range storedCols {
+		if c.legacy() {
+			return moved
+		}
+		storedRanks[c.Name] = i
+	}
+
+	names := make([]string, 0, len(currentCols))
+	ranks := make([]int, 0, len(currentCols))
+	for _, c := range currentCols {
+		if rank, ok := storedRanks[c.Name]; ok {
+			names = append(names, c.Name)
+			ranks = append(ranks, rank)
+		}
+	}
+
+	// lengths[i] is the length of the longest increasing run of ranks ending with ranks[i], which follows ranks[prev[i]].
+	lengths := make([]int, len(ranks))
+	prev := make([]int, len(ranks))
+	last := -1
+	for i := range ranks {
+		lengths[i], prev[i] = 1, -1
+		for j := 0; j < i; j++ {
+			if ranks[j] < ranks[i] && lengths[j]+1 > lengths[i] {
+				lengths[i], prev[i] = lengths[j]+1, j
+			}
+		}
+		if last < 0 || lengths[i] > lengths[last] {
+			last = i
+		}
+	}
+
+	kept := make(map[int]bool, len(ranks))
+	for i := last; i >= 0; i = prev[i] {
+		kept[i] = true
+	}
+	for i, name := range names {
+		if !kept[i] {
+			moved[name] = true
+		}
+	}
+	return moved
+}
+
+// getNewRoutinesChanges will return all new functions, stored procedures and triggers of the database.
+func getNewRoutinesChanges(repo Repository, cat *catalog) (newRoutines Routines, err error) {
+	newRoutines = make(Routines, 0)
+
+	storedRoutines, err := repo.GetRoutines()
+	if err != nil {
+		return newRoutines, err
+	}
+
+	for _, r := range cat.routines() {
+		if !storedRoutines.exists(r.ID) {
+			newRoutines = append(newRoutines, r)
+		}
+	}
+
+	return newRoutines, nil
+}
+
+// getDeletedRoutinesChanges will return the stored routines that were deleted in the database.
+func getDeletedRoutinesChanges(repo Repository, cat *catalog) (deletedRoutines Routines, err error) {
+	deletedRoutines = make(Routines, 0)
+
+	storedRoutines, err := repo.GetRoutines()
+	if err != nil {
+		return deletedRoutines, err
+	}
+
+	routines := cat.routines()
+	for _, storedRoutine := range storedRoutines {
+		if !routines.exists(storedRoutine.ID) {
+			deletedRoutines = append(deletedRoutines, storedRoutine)
+		}
+	}
+
+	return deletedRoutines, nil
+}
+
+// getRoutineChanges will return all changes of the definitions of the existing stored routines of the database.
+func getRoutineChanges(repo Repository, cat *catalog) ([]routineChanges, error) {
+	changes := make([]routineChanges, 0)
+
+	storedRoutines, err := repo.GetRoutines()
+	if err != nil {
+		return changes, err
+	}
+
+	for _, r := range cat.routines() {
+		storedRoutine, err := storedRoutines.get(r.ID)
+		// if there is an err we know here that we are dealing with a new routine, so we go to the next iteration.
+		if err != nil {
+			continue
+		}
+		if equal, msg := compareRoutineMetadata(storedRoutine, r); !equal {
+			changes = append(changes, routineChanges{routine: storedRoutine, ChangesMessage: msg})
+		}
+	}
+
+	return changes, nil
+}
+
+// getNewDataTypesChanges will return all new user defined types of the database.
+func getNewDataTypesChanges(repo Repository, cat *catalog) (newTypes DataTypes, err error) {
+	newTypes = make(DataTypes, 0)
+
+	storedTypes, err := repo.GetDataTypes()
+	if err != nil {
+		return newTypes, err
+	}
+
+	for _, t := range cat.dataTypes() {
+		if !storedTypes.exists(t.ID) {
+			newTypes = append(newTypes, t)
+		}
+	}
+
+	return newTypes, nil
+}
+
+// getDeletedDataTypesChanges will return the stored user defined types that were dropped in the database.
+func getDeletedDataTypesChanges(repo Repository, cat *catalog) (deletedTypes DataTypes, err error) {
+	deletedTypes = make(DataTypes, 0)
+
+	storedTypes, err := repo.GetDataTypes()
+	if err != nil {
+		return deletedTypes, err
+	}
+
+	types := cat.dataTypes()
+	for _, storedType := range storedTypes {
+		if !types.exists(storedType.ID) {
+			deletedTypes = append(deletedTypes, storedType)
+		}
+	}
+
+	return deletedTypes, nil
+}
+
+// getDataTypeChanges will return all changes of the definitions of the existing stored user defined types.
+func getDataTypeChanges(repo Repository, cat *catalog) ([]dataTypeChanges, error) {
+	changes := make([]dataTypeChanges, 0)
+
+	storedTypes, err := repo.GetDataTypes()
+	if err != nil {
+		return changes, err
+	}
+
+	for _, t := range cat.dataTypes() {
+		storedType, err := storedTypes.get(t.ID)
+		// if there is an err we know here that we are dealing with a new type, so we go to the next iteration.
+		if err != nil {
+			continue
+		}
+		if equal, msg := compareDataTypeMetadata(storedType, t); !equal {
+			changes = append(changes, dataTypeChanges{dataType: storedType, ChangesMessage: msg})
+		}
+	}
+
+	return changes, nil
+}
+
+// columnMetadataBuilder is a helper func that creates a colMetadata object with the correct attributes
+// using the metadata of the given catalog.
+func columnMetadataBuilder(tableName string, col column, cat *catalog) (colMetadata, error) {
+	colMetadata := colMetadata{}
+
+	colMetadata.Name = col.Name
+	colMetadata.Position = cat.columnPosition(col.Name, tableName)
+	colMetadata.DBType = col.DBType
+	colMetadata.Nullable = col.Nullable
+	colMetadata.GoType = col.GoType
+	colMetadata.Length = col.Length
+	colMetadata.Precision = col.Precision
+	colMetadata.Scale = col.Scale
+	colMetadata.TBName = tableName
+	colMetadata.Description = cat.comment(col.Name, tableName)
+
+	if isPK := cat.PrimaryKeys.exists(colMetadata.Name, tableName); isPK {
+		colMetadata.IsPrimaryKey = true
+	}
+
+	if isFK := cat.ForeignKeys.exists(colMetadata.Name, tableName); isFK {
+		fk, err := cat.ForeignKeys.get(colMetadata.Name, tableName)
+		if err != nil {
+			return colMetadata, err
+		}
+		colMetadata.IsForeignKey = true
+		colMetadata.TargetTableFK = fk.TargetTable
+		colMetadata.TargetColFK = cat.foreignKeyTargetColumn(fk)
+		colMetadata.DeleteRule = fk.DeleteRule
+		colMetadata.UpdateRule = fk.UpdateRule
+	}
+
+	if hasEnum := cat.Enums.exists(colMetadata.Name, tableName); hasEnum {
+		enum, err := cat.Enums.get(colMetadata.Name, tableName)
+		if err != nil {
+			return colMetadata, err
+		}
+		colMetadata.HasENUM = true
+		colMetadata.ENUMName = enum.EnumName
+		colMetadata.ENUMValues = strings.Split(enum.EnumValues, ",")
+	}
+
+	if ct, err := cat.ColTypes.get(colMetadata.Name, tableName); err == nil {
+		colMetadata.TypeKind = ct.Kind
+		colMetadata.UserType = ct.UserType
+		colMetadata.BaseType = ct.BaseType
+		if ct.Kind == domainTypeKind {
+			colMetadata.DomainChecks = cat.domainChecks(ct.UserType)
+		}
+	}
+
+	if collation, err := cat.Collations.get(colMetadata.Name, tableName); err == nil {
+		colMetadata.Charset = collation.Charset
+		colMetadata.Collation = collation.Collation
+	}
+
+	if hasUniqueIndex := cat.Uniques.exists(colMetadata.Name, tableName); hasUniqueIndex {
+		colMetadata.IsUnique = true
+	}
+
+	if hasDefault := cat.Defaults.exists(colMetadata.Name, tableName); hasDefault {
+		def, err := cat.Defaults.get(colMetadata.Name, tableName)
+		if err != nil {
+			return colMetadata, err
+		}
+		colMetadata.Default = def.Default
+		colMetadata.Identity = def.Identity
+		colMetadata.Generation = def.Generation
+		colMetadata.Extra = def.Extra
+	}
+
+	return colMetadata, nil
+}
+
+// compareColumnMetadata is a helper function that compares two versions of a column's metadata, the stored one and one
+// created dynamically. This is handy when checking if there are changes in a column of a database table, for example.
+// The returned msg -if any- contains information about the changes in the column. This helper func should always
 // compare two instances of the same column metadata.
 func compareColumnMetadata(storedMetadata colMetadata, metadata colMetadata) (equal bool, msg string, err error) {
 	differences := make([]string, 0)
@@ -586,6 +1304,11 @@ func compareColumnMetadata(storedMetadata colMetadata, metadata colMetadata) (eq
 			metadata.TBName, metadata.Name)
 	}
 
+	// recorded checks whether the stored metadata was recorded, see colMetadata.legacy.
+	recorded := func(stored bool) bool {
+		return stored || !storedMetadata.legacy()
+	}
+
 	if storedMetadata.IsUnique != metadata.IsUnique {
 		s := ""
 		if metadata.IsUnique {
@@ -670,6 +1393,52 @@ func compareColumnMetadata(storedMetadata colMetadata, metadata colMetadata) (eq
 			differences = append(differences, fmt.Sprintf("column foreign key is targeting a different table "+
 				"before it was (%s) and not it is (%s).", storedMetadata.TargetTableFK, metadata.TargetTableFK))
 		}
+		if recorded(storedMetadata.TargetColFK != "") && storedMetadata.TargetColFK != metadata.TargetColFK {
+			differences = append(differences, fmt.Sprintf("column foreign key is targeting a different column "+
+				"before it was (%s) and now it is (%s).", storedMetadata.TargetColFK, metadata.TargetColFK))
+		}
+	}
+
+	if recorded(storedMetadata.Default != "") && storedMetadata.Default != metadata.Default {
+		differences = append(differences, fmt.Sprintf("column default changed from (%s) to (%s).",
+			storedMetadata.Default, metadata.Default))
+	}
+
+	if recorded(storedMetadata.Identity != "") && storedMetadata.Identity != metadata.Identity {
+		differences = append(differences, fmt.Sprintf("column identity changed from (%s) to (%s).",
+			storedMetadata.Identity, metadata.Identity))
+	}
+
+	if recorded(storedMetadata.Generation != "") && storedMetadata.Generation != metadata.Generation {
+		differences = append(differences, fmt.Sprintf("column generation expression changed from (%s) to (%s).",
+			storedMetadata.Generation, metadata.Generation))
+	}
+
+	if recorded(storedMetadata.Extra != "") && storedMetadata.Extra != metadata.Extra {
+		differences = append(differences, fmt.Sprintf("column extra information changed from (%s) to (%s).",
+			storedMetadata.Extra, metadata.Extra))
+	}
+
+	if recorded(storedMetadata.TypeKind != "") && (storedMetadata.TypeKind != metadata.TypeKind ||
+		storedMetadata.UserType != metadata.UserType || storedMetadata.BaseType != metadata.BaseType) {
+		differences = append(differences, fmt.Sprintf("column type changed from (%s) to (%s).",
+			columnTypeDescription(storedMetadata), columnTypeDescription(metadata)))
+	}
+
+	if recorded(len(storedMetadata.DomainChecks) > 0) &&
+		strings.Join(storedMetadata.DomainChecks, ", ") != strings.Join(metadata.DomainChecks, ", ") {
+		differences = append(differences, fmt.Sprintf("column domain constraints changed from (%s) to (%s).",
+			strings.Join(storedMetadata.DomainChecks, ", "), strings.Join(metadata.DomainChecks, ", ")))
+	}
+
+	if recorded(storedMetadata.Charset != "") && storedMetadata.Charset != metadata.Charset {
+		differences = append(differences, fmt.Sprintf("column character set changed from (%s) to (%s).",
+			storedMetadata.Charset, metadata.Charset))
+	}
+
+	if recorded(storedMetadata.Collation != "") && storedMetadata.Collation != metadata.Collation {
+		differences = append(differences, fmt.Sprintf("column collation changed from (%s) to (%s).",
+			storedMetadata.Collation, metadata.Collation))
 	}
 
 	if storedMetadata.DBType != metadata.DBType {
@@ -686,6 +1455,18 @@ func compareColumnMetadata(storedMetadata colMetadata, metadata colMetadata) (eq
 		}
 	}
 
+	// Only the narrowed sizes are reported, as they can truncate the values of the column. The widened sizes are
+	// synced without being reported, see getUnreportedColumnChanges.
+	if recorded(storedMetadata.Precision != 0) && narrowedSize(storedMetadata.Precision, metadata.Precision) {
+		differences = append(differences, fmt.Sprintf("column precision narrowed from %d to %d.",
+			storedMetadata.Precision, metadata.Precision))
+	}
+
+	if recorded(storedMetadata.Scale != 0) && metadata.Precision != 0 && metadata.Scale < storedMetadata.Scale {
+		differences = append(differences, fmt.Sprintf("column scale narrowed from %d to %d.",
+			storedMetadata.Scale, metadata.Scale))
+	}
+
 	var message string
 	if len(differences) > 0 {
 		message = strings.Join(differences, ".\n")
@@ -696,115 +1477,307 @@ func compareColumnMetadata(storedMetadata colMetadata, metadata colMetadata) (eq
 	return equal, message, nil
 }
 
-var psqlQueryGetPKs = `
-	SELECT cu.column_name, 
-		   cu.table_name 
-	FROM   information_schema.key_column_usage AS cu 
-		   JOIN information_schema.table_constraints AS tc 
-			 ON tc.constraint_name = cu.constraint_name 
-	WHERE  tc.constraint_type = 'PRIMARY KEY'; 
-`
-
-var mysqlQueryGetPks = `
-	SELECT sta.column_name, 
-		   tab.table_name 
-	FROM   information_schema.tables AS tab 
-		   INNER JOIN information_schema.statistics AS sta 
-				   ON sta.table_schema = tab.table_schema 
-					  AND sta.table_name = tab.table_name 
-					  AND sta.index_name = 'primary' 
-	WHERE  tab.table_schema = '%s' 
-	ORDER  BY tab.table_name;
-`
-
-var psqlQueryGetFKs = `
-	SELECT cu.table_name  AS origin_table_name, 
-		   icu.table_name AS target_table_name, 
-		   cu.column_name, 
-		   rc.delete_rule, 
-		   rc.update_rule 
-	FROM   information_schema.key_column_usage AS cu 
-		   JOIN information_schema.table_constraints AS tc 
-			 ON tc.constraint_name = cu.constraint_name 
-		   JOIN information_schema.referential_constraints AS rc 
-			 ON tc.constraint_name = rc.constraint_name 
-		   JOIN information_schema.constraint_column_usage AS icu 
-			 ON icu.constraint_name = rc.constraint_name 
-	WHERE  tc.constraint_type = 'FOREIGN KEY'; 
-`
-
-var mysqlQueryGetFKs = `
-	SELECT rf.table_name            AS origin_table_name, 
-		   rf.referenced_table_name AS target_table_name, 
-		   kcu.column_name, 
-		   rf.delete_rule, 
-		   rf.update_rule 
-	FROM   information_schema.referential_constraints AS rf 
-		   JOIN information_schema.table_constraints AS tc 
-			 ON rf.constraint_name = tc.constraint_name 
-		   JOIN information_schema.key_column_usage AS kcu 
-			 ON kcu.constraint_name = tc.constraint_name 
-	WHERE  rf.constraint_schema = '%s'; 
-`
-
-var psqlQueryEnumTypesAndCols = `
-	SELECT isc.table_name, 
-		   isc.column_name, 
-		   t.typname                     AS enum_name, 
-		   String_agg(e.enumlabel, ',') AS enum_value 
-	FROM   pg_type AS t 
-		   JOIN pg_enum e 
-			 ON t.oid = e.enumtypid 
-		   JOIN pg_catalog.pg_namespace n 
-			 ON n.oid = t.typnamespace 
-		   JOIN information_schema.columns AS isc 
-			 ON isc.udt_name = t.typname 
-	GROUP  BY enum_name, 
-			  isc.column_name, 
-			  isc.table_name; 
-`
-
-var mysqlQueryEnumTypesAndCols = `
-	SELECT col.table_name  AS table_name, 
-		   col.column_name AS column_name, 
-		   col.data_type   AS enum_type, 
-		   Regexp_replace(REPLACE(col.column_type, 'enum', ''), '[\)\(\']', '') 
-	FROM   information_schema.columns AS col 
-	WHERE  col.data_type = 'enum' 
-		   AND col.table_schema = '%s'; 
-`
-
-var psqlQueryGetUniquesColumns = `
-	SELECT DISTINCT 
-           tbl.relname                     AS table_name, 
-		   pga.attname                     AS column_name
-	FROM   pg_index AS pgi 
-		   JOIN pg_class AS pgc 
-			 ON pgc.oid = pgi.indexrelid 
-		   JOIN pg_namespace AS pgn 
-			 ON pgn.oid = pgc.relnamespace 
-		   JOIN pg_class AS tbl 
-			 ON tbl.oid = pgi.indrelid 
-		   JOIN pg_attribute AS pga 
-			 ON pga.attrelid = pgc.oid 
-	WHERE  pgi.indisunique = true 
-		   AND pgn.nspname = '%s'; 
-`
-
-var mysqlQueryGetUniquesColumns = `
-	SELECT DISTINCT kcu.table_name  AS table_name, 
-					kcu.column_name AS column_name
-	FROM   information_schema.key_column_usage AS kcu 
-	WHERE  kcu.table_schema = '%[1]s' 
-		   AND kcu.constraint_name IN (SELECT tc.constraint_name 
-									   FROM   information_schema.table_constraints 
-											  AS tc 
-									   WHERE  tc.constraint_type = 'UNIQUE' 
-											  AND tc.table_schema = '%[1]s');
-`
-
-var mysqlQueryGetColumns = "SELECT * FROM `%s` LIMIT 0;"
-var psqlQueryGetColumns = "SELECT * FROM %q LIMIT 0;"
-
-var mysqlQueryGetColumn = "SELECT %s FROM `%s` LIMIT 0;"
-var psqlQueryGetColumn = "SELECT %s FROM %q LIMIT 0;"
+// columnTypeDescription describes the resolved type of the given column, e.g. domain public.email over text.
+func columnTypeDescription(col colMetadata) string {
+	switch col.TypeKind {
+	case domainTypeKind:
+		return fmt.Sprintf("domain %s over %s", col.UserType, col.BaseType)
+	case compositeTypeKind:
+		return fmt.Sprintf("composite type %s", col.UserType)
+	case rangeTypeKind:
+		return strings.TrimSpace(fmt.Sprintf("range %s of %s", col.UserType, col.BaseType))
+	case arrayTypeKind:
+		return fmt.Sprintf("array of %s", col.BaseType)
+	default:
+		return col.DBType
+	}
+}
+
+// compareTableMetadata is a helper function that compares the kind, the definition and the keys of two versions of
+// a table, the stored one and the current one read from the database. The returned msg -if any- contains
+// information about the changes in the table. Previous versions of godic did not store the kind, the indexes, the
+// check constraints, the character set and the collation of the tables, so they are only compared once stored.
+func compareTableMetadata(storedTable table, t table) (equal bool, msg string) {
+	differences := make([]string, 0)
+
+	if storedTable.Kind != "" && storedTable.Kind != t.Kind {
+		differences = append(differences, fmt.Sprintf("kind changed from (%s) to (%s)", storedTable.Kind, t.Kind))
+	}
+
+	if normalizeSQL(storedTable.Definition) != normalizeSQL(t.Definition) {
+		differences = append(differences, fmt.Sprintf("%s definition changed from (%s) to (%s)", t.Kind,
+			storedTable.Definition, t.Definition))
+	}
+
+	if storedTable.PrimaryKey.String() != t.PrimaryKey.String() {
+		differences = append(differences, fmt.Sprintf("primary key changed from (%s) to (%s)",
+			storedTable.PrimaryKey, t.PrimaryKey))
+	}
+
+	for i := range storedTable.ForeignKeys {
+		storedFK := &storedTable.ForeignKeys[i]
+		exists := false
+		for j := range t.ForeignKeys {
+			fk := &t.ForeignKeys[j]
+			if fk.Name != storedFK.Name {
+				continue
+			}
+			exists = true
+			if fk.String() != storedFK.String() {
+				differences = append(differences, fmt.Sprintf("foreign key changed from (%s) to (%s)", storedFK, fk))
+			}
+		}
+		if !exists {
+			differences = append(differences, fmt.Sprintf("foreign key (%s) has been removed", storedFK))
+		}
+	}
+
+	for i := range t.ForeignKeys {
+		fk := &t.ForeignKeys[i]
+		exists := false
+		for j := range storedTable.ForeignKeys {
+			if storedTable.ForeignKeys[j].Name == fk.Name {
+				exists = true
+			}
+		}
+		if !exists {
+			differences = append(differences, fmt.Sprintf("new foreign key (%s)", fk))
+		}
+	}
+
+	if storedTable.Indexes != nil {
+		differences = append(differences, compareIndexes(storedTable.Indexes, t.Indexes)...)
+	}
+
+	if storedTable.Checks != nil {
+		differences = append(differences, compareChecks(storedTable.Checks, t.Checks)...)
+	}
+
+	if storedTable.Charset != "" && storedTable.Charset != t.Charset {
+		differences = append(differences, fmt.Sprintf("character set changed from (%s) to (%s)", storedTable.Charset,
+			t.Charset))
+	}
+
+	if storedTable.Collation != "" && storedTable.Collation != t.Collation {
+		differences = append(differences, fmt.Sprintf("collation changed from (%s) to (%s)", storedTable.Collation,
+			t.Collation))
+	}
+
+	// The partitions themselves come and go, e.g. a new one every month, so only a change of the partition key is
+	// reported. See getUnreportedTableChanges.
+	if storedTable.PartitionKey != t.PartitionKey {
+		differences = append(differences, fmt.Sprintf("partition key changed from (%s) to (%s)",
+			storedTable.PartitionKey, t.PartitionKey))
+	}
+
+	if strings.Join(storedTable.Inherits, ", ") != strings.Join(t.Inherits, ", ") {
+		differences = append(differences, fmt.Sprintf("inherited tables changed from (%s) to (%s)",
+			strings.Join(storedTable.Inherits, ", "), strings.Join(t.Inherits, ", ")))
+	}
+
+	if len(differences) > 0 {
+		msg = strings.Join(differences, ".\n")
+	} else if len(differences) == 0 {
+		equal = true
+	}
+
+	return equal, msg
+}
+
+// compareIndexes returns the differences between the given stored indexes and the indexes read from the database.
+func compareIndexes(storedIndexes []tableIndex, indexes []tableIndex) []string {
+	differences := make([]string, 0)
+
+	for _, storedIndex := range storedIndexes {
+		exists := false
+		for _, index := range indexes {
+			if index.Name != storedIndex.Name {
+				continue
+			}
+			exists = true
+			if index.String() != storedIndex.String() {
+				differences = append(differences, fmt.Sprintf("index changed from (%s) to (%s)", storedIndex, index))
+			}
+		}
+		if !exists {
+			differences = append(differences, fmt.Sprintf("index (%s) has been removed", storedIndex))
+		}
+	}
+
+	for _, index := range indexes {
+		exists := false
+		for _, storedIndex := range storedIndexes {
+			if storedIndex.Name == index.Name {
+				exists = true
+			}
+		}
+		if !exists {
+			differences = append(differences, fmt.Sprintf("new index (%s)", index))
+		}
+	}
+
+	return differences
+}
+
+// compareChecks returns the differences between the given stored check constraints and the check constraints
+// read from the database.
+func compareChecks(storedChecks []checkConstraint, checks []checkConstraint) []string {
+	differences := make([]string, 0)
+
+	for _, storedCheck := range storedChecks {
+		exists := false
+		for _, check := range checks {
+			if check.Name != storedCheck.Name {
+				continue
+			}
+			exists = true
+			if normalizeSQL(check.Clause) != normalizeSQL(storedCheck.Clause) {
+				differences = append(differences, fmt.Sprintf("check constraint changed from (%s) to (%s)",
+					storedCheck, check))
+			}
+		}
+		if !exists {
+			differences = append(differences, fmt.Sprintf("check constraint (%s) has been removed", storedCheck))
+		}
+	}
+
+	for _, check := range checks {
+		exists := false
+		for _, storedCheck := range storedChecks {
+			if storedCheck.Name == check.Name {
+				exists = true
+			}
+		}
+		if !exists {
+			differences = append(differences, fmt.Sprintf("new check constraint (%s)", check))
+		}
+	}
+
+	return differences
+}
+
+// compareRoutineMetadata is a helper function that compares the definition of two versions of a routine, the
+// stored one and the current one read from the database. The returned msg -if any- contains information about the
+// changes in the routine.
+func compareRoutineMetadata(storedRoutine routine, r routine) (equal bool, msg string) {
+	differences := make([]string, 0)
+
+	if storedRoutine.Arguments != r.Arguments {
+		differences = append(differences, fmt.Sprintf("arguments changed from (%s) to (%s)",
+			storedRoutine.Arguments, r.Arguments))
+	}
+
+	if storedRoutine.ReturnType != r.ReturnType {
+		differences = append(differences, fmt.Sprintf("return type changed from (%s) to (%s)",
+			storedRoutine.ReturnType, r.ReturnType))
+	}
+
+	if storedRoutine.Language != r.Language {
+		differences = append(differences, fmt.Sprintf("language changed from (%s) to (%s)",
+			storedRoutine.Language, r.Language))
+	}
+
+	if storedRoutine.Event != r.Event {
+		differences = append(differences, fmt.Sprintf("event changed from (%s) to (%s)", storedRoutine.Event,
+			r.Event))
+	}
+
+	if storedRoutine.BodyHash != r.BodyHash {
+		differences = append(differences, fmt.Sprintf("%s body has been changed", r.Kind))
+	}
+
+	if len(differences) > 0 {
+		msg = strings.Join(differences, ".\n")
+	} else if len(differences) == 0 {
+		equal = true
+	}
+
+	return equal, msg
+}
+
+// compareDataTypeMetadata is a helper function that compares the definition of two versions of a user defined type,
+// the stored one and the current one read from the database. The returned msg -if any- contains information about
+// the changes in the type.
+func compareDataTypeMetadata(storedType dataType, t dataType) (equal bool, msg string) {
+	differences := make([]string, 0)
+
+	if storedType.Kind != t.Kind {
+		differences = append(differences, fmt.Sprintf("kind changed from (%s) to (%s)", storedType.Kind, t.Kind))
+	}
+
+	if storedType.BaseType != t.BaseType {
+		differences = append(differences, fmt.Sprintf("base type changed from (%s) to (%s)", storedType.BaseType,
+			t.BaseType))
+	}
+
+	if storedType.NotNull != t.NotNull {
+		if t.NotNull {
+			differences = append(differences, "domain is not nullable now")
+		} else {
+			differences = append(differences, "domain is nullable now")
+		}
+	}
+
+	if storedType.Default != t.Default {
+		differences = append(differences, fmt.Sprintf("default changed from (%s) to (%s)", storedType.Default,
+			t.Default))
+	}
+
+	differences = append(differences, compareChecks(storedType.Checks, t.Checks)...)
+
+	for _, storedValue := range storedType.Values {
+		exists := false
+		for _, value := range t.Values {
+			if value == storedValue {
+				exists = true
+			}
+		}
+		if !exists {
+			differences = append(differences, fmt.Sprintf("enum value %s has been removed", storedValue))
+		}
+	}
+	for _, value := range t.Values {
+		exists := false
+		for _, storedValue := range storedType.Values {
+			if value == storedValue {
+				exists = true
+			}
+		}
+		if !exists {
+			differences = append(differences, fmt.Sprintf("new enum value %s", value))
+		}
+	}
+
+	if typeAttributesString(storedType.Attributes) != typeAttributesString(t.Attributes) {
+		differences = append(differences, fmt.Sprintf("attributes changed from (%s) to (%s)",
+			typeAttributesString(storedType.Attributes), typeAttributesString(t.Attributes)))
+	}
+
+	if len(differences) > 0 {
+		msg = strings.Join(differences, ".\n")
+	} else if len(differences) == 0 {
+		equal = true
+	}
+
+	return equal, msg
+}
+
+// typeAttributesString returns the given attributes of a composite type as a list, e.g. street text, zip integer.
+func typeAttributesString(attributes []typeAttribute) string {
+	list := make([]string, len(attributes))
+	for i, a := range attributes {
+		list[i] = a.Name + " " + a.Type
+	}
+	return strings.Join(list, ", ")
+}
+
+// normalizeSQL collapses the whitespaces of the given sql, so a reformatted definition is not reported as a change.
+func normalizeSQL(definition string) string {
+	return strings.Join(strings.Fields(definition), " ")
+}
+
+// narrowedSize checks whether the size of a column was narrowed from storedSize to size. A size of 0 means that the
+// column does not have a declared size, so it can hold anything.
+func narrowedSize(storedSize int64, size int64) bool {
+	return size != 0 && (storedSize == 0 || size < storedSize)
+}
@@ -0,0 +1,696 @@
+package main
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"github.com/pkg/errors"
+	"math"
+	"strings"
+)
+
+// Introspector reads the catalog of a database.
+// Every database engine supported by godic has its own Introspector registered with registerIntrospector.
+type Introspector interface {
+	// Catalog reads the whole catalog of the database.
+	Catalog() (*catalog, error)
+}
+
+// introspectorDriver describes how godic connects to and introspects a database engine.
+type introspectorDriver struct {
+	// sqlDriver is the name of the database/sql driver used to connect to the database.
+	sqlDriver string
+
+	// dataSource returns the dataSourceName needed to connect to the database described by the given *Config.
+	dataSource func(conf *Config) string
+
+	// newIntrospector returns the Introspector of the database engine using the given connection.
+	newIntrospector func(db *sql.DB, conf *Config) (Introspector, error)
+}
+
+// introspectors holds the registered introspectorDriver of every supported database engine by driver name.
+var introspectors = make(map[string]introspectorDriver)
+
+// registerIntrospector makes the given introspectorDriver available under the given driver name.
+// registerIntrospector is meant to be called from the init function of the file implementing the Introspector.
+func registerIntrospector(name string, driver introspectorDriver) {
+	if _, exists := introspectors[name]; exists {
+		panic(fmt.Sprintf("an introspector for the driver %s is already registered", name))
+	}
+	introspectors[name] = driver
+}
+
+// openIntrospector connects to the database described by the given *Config and returns its Introspector.
+// If the *Config has a dump file openIntrospector returns an Introspector that reads the dump file instead.
+func openIntrospector(conf *Config) (Introspector, error) {
+	driver, ok := introspectors[conf.DatabaseDriver]
+	if !ok {
+		return nil, fmt.Errorf("the given driver %s is not supported", conf.DatabaseDriver)
+	}
+
+	if conf.DatabaseDump != "" {
+		return newDumpIntrospector(conf)
+	}
+
+	db, err := sql.Open(driver.sqlDriver, driver.dataSource(conf))
+	if err != nil {
+		return nil, err
+	}
+	if err = db.Ping(); err != nil {
+		return nil, err
+	}
+
+	return driver.newIntrospector(db, conf)
+}
+
+// column holds the structural metadata of a column as it is reported by the database.
+// Precision and Scale are only set for decimal columns with a declared precision.
+type column struct {
+	Name      string
+	DBType    string
+	Nullable  bool
+	GoType    string
+	Length    int64
+	Precision int64
+	Scale     int64
+}
+
+// catalog holds all the metadata of a database read by an Introspector.
+// Tables are identified everywhere in the catalog by their id (see tableID), so tables with the same name
+// in different schemas do not clash.
+type catalog struct {
+	Tables      []string
+	Schemas     map[string]string
+	Columns     map[string][]column
+	Kinds       map[string]string
+	Definitions map[string]string
+	PrimaryKeys PrimaryKeys
+	ForeignKeys ForeignKeys
+	Enums       ColumnsAndEnums
+	ColTypes    ColumnsTypes
+	Types       DBDataTypes
+	Uniques     UniqueCols
+	Indexes     IndexColumns
+	Checks      CheckColumns
+	Defaults    ColumnsDefaults
+	Comments    Comments
+	Collations  DBCollations
+	Partitions  DBPartitions
+	Inherits    DBInheritances
+	Routines    DBRoutines
+	Sequences   DBSequences
+	Stats       DBTablesStats
+
+	// fkTargets indexes the columns referenced by the columns of the foreign keys, see foreignKeyTargetColumn.
+	fkTargets map[foreignKeyColumn]string
+	// colPositions indexes the positions of the columns by table, see columnPosition.
+	colPositions map[string]map[string]int
+}
+
+// foreignKeyColumn identifies a column of a foreign key of a table.
+type foreignKeyColumn struct {
+	Table string
+	Name  string
+	Col   string
+}
+
+// hasTable checks whether a table with the given tableName exists in the catalog or not.
+func (c *catalog) hasTable(tableName string) bool {
+	for i := range c.Tables {
+		if c.Tables[i] == tableName {
+			return true
+		}
+	}
+	return false
+}
+
+// table returns the table with the given id, ready to be stored in a Repository.
+// The description of the table is the comment written for it in the database, if any.
+func (c *catalog) table(id string) table {
+	t := table{ID: id, Schema: c.Schemas[id], Name: id, Kind: c.kind(id), Definition: c.Definitions[id],
+		Description: c.comment("", id), PrimaryKey: c.primaryKey(id), ForeignKeys: c.foreignKeys(id),
+		Indexes: c.indexes(id), Checks: c.checks(id), Inherits: c.parents(id)}
+	t.PartitionKey, t.Partitions = c.partitions(id)
+	if collation, err := c.Collations.get("", id); err == nil {
+		t.Charset, t.Collation = collation.Charset, collation.Collation
+	}
+	if t.Schema != "" {
+		t.Name = strings.TrimPrefix(id, t.Schema+".")
+	}
+	return t
+}
+
+// comment returns the comment written in the database for the column with the given colName in the table with
+// the given id, or for the table itself when colName is empty.
+func (c *catalog) comment(colName string, id string) string {
+	comment, err := c.Comments.get(colName, id)
+	if err != nil {
+		return ""
+	}
+	return comment.Comment
+}
+
+// kind returns the kind of the table with the given id. Only views are registered in Kinds, anything else
+// is a plain table.
+func (c *catalog) kind(id string) string {
+	if kind, ok := c.Kinds[id]; ok {
+		return kind
+	}
+	return tableKind
+}
+
+// partitions returns the partition key and the partitions of the table with the given id. The partition key is
+// empty if the table is not partitioned.
+func (c *catalog) partitions(id string) (key string, partitions []tablePartition) {
+	for _, p := range c.Partitions {
+		if p.Table != id {
+			continue
+		}
+		if p.Key != "" {
+			key = p.Key
+		}
+		if p.Partition != "" {
+			partitions = append(partitions, tablePartition{Name: p.Partition, Bound: p.Bound})
+		}
+	}
+	return key, partitions
+}
+
+// parents returns the ids of the tables the table with the given id inherits from.
+func (c *catalog) parents(id string) []string {
+	var parents []string
+	for _, i := range c.Inherits {
+		if i.Table == id {
+			parents = append(parents, i.Parent)
+		}
+	}
+	return parents
+}
+
+// estimatedRows returns the number of rows of the table with the given id as it is known by the database,
+// or 0 if it is unknown.
+func (c *catalog) estimatedRows(id string) int64 {
+	for _, s := range c.Stats {
+		if s.Table == id && s.Rows > 0 {
+			return s.Rows
+		}
+	}
+	return 0
+}
+
+// primaryKey returns the primary key constraint of the table with the given id.
+// If the table does not have a primary key primaryKey will return nil.
+func (c *catalog) primaryKey(id string) *keyConstraint {
+	var pk *keyConstraint
+	for _, p := range c.PrimaryKeys {
+		if p.Table != id {
+			continue
+		}
+		if pk == nil {
+			pk = &keyConstraint{Name: p.Name, Columns: make([]string, 0)}
+		}
+		pk.Columns = append(pk.Columns, p.Col)
+	}
+	return pk
+}
+
+// foreignKeys returns the foreign key constraints of the table with the given id.
+// The columns of the foreign keys are grouped by the name of their constraint.
+func (c *catalog) foreignKeys(id string) []keyConstraint {
+	fks := make([]keyConstraint, 0)
+	positions := make(map[string]int)
+	for _, f := range c.ForeignKeys {
+		if f.Table != id {
+			continue
+		}
+		i, ok := positions[f.Name]
+		if !ok {
+			fks = append(fks, keyConstraint{
+				Name:          f.Name,
+				Columns:       make([]string, 0),
+				TargetTable:   f.TargetTable,
+				TargetColumns: make([]string, 0),
+				DeleteRule:    f.DeleteRule,
+				UpdateRule:    f.UpdateRule,
+			})
+			i = len(fks) - 1
+			positions[f.Name] = i
+		}
+
+		targetCol := f.TargetCol
+		if targetCol == "" {
+			if pk := c.primaryKey(f.TargetTable); pk != nil && len(pk.Columns) > len(fks[i].Columns) {
+				targetCol = pk.Columns[len(fks[i].Columns)]
+			}
+		}
+		fks[i].Columns = append(fks[i].Columns, f.Col)
+		fks[i].TargetColumns = append(fks[i].TargetColumns, targetCol)
+	}
+	return fks
+}
+
+// indexes returns the indexes of the table with the given id.
+// The keys of the indexes are grouped by the name of their index.
+func (c *catalog) indexes(id string) []tableIndex {
+	indexes := make([]tableIndex, 0)
+	positions := make(map[string]int)
+	for _, ic := range c.Indexes {
+		if ic.Table != id {
+			continue
+		}
+		i, ok := positions[ic.Name]
+		if !ok {
+			indexes = append(indexes, tableIndex{
+				Name:      ic.Name,
+				Keys:      make([]indexKey, 0),
+				Unique:    ic.Unique,
+				Method:    ic.Method,
+				Predicate: ic.Predicate,
+			})
+			i = len(indexes) - 1
+			positions[ic.Name] = i
+		}
+		indexes[i].Keys = append(indexes[i].Keys, indexKey{Column: ic.Col, Expression: ic.Expression})
+	}
+	return indexes
+}
+
+// checks returns the check constraints of the table with the given id.
+// The columns of the check constraints are grouped by the name of their constraint.
+func (c *catalog) checks(id string) []checkConstraint {
+	checks := make([]checkConstraint, 0)
+	positions := make(map[string]int)
+	for _, cc := range c.Checks {
+		if cc.Table != id {
+			continue
+		}
+		i, ok := positions[cc.Name]
+		if !ok {
+			checks = append(checks, checkConstraint{Name: cc.Name, Clause: cc.Clause, Columns: make([]string, 0)})
+			i = len(checks) - 1
+			positions[cc.Name] = i
+		}
+		if cc.Col != "" {
+			checks[i].Columns = append(checks[i].Columns, cc.Col)
+		}
+	}
+	return checks
+}
+
+// routines returns the functions, procedures and triggers of the catalog, ready to be stored in a Repository.
+func (c *catalog) routines() Routines {
+	routines := make(Routines, 0, len(c.Routines))
+	for _, r := range c.Routines {
+		routines = append(routines, routine{
+			ID:         routineID(r.Schema, r.Name, r.Kind, r.Table, r.Signature),
+			Schema:     r.Schema,
+			Name:       r.Name,
+			Kind:       r.Kind,
+			Arguments:  r.Arguments,
+			ReturnType: r.ReturnType,
+			Language:   r.Language,
+			Table:      r.Table,
+			Event:      r.Event,
+			BodyHash:   routineBodyHash(r.Body),
+		})
+	}
+	return routines
+}
+
+// dataTypes returns the user defined types of the catalog, ready to be stored in a Repository.
+func (c *catalog) dataTypes() DataTypes {
+	types := make(DataTypes, 0)
+	positions := make(map[string]int)
+	for _, dt := range c.Types {
+		id := tableID(dt.Schema, dt.Name)
+		i, ok := positions[id]
+		if !ok {
+			types = append(types, dataType{ID: id, Schema: dt.Schema, Name: dt.Name, Kind: dt.Kind,
+				BaseType: dt.BaseType, NotNull: dt.NotNull, Default: dt.Default})
+			i = len(types) - 1
+			positions[id] = i
+		}
+		if dt.Item == "" {
+			continue
+		}
+		t := &types[i]
+		switch t.Kind {
+		case enumTypeKind:
+			t.Values = append(t.Values, dt.Item)
+		case domainTypeKind:
+			t.Checks = append(t.Checks, checkConstraint{Name: dt.Item, Clause: dt.Definition, Columns: make([]string, 0)})
+		case compositeTypeKind:
+			t.Attributes = append(t.Attributes, typeAttribute{Name: dt.Item, Type: dt.Definition})
+		}
+	}
+	return types
+}
+
+// domainChecks returns the check constraints of the domain with the given id, e.g. email_check CHECK (VALUE ~~
+// '%@%'::text).
+func (c *catalog) domainChecks(typeID string) []string {
+	checks := make([]string, 0)
+	for _, dt := range c.Types {
+		if dt.Kind == domainTypeKind && dt.Item != "" && tableID(dt.Schema, dt.Name) == typeID {
+			checks = append(checks, checkConstraint{Name: dt.Item, Clause: dt.Definition}.String())
+		}
+	}
+	return checks
+}
+
+// routineBodyHash returns the hash of the given body of a routine. Only the surrounding whitespaces of the body
+// are ignored, any other change of the body changes its hash.
+func routineBodyHash(body string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(body)))
+	return hex.EncodeToString(sum[:])
+}
+
+// sequences returns the sequences and the auto increment counters of the catalog with their usage.
+// The range of a sequence is narrowed to the range of the column it feeds, as a column with a smaller type than
+// its sequence, e.g. an integer column fed by a bigint sequence, runs out of values before the sequence does.
+// The usage of descending sequences is not computed.
+func (c *catalog) sequences() Sequences {
+	sequences := make(Sequences, 0, len(c.Sequences))
+	for _, s := range c.Sequences {
+		seq := sequence{
+			ID:           tableID(s.Schema, s.Name),
+			Schema:       s.Schema,
+			Name:         s.Name,
+			Kind:         s.Kind,
+			Table:        s.Table,
+			Column:       s.Col,
+			DataType:     s.DataType,
+			Increment:    s.Increment,
+			CurrentValue: s.CurrentValue,
+			MaxValue:     s.MaxValue,
+		}
+		if col, err := c.column(s.Col, s.Table); err == nil {
+			if max := integerTypeMax(col); max > 0 && (seq.MaxValue == 0 || max < seq.MaxValue) {
+				seq.MaxValue = max
+			}
+		}
+		if seq.Increment > 0 && seq.CurrentValue > 0 && seq.MaxValue > 0 {
+			seq.Usage = math.Round(float64(seq.CurrentValue)/float64(seq.MaxValue)*10000) / 100
+		}
+		seq.Warning = seq.Usage >= sequenceUsageWarning
+		sequences = append(sequences, seq)
+	}
+	return sequences
+}
+
+// integerMaxValues maps the go types of the integer columns to the largest value they can hold.
+var integerMaxValues = map[string]uint64{
+	"int8":   math.MaxInt8,
+	"uint8":  math.MaxUint8,
+	"int16":  math.MaxInt16,
+	"uint16": math.MaxUint16,
+	"int32":  math.MaxInt32,
+	"uint32": math.MaxUint32,
+	"int64":  math.MaxInt64,
+	"uint64": math.MaxUint64,
+}
+
+// integerTypeMax returns the largest value the given column can hold, or 0 if it is not an integer column.
+// The range of a column is told by its go type, except for the mysql mediumint columns, which are scanned as
+// 32 bits integers but only hold 24 bits.
+func integerTypeMax(col column) uint64 {
+	unsigned := strings.HasPrefix(col.GoType, "uint")
+	if strings.Contains(strings.ToUpper(col.DBType), "MEDIUMINT") {
+		if unsigned {
+			return 1<<24 - 1
+		}
+		return 1<<23 - 1
+	}
+	return integerMaxValues[col.GoType]
+}
+
+// foreignKeyTargetColumn returns the column referenced by the given column of a foreign key.
+// If the database did not give us the target column, we take it from the primary key of the target table.
+// The target columns of all the foreign keys are indexed the first time, as every column of a table is looked up.
+func (c *catalog) foreignKeyTargetColumn(f foreignKey) string {
+	if c.fkTargets == nil {
+		c.fkTargets = make(map[foreignKeyColumn]string)
+		for _, fk := range c.ForeignKeys {
+			if _, ok := c.fkTargets[foreignKeyColumn{fk.Table, fk.Name, fk.Col}]; ok {
+				continue
+			}
+			for _, key := range c.foreignKeys(fk.Table) {
+				for i := range key.Columns {
+					c.fkTargets[foreignKeyColumn{fk.Table, key.Name, key.Columns[i]}] = key.TargetColumns[i]
+				}
+			}
+		}
+	}
+	if target, ok := c.fkTargets[foreignKeyColumn{f.Table, f.Name, f.Col}]; ok {
+		return target
+	}
+	return f.TargetCol
+}
+
+// tableColumns will get all the columns of the given tableName.
+func (c *catalog) tableColumns(tableName string) []column {
+	return c.Columns[tableName]
+}
+
+// column will get the column with the given colName from the given tableName.
+// If the column does not exist column() will return an error.
+func (c *catalog) column(colName string, tableName string) (column, error) {
+	for _, col := range c.Columns[tableName] {
+		if col.Name == colName {
+			return col, nil
+		}
+	}
+	return column{}, errors.Errorf("column with name %s in the given table %s does not exist", colName, tableName)
+}
+
+// columnPosition returns the ordinal position, starting at 1, of the column with the given colName in the given
+// tableName, or 0 if the column does not exist. The position is the index of the column in Columns, so it relies on
+// the columns being read in the order of the table: the order of SELECT * for the sql databases, which follows
+// ordinal_position in mysql, attnum in postgres and cid in sqlite, and the order of the CREATE TABLE statements for
+// the dumps. The positions are indexed once per table.
+func (c *catalog) columnPosition(colName string, tableName string) int {
+	if c.colPositions == nil {
+		c.colPositions = make(map[string]map[string]int)
+	}
+	positions, ok := c.colPositions[tableName]
+	if !ok {
+		positions = make(map[string]int, len(c.Columns[tableName]))
+		for i, col := range c.Columns[tableName] {
+			positions[col.Name] = i + 1
+		}
+		c.colPositions[tableName] = positions
+	}
+	return positions[colName]
+}
+
+// sqlQueries holds the queries used by an Introspector to read the catalog of a sql database.
+// An empty query means that the database engine does not support that kind of metadata.
+type sqlQueries struct {
+	// TableNames must return the schema and the name of every table. Database engines whose tables are not
+	// read by schema must return an empty schema.
+	TableNames string
+
+	// Views must return the schema, the name, the kind (viewKind or materializedViewKind) and the definition
+	// of every view. Database engines whose views are not read by schema must return an empty schema.
+	Views string
+
+	// Columns must be a format string that receives the schema and the name of a table or view and returns
+	// all its columns.
+	Columns string
+
+	// The rest of the queries must identify the tables by their id (see tableID).
+
+	// PrimaryKeys must return the column name, the table name and the constraint name of every column of a
+	// primary key, ordered by their position in the key.
+	PrimaryKeys string
+
+	// ForeignKeys must return the origin table, target table, column, delete rule, update rule, target column
+	// and constraint name of every column of a foreign key, ordered by their position in the key.
+	ForeignKeys string
+
+	// Enums must return the table, column, enum name and comma separated enum values of every enum column.
+	Enums string
+
+	// ColumnsTypes must return the table, column, type kind (domainTypeKind, compositeTypeKind, rangeTypeKind or
+	// arrayTypeKind), user defined type id and base type of every column typed with a domain, a composite type,
+	// a range type or an array. The user defined type id must be empty for the built-in types.
+	ColumnsTypes string
+
+	// Types must return the schema, name, kind, base type, not null constraint, default and an item (see
+	// dbDataType) of every enum, domain, composite type and range type. The items must be ordered, and the types
+	// without items must be returned once with an empty item.
+	Types string
+
+	// Uniques must return the table and column of every column with a unique index.
+	Uniques string
+
+	// Indexes must return the table, index name, uniqueness, method, predicate, column and expression of every
+	// key of an index, ordered by their position in the index. Keys that are expressions must have an empty
+	// column, and the keys that are columns an empty expression.
+	Indexes string
+
+	// Checks must return the table, constraint name, clause and column of every column referenced by a check
+	// constraint, ordered by constraint. Check constraints that do not reference any column must be returned
+	// once with an empty column.
+	Checks string
+
+	// Defaults must return the table, column, default value, identity generation (ALWAYS or BY DEFAULT),
+	// generation expression and extra information of every column that has any of them.
+	Defaults string
+
+	// Comments must return the table, column and comment of every table and column with a comment written in
+	// the database. The comments of the tables must have an empty column.
+	Comments string
+
+	// Collations must return the table, column, character set and collation of every column with a collation, and
+	// the same for every table with an empty column. Database engines without character sets per column must
+	// return an empty character set.
+	Collations string
+
+	// Partitions must return the table, partition key, partition and bound of every partition of the partitioned
+	// tables, or a single row with an empty partition and bound for a partitioned table without partitions.
+	Partitions string
+
+	// Inherits must return the table and the parent table of every table that inherits from another table.
+	// Partitions must not be returned, see Partitions.
+	Inherits string
+
+	// Routines must return the schema, name, kind (functionKind or procedureKind), signature, arguments, return
+	// type, language, table, event and body of every function and stored procedure. The table and the event
+	// must be empty.
+	Routines string
+
+	// Triggers must return the same columns as Routines for every trigger, with the triggerKind kind, an empty
+	// signature, the table the trigger fires on and its event, e.g. BEFORE INSERT OR UPDATE FOR EACH ROW.
+	Triggers string
+
+	// Sequences must return the schema, name, kind (sequenceKind or autoIncrementKind), table, column, data type,
+	// increment, current value and maximum value of every sequence. The table and the column are the column fed by
+	// the sequence, if any, and a maximum value of 0 means the range of the type of that column. Database engines
+	// whose sequences are not read by schema must return an empty schema.
+	Sequences string
+
+	// Stats must return the table, estimated row count, table size, index size, last vacuum time and last analyze
+	// time of every table. The sizes must be in bytes and the times must be in RFC 3339 format, or empty if unknown.
+	Stats string
+}
+
+// readSqlCatalog reads the catalog of the database behind db with the given sqlQueries.
+func readSqlCatalog(db *sql.DB, q sqlQueries) (*catalog, error) {
+	var err error
+	c := &catalog{Tables: make([]string, 0), Schemas: make(map[string]string), Columns: make(map[string][]column),
+		Kinds: make(map[string]string), Definitions: make(map[string]string)}
+
+	tables, err := queryTableNames(db, q.TableNames)
+	if err != nil {
+		return nil, err
+	}
+
+	views, err := queryViews(db, q.Views)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range append(tables, views...) {
+		id := tableID(t.Schema, t.Name)
+		c.Tables = append(c.Tables, id)
+		c.Schemas[id] = t.Schema
+		if t.Kind != "" {
+			c.Kinds[id] = t.Kind
+			c.Definitions[id] = t.Definition
+		}
+		c.Columns[id], err = queryTableColumns(db, fmt.Sprintf(q.Columns, t.Schema, t.Name))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	c.PrimaryKeys, err = queryPrimaryKeys(db, q.PrimaryKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	c.ForeignKeys, err = queryForeignKeys(db, q.ForeignKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Enums, err = queryColsAndEnums(db, q.Enums)
+	if err != nil {
+		return nil, err
+	}
+
+	c.ColTypes, err = queryColumnsTypes(db, q.ColumnsTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Types, err = queryDataTypes(db, q.Types)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Uniques, err = queryUniqueCols(db, q.Uniques)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Indexes, err = queryIndexes(db, q.Indexes)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Checks, err = queryChecks(db, q.Checks)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Defaults, err = queryColsDefaults(db, q.Defaults)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Comments, err = queryComments(db, q.Comments)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Collations, err = queryCollations(db, q.Collations)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Partitions, err = queryPartitions(db, q.Partitions)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Inherits, err = queryInherits(db, q.Inherits)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Routines, err = queryRoutines(db, q.Routines)
+	if err != nil {
+		return nil, err
+	}
+
+	triggers, err := queryRoutines(db, q.Triggers)
+	if err != nil {
+		return nil, err
+	}
+	c.Routines = append(c.Routines, triggers...)
+
+	c.Sequences, err = querySequences(db, q.Sequences)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Stats, err = queryTablesStats(db, q.Stats)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
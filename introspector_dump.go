@@ -0,0 +1,2368 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// dumpIntrospector is the Introspector of a database described by a schema only dump file, e.g. the output
+// of "pg_dump --schema-only" or "mysqldump --no-data". It allows godic to build the data dictionary without
+// a live connection to the database. The dump file is parsed every time the catalog is read, so replacing
+// the dump file is enough to detect the changes of the database.
+type dumpIntrospector struct {
+	path    string
+	dialect string
+	schemas []string
+}
+
+// newDumpIntrospector returns the Introspector of the dump file given in the *Config.
+func newDumpIntrospector(conf *Config) (Introspector, error) {
+	if conf.DatabaseDriver != "postgres" && conf.DatabaseDriver != "mysql" {
+		return nil, fmt.Errorf("dump files are only supported for the postgres and mysql drivers; got %s",
+			conf.DatabaseDriver)
+	}
+	return &dumpIntrospector{path: conf.DatabaseDump, dialect: conf.DatabaseDriver, schemas: conf.schemas()}, nil
+}
+
+func (in *dumpIntrospector) Catalog() (*catalog, error) {
+	sb, err := ioutil.ReadFile(in.path)
+	if err != nil {
+		return nil, err
+	}
+	return parseDump(string(sb), in.dialect, in.schemas)
+}
+
+// parseDump builds the catalog of the given schemas from the CREATE TABLE, CREATE VIEW, CREATE TYPE,
+// CREATE DOMAIN, CREATE INDEX, CREATE FUNCTION, CREATE PROCEDURE, CREATE TRIGGER, CREATE SEQUENCE, ALTER TABLE, ALTER SEQUENCE
+// and COMMENT ON statements of the given dump written in the given dialect (postgres or mysql), and from the
+// setval calls of the sequences. Any other statement of the dump is ignored.
+func parseDump(dump string, dialect string, schemas []string) (*catalog, error) {
+	tokens, err := tokenizeDDL(dump, dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &dumpParser{
+		dialect: dialect,
+		schemas: schemas,
+		cat: &catalog{
+			Tables:      make([]string, 0),
+			Schemas:     make(map[string]string),
+			Columns:     make(map[string][]column),
+			Kinds:       make(map[string]string),
+			Definitions: make(map[string]string),
+			PrimaryKeys: make(PrimaryKeys, 0),
+			ForeignKeys: make(ForeignKeys, 0),
+			Enums:       make(ColumnsAndEnums, 0),
+			ColTypes:    make(ColumnsTypes, 0),
+			Types:       make(DBDataTypes, 0),
+			Uniques:     make(UniqueCols, 0),
+			Indexes:     make(IndexColumns, 0),
+			Checks:      make(CheckColumns, 0),
+			Defaults:    make(ColumnsDefaults, 0),
+			Comments:    make(Comments, 0),
+			Collations:  make(DBCollations, 0),
+			Partitions:  make(DBPartitions, 0),
+			Inherits:    make(DBInheritances, 0),
+			Routines:    make(DBRoutines, 0),
+			Sequences:   make(DBSequences, 0),
+			Stats:       make(DBTablesStats, 0),
+		},
+		enumTypes:    make(map[string][]string),
+		userTypes:    make(map[string]dbDataType),
+		partitionIDs: make(map[string]bool),
+	}
+
+	for _, stmt := range splitDDLStatements(tokens) {
+		if err := p.parseStatement(stmt); err != nil {
+			return nil, err
+		}
+	}
+
+	// pg_dump creates the partitions as plain tables and attaches them to their parent table afterwards, but they are
+	// documented within their parent table.
+	tables := make([]string, 0, len(p.cat.Tables))
+	for _, id := range p.cat.Tables {
+		if p.partitionIDs[id] {
+			delete(p.cat.Columns, id)
+			delete(p.cat.Schemas, id)
+			continue
+		}
+		tables = append(tables, id)
+	}
+	p.cat.Tables = tables
+
+	// Columns typed with a user defined type can only be resolved once every CREATE TYPE and CREATE DOMAIN
+	// statement has been read.
+	for _, tableName := range p.cat.Tables {
+		for _, col := range p.cat.Columns[tableName] {
+			if values, ok := p.enumTypes[strings.ToLower(col.DBType)]; ok {
+				p.cat.Enums = append(p.cat.Enums, colAndEnum{
+					Table:      tableName,
+					Col:        col.Name,
+					EnumName:   col.DBType,
+					EnumValues: strings.Join(values, ","),
+				})
+			}
+			if ct, ok := p.columnType(col); ok {
+				ct.Table = tableName
+				p.cat.ColTypes = append(p.cat.ColTypes, ct)
+			}
+		}
+	}
+
+	return p.cat, nil
+}
+
+// ddlTokenKind identifies the kind of a ddlToken.
+type ddlTokenKind int
+
+const (
+	ddlWord ddlTokenKind = iota
+	ddlQuotedIdent
+	ddlString
+	ddlNumber
+	ddlSymbol
+	ddlDelimiter
+)
+
+// ddlToken is a lexical token of a sql dump.
+type ddlToken struct {
+	kind ddlTokenKind
+	text string
+}
+
+// is checks whether the token is the given keyword.
+func (t ddlToken) is(keyword string) bool {
+	return t.kind == ddlWord && strings.EqualFold(t.text, keyword)
+}
+
+// isSymbol checks whether the token is the given symbol.
+func (t ddlToken) isSymbol(symbol string) bool {
+	return t.kind == ddlSymbol && t.text == symbol
+}
+
+// tokenizeDDL splits the given sql dump into tokens, skipping whitespaces and comments.
+// The end of every statement is a ddlDelimiter token. Mysql dumps change the delimiter of the statements with the
+// DELIMITER command to write the bodies of their routines, so the semicolons inside a routine are plain symbols.
+func tokenizeDDL(dump string, dialect string) ([]ddlToken, error) {
+	tokens := make([]ddlToken, 0)
+	src := []rune(dump)
+	n := len(src)
+	executableComments := 0
+	delimiter := ";"
+
+	for i := 0; i < n; {
+		r := src[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case hasRunesAt(src, i, delimiter):
+			tokens = append(tokens, ddlToken{kind: ddlDelimiter, text: ";"})
+			i += len([]rune(delimiter))
+		case dialect == "mysql" && isDelimiterCommand(src, i):
+			// DELIMITER ;; changes the delimiter until the end of the dump or the next DELIMITER command.
+			end := indexRunes(src, i, "\n")
+			if end == -1 {
+				end = n
+			}
+			if fields := strings.Fields(string(src[i:end])); len(fields) > 1 {
+				delimiter = fields[1]
+			}
+			i = end
+		case r == '-' && i+1 < n && src[i+1] == '-', r == '#' && dialect == "mysql":
+			for i < n && src[i] != '\n' {
+				i++
+			}
+		case r == '/' && i+2 < n && src[i+1] == '*' && src[i+2] == '!' && dialect == "mysql":
+			// mysql executable comments, e.g. /*!50001 CREATE VIEW ... */, are part of the statements.
+			i += 3
+			for i < n && unicode.IsDigit(src[i]) {
+				i++
+			}
+			executableComments++
+		case r == '*' && i+1 < n && src[i+1] == '/' && executableComments > 0:
+			i += 2
+			executableComments--
+		case r == '/' && i+1 < n && src[i+1] == '*':
+			end := indexRunes(src, i+2, "*/")
+			if end == -1 {
+				return nil, fmt.Errorf("unterminated comment in dump")
+			}
+			i = end + 2
+		case r == '\'':
+			text, next, err := readQuoted(src, i, '\'', dialect == "mysql")
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, ddlToken{kind: ddlString, text: text})
+			i = next
+		case r == '"':
+			text, next, err := readQuoted(src, i, '"', dialect == "mysql")
+			if err != nil {
+				return nil, err
+			}
+			kind := ddlQuotedIdent
+			if dialect == "mysql" {
+				kind = ddlString
+			}
+			tokens = append(tokens, ddlToken{kind: kind, text: text})
+			i = next
+		case r == '`':
+			text, next, err := readQuoted(src, i, '`', false)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, ddlToken{kind: ddlQuotedIdent, text: text})
+			i = next
+		case r == '$' && dialect == "postgres":
+			// Dollar quoted strings, e.g. $$ ... $$ or $body$ ... $body$.
+			j := i + 1
+			for j < n && (unicode.IsLetter(src[j]) || unicode.IsDigit(src[j]) || src[j] == '_') {
+				j++
+			}
+			if j >= n || src[j] != '$' {
+				tokens = append(tokens, ddlToken{kind: ddlSymbol, text: "$"})
+				i++
+				continue
+			}
+			tag := string(src[i : j+1])
+			end := indexRunes(src, j+1, tag)
+			if end == -1 {
+				return nil, fmt.Errorf("unterminated dollar quoted string %s in dump", tag)
+			}
+			tokens = append(tokens, ddlToken{kind: ddlString, text: string(src[j+1 : end])})
+			i = end + len([]rune(tag))
+		case unicode.IsDigit(r):
+			j := i
+			for j < n && (unicode.IsDigit(src[j]) || src[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, ddlToken{kind: ddlNumber, text: string(src[i:j])})
+			i = j
+		case unicode.IsLetter(r) || r == '_':
+			j := i
+			for j < n && (unicode.IsLetter(src[j]) || unicode.IsDigit(src[j]) || src[j] == '_' || src[j] == '$') {
+				j++
+			}
+			tokens = append(tokens, ddlToken{kind: ddlWord, text: string(src[i:j])})
+			i = j
+		case i+1 < n && isCompoundOperator(string(src[i:i+2])):
+			tokens = append(tokens, ddlToken{kind: ddlSymbol, text: string(src[i : i+2])})
+			i += 2
+		default:
+			tokens = append(tokens, ddlToken{kind: ddlSymbol, text: string(r)})
+			i++
+		}
+	}
+
+	return tokens, nil
+}
+
+// isDelimiterCommand checks whether the mysql DELIMITER command starts at src[i]. The command is only recognized at
+// the beginning of a line.
+func isDelimiterCommand(src []rune, i int) bool {
+	const command = "DELIMITER"
+	if i > 0 && src[i-1] != '\n' {
+		return false
+	}
+	if i+len(command) >= len(src) || !unicode.IsSpace(src[i+len(command)]) {
+		return false
+	}
+	return strings.EqualFold(string(src[i:i+len(command)]), command)
+}
+
+// isCompoundOperator checks whether the given symbol is an operator made of two characters, e.g. >=, which
+// must be kept together when the expressions of the dump are rendered again.
+func isCompoundOperator(symbol string) bool {
+	switch symbol {
+	case ">=", "<=", "<>", "!=", "||", "~~", "~*":
+		return true
+	}
+	return false
+}
+
+// indexRunes returns the index of the first occurrence of sep in src starting at the given position,
+// or -1 if sep is not present.
+func indexRunes(src []rune, from int, sep string) int {
+	for i := from; i+len([]rune(sep)) <= len(src); i++ {
+		if hasRunesAt(src, i, sep) {
+			return i
+		}
+	}
+	return -1
+}
+
+// hasRunesAt checks whether src has the given text at the given position.
+func hasRunesAt(src []rune, at int, text string) bool {
+	target := []rune(text)
+	if at+len(target) > len(src) {
+		return false
+	}
+	for j := range target {
+		if src[at+j] != target[j] {
+			return false
+		}
+	}
+	return true
+}
+
+// readQuoted reads the quoted text starting at src[start] and delimited by the given quote.
+// A doubled quote is read as a single quote. If backslashEscapes is true a backslash escapes the next rune.
+// readQuoted returns the unquoted text and the position right after the closing quote.
+func readQuoted(src []rune, start int, quote rune, backslashEscapes bool) (string, int, error) {
+	var sb strings.Builder
+	for i := start + 1; i < len(src); i++ {
+		r := src[i]
+		if backslashEscapes && r == '\\' && i+1 < len(src) {
+			sb.WriteRune(src[i+1])
+			i++
+			continue
+		}
+		if r == quote {
+			if i+1 < len(src) && src[i+1] == quote {
+				sb.WriteRune(quote)
+				i++
+				continue
+			}
+			return sb.String(), i + 1, nil
+		}
+		sb.WriteRune(r)
+	}
+	return "", 0, fmt.Errorf("unterminated quoted text %c in dump", quote)
+}
+
+// splitDDLStatements splits the given tokens into statements separated by delimiters.
+// Empty statements are dropped.
+func splitDDLStatements(tokens []ddlToken) [][]ddlToken {
+	stmts := make([][]ddlToken, 0)
+	start := 0
+	for i, t := range tokens {
+		if t.kind == ddlDelimiter {
+			if i > start {
+				stmts = append(stmts, tokens[start:i])
+			}
+			start = i + 1
+		}
+	}
+	if start < len(tokens) {
+		stmts = append(stmts, tokens[start:])
+	}
+	return stmts
+}
+
+// splitDDLList splits the given tokens by the commas that are not nested in parentheses.
+func splitDDLList(tokens []ddlToken) [][]ddlToken {
+	items := make([][]ddlToken, 0)
+	depth, start := 0, 0
+	for i, t := range tokens {
+		switch {
+		case t.isSymbol("("):
+			depth++
+		case t.isSymbol(")"):
+			depth--
+		case t.isSymbol(",") && depth == 0:
+			items = append(items, tokens[start:i])
+			start = i + 1
+		}
+	}
+	if start < len(tokens) {
+		items = append(items, tokens[start:])
+	}
+	return items
+}
+
+// dumpParser builds a catalog from the statements of a dump.
+type dumpParser struct {
+	dialect   string
+	schemas   []string
+	cat       *catalog
+	enumTypes map[string][]string
+	userTypes map[string]dbDataType
+
+	// partitionIDs holds the ids of the partitions of the partitioned tables, which are not documented as tables.
+	partitionIDs map[string]bool
+}
+
+// ddlCursor walks through the tokens of a single statement.
+type ddlCursor struct {
+	tokens []ddlToken
+	pos    int
+}
+
+func (c *ddlCursor) done() bool {
+	return c.pos >= len(c.tokens)
+}
+
+func (c *ddlCursor) peek() ddlToken {
+	if c.done() {
+		return ddlToken{kind: ddlSymbol}
+	}
+	return c.tokens[c.pos]
+}
+
+func (c *ddlCursor) next() ddlToken {
+	t := c.peek()
+	c.pos++
+	return t
+}
+
+// accept consumes the given sequence of keywords if the next tokens match all of them.
+func (c *ddlCursor) accept(keywords ...string) bool {
+	if c.pos+len(keywords) > len(c.tokens) {
+		return false
+	}
+	for i, k := range keywords {
+		if !c.tokens[c.pos+i].is(k) {
+			return false
+		}
+	}
+	c.pos += len(keywords)
+	return true
+}
+
+// qualifiedName reads a possibly schema qualified name, e.g. public."order", and returns its parts.
+func (c *ddlCursor) qualifiedName(dialect string) []string {
+	parts := make([]string, 0, 2)
+	for !c.done() {
+		t := c.next()
+		parts = append(parts, identifier(t, dialect))
+		if !c.peek().isSymbol(".") {
+			break
+		}
+		c.next()
+	}
+	return parts
+}
+
+// expression reads the tokens of an expression in a column definition, e.g. a default value, which goes until
+// the next column modifier.
+func (c *ddlCursor) expression() []ddlToken {
+	start := c.pos
+	for !c.done() && (c.pos == start || !isColumnModifier(c.peek())) {
+		if c.peek().isSymbol("(") {
+			c.parenthesized()
+			continue
+		}
+		c.next()
+	}
+	return c.tokens[start:c.pos]
+}
+
+// parenthesized reads the tokens enclosed by the parentheses starting at the current token.
+// If the current token is not an opening parenthesis parenthesized returns nil.
+func (c *ddlCursor) parenthesized() []ddlToken {
+	if !c.peek().isSymbol("(") {
+		return nil
+	}
+	start := c.pos + 1
+	depth := 0
+	for !c.done() {
+		t := c.next()
+		if t.isSymbol("(") {
+			depth++
+		} else if t.isSymbol(")") {
+			depth--
+			if depth == 0 {
+				return c.tokens[start : c.pos-1]
+			}
+		}
+	}
+	return c.tokens[start:]
+}
+
+// identifier returns the name represented by the given token. Postgres folds unquoted identifiers to
+// lower case, so we do the same.
+func identifier(t ddlToken, dialect string) string {
+	if t.kind == ddlWord && dialect == "postgres" {
+		return strings.ToLower(t.text)
+	}
+	return t.text
+}
+
+// identifierList returns the plain column names of the given comma separated list, e.g. (id, "name").
+// Entries that are expressions instead of column names are skipped.
+func identifierList(tokens []ddlToken, dialect string) []string {
+	names := make([]string, 0)
+	for _, item := range splitDDLList(tokens) {
+		if len(item) == 0 || (item[0].kind != ddlWord && item[0].kind != ddlQuotedIdent) {
+			continue
+		}
+		// Anything but a sort order or a prefix length after the column name means we have an expression.
+		if len(item) > 1 && !item[1].is("ASC") && !item[1].is("DESC") && !item[1].isSymbol("(") {
+			continue
+		}
+		names = append(names, identifier(item[0], dialect))
+	}
+	return names
+}
+
+// schemaOf returns the schema of an object with the given qualified name.
+// Unqualified names belong to the first scanned schema.
+func (p *dumpParser) schemaOf(parts []string) string {
+	if len(parts) > 1 {
+		return parts[len(parts)-2]
+	}
+	if len(p.schemas) == 0 || p.schemas[0] == allSchemas {
+		return "public"
+	}
+	return p.schemas[0]
+}
+
+// inSchema checks whether an object with the given qualified name belongs to one of the scanned schemas.
+func (p *dumpParser) inSchema(parts []string) bool {
+	schema := p.schemaOf(parts)
+	for _, s := range p.schemas {
+		if s == schema {
+			return true
+		}
+		if s == allSchemas && schema != "pg_catalog" && schema != "information_schema" &&
+			!strings.HasPrefix(schema, "pg_") {
+			return true
+		}
+	}
+	return false
+}
+
+// tableID returns the id of the table with the given qualified name.
+// Only postgres tables are identified by their schema, see tableID.
+func (p *dumpParser) tableID(parts []string) string {
+	if p.dialect != "postgres" {
+		return parts[len(parts)-1]
+	}
+	return tableID(p.schemaOf(parts), parts[len(parts)-1])
+}
+
+// hasTable checks whether the table with the given qualified name has been created in the scanned schemas.
+func (p *dumpParser) hasTable(parts []string) bool {
+	return len(parts) > 0 && p.inSchema(parts) && p.cat.hasTable(p.tableID(parts))
+}
+
+func (p *dumpParser) parseStatement(stmt []ddlToken) error {
+	c := &ddlCursor{tokens: stmt}
+
+	if c.accept("CREATE") {
+		c.accept("OR", "REPLACE")
+		for c.accept("UNLOGGED") || c.accept("TEMPORARY") || c.accept("TEMP") || c.accept("GLOBAL") ||
+			c.accept("LOCAL") || acceptViewOption(c) {
+		}
+		switch {
+		case c.accept("TABLE"):
+			return p.parseCreateTable(c)
+		case c.accept("VIEW"):
+			return p.parseCreateView(c, viewKind)
+		case c.accept("MATERIALIZED", "VIEW"):
+			return p.parseCreateView(c, materializedViewKind)
+		case c.accept("TYPE"):
+			return p.parseCreateType(c)
+		case c.accept("DOMAIN"):
+			return p.parseCreateDomain(c)
+		case c.accept("UNIQUE", "INDEX"):
+			return p.parseCreateIndex(c, true, "")
+		case c.accept("INDEX"):
+			return p.parseCreateIndex(c, false, "")
+		case c.accept("FULLTEXT", "INDEX"):
+			return p.parseCreateIndex(c, false, "fulltext")
+		case c.accept("SPATIAL", "INDEX"):
+			return p.parseCreateIndex(c, false, "spatial")
+		case c.accept("FUNCTION"):
+			return p.parseCreateRoutine(c, functionKind)
+		case c.accept("PROCEDURE"):
+			return p.parseCreateRoutine(c, procedureKind)
+		case c.accept("TRIGGER"), c.accept("CONSTRAINT", "TRIGGER"):
+			return p.parseCreateTrigger(c, stmt)
+		case c.accept("SEQUENCE"):
+			return p.parseCreateSequence(c)
+		}
+		return nil
+	}
+
+	if c.accept("ALTER", "TABLE") {
+		return p.parseAlterTable(c)
+	}
+
+	if c.accept("ALTER", "SEQUENCE") {
+		return p.parseAlterSequence(c)
+	}
+
+	if c.accept("SELECT") {
+		return p.parseSetval(c)
+	}
+
+	if c.accept("COMMENT", "ON") {
+		return p.parseComment(c)
+	}
+
+	return nil
+}
+
+// psqlRoutineOptions are the keywords that start the options of a postgres function or procedure.
+var psqlRoutineOptions = []string{"LANGUAGE", "AS", "IMMUTABLE", "STABLE", "VOLATILE", "STRICT", "CALLED", "SECURITY",
+	"EXTERNAL", "PARALLEL", "COST", "ROWS", "SUPPORT", "SET", "WINDOW", "LEAKPROOF", "NOT", "TRANSFORM", "RETURN",
+	"BEGIN"}
+
+// mysqlRoutineCharacteristics are the keywords that start the characteristics of a mysql function or procedure.
+var mysqlRoutineCharacteristics = []string{"COMMENT", "LANGUAGE", "DETERMINISTIC", "NOT", "CONTAINS", "NO", "READS",
+	"MODIFIES", "SQL", "DATA"}
+
+// isKeywordOf checks whether the given token is one of the given keywords.
+func isKeywordOf(t ddlToken, keywords []string) bool {
+	for _, k := range keywords {
+		if t.is(k) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseCreateRoutine registers the function or the procedure created by the statement, e.g.
+// CREATE FUNCTION public.order_total(order_id integer) RETURNS numeric LANGUAGE sql AS $$ ... $$.
+// The body of a postgres routine is its dollar quoted string, while the body of a mysql routine is anything after
+// its characteristics.
+func (p *dumpParser) parseCreateRoutine(c *ddlCursor, kind string) error {
+	c.accept("IF", "NOT", "EXISTS")
+	name := c.qualifiedName(p.dialect)
+	if len(name) == 0 || !p.inSchema(name) {
+		return nil
+	}
+	args := c.parenthesized()
+	r := dbRoutine{Name: name[len(name)-1], Kind: kind, Arguments: renderDDL(args, p.dialect)}
+
+	if p.dialect == "mysql" {
+		r.Language = "sql"
+		if c.accept("RETURNS") {
+			r.ReturnType = renderDDL(mysqlReturnType(c), p.dialect)
+		}
+		for !c.done() && isKeywordOf(c.peek(), mysqlRoutineCharacteristics) {
+			if c.next().is("COMMENT") || c.accept("SECURITY") {
+				c.next()
+			}
+		}
+		r.Body = renderDDL(c.tokens[c.pos:], p.dialect)
+		p.addRoutine(r)
+		return nil
+	}
+
+	r.Schema = p.schemaOf(name)
+	r.Signature = routineSignature(args, p.dialect)
+	for !c.done() {
+		switch {
+		case c.accept("RETURNS"):
+			start := c.pos
+			for !c.done() && !isKeywordOf(c.peek(), psqlRoutineOptions) {
+				if c.peek().isSymbol("(") {
+					c.parenthesized()
+					continue
+				}
+				c.next()
+			}
+			r.ReturnType = renderDDL(c.tokens[start:c.pos], p.dialect)
+		case c.accept("LANGUAGE"):
+			r.Language = strings.ToLower(c.next().text)
+		case c.accept("AS"):
+			if c.peek().kind == ddlString {
+				r.Body = c.next().text
+			}
+		case c.peek().is("RETURN"), c.peek().is("BEGIN"):
+			// The body of the routines written in standard sql goes until the end of the statement.
+			r.Body = renderDDL(c.tokens[c.pos:], p.dialect)
+			c.pos = len(c.tokens)
+		default:
+			c.next()
+		}
+	}
+	p.addRoutine(r)
+	return nil
+}
+
+// mysqlReturnType reads the return type of a mysql function, e.g. decimal(10,2) or varchar(20) CHARSET utf8mb4.
+func mysqlReturnType(c *ddlCursor) []ddlToken {
+	start := c.pos
+	c.next()
+	c.parenthesized()
+	for {
+		switch {
+		case c.accept("UNSIGNED"), c.accept("SIGNED"), c.accept("ZEROFILL"):
+		case c.accept("CHARSET"), c.accept("CHARACTER", "SET"), c.accept("COLLATE"):
+			c.next()
+		default:
+			return c.tokens[start:c.pos]
+		}
+	}
+}
+
+// routineSignature returns the arguments of a postgres routine without their defaults, which is how postgres
+// tells apart its overloaded routines.
+func routineSignature(args []ddlToken, dialect string) string {
+	signature := make([]string, 0)
+	for _, item := range splitDDLList(args) {
+		for i := range item {
+			if item[i].is("DEFAULT") || item[i].isSymbol("=") {
+				item = item[:i]
+				break
+			}
+		}
+		signature = append(signature, renderDDL(item, dialect))
+	}
+	return strings.Join(signature, ", ")
+}
+
+// parseCreateTrigger registers the trigger created by the given statement, e.g.
+// CREATE TRIGGER product_audit AFTER INSERT OR UPDATE ON public.product FOR EACH ROW EXECUTE FUNCTION audit().
+// Triggers are only registered for the tables of the scanned schemas. The body of a postgres trigger is its whole
+// statement, which tells the function it executes, and the language of the trigger is the language of that function.
+func (p *dumpParser) parseCreateTrigger(c *ddlCursor, stmt []ddlToken) error {
+	c.accept("IF", "NOT", "EXISTS")
+	name := c.qualifiedName(p.dialect)
+	if len(name) == 0 {
+		return nil
+	}
+	event, tableName := triggerEvent(c, p.dialect)
+	if !p.hasTable(tableName) {
+		return nil
+	}
+	r := dbRoutine{Name: name[len(name)-1], Kind: triggerKind, Table: p.tableID(tableName), Event: event}
+
+	if p.dialect == "mysql" {
+		r.Language = "sql"
+		if c.accept("FOLLOWS") || c.accept("PRECEDES") {
+			c.next()
+		}
+		r.Body = renderDDL(c.tokens[c.pos:], p.dialect)
+		p.addRoutine(r)
+		return nil
+	}
+
+	r.Schema = p.schemaOf(tableName)
+	r.Body = renderDDL(stmt, p.dialect)
+	for !c.done() && !c.peek().is("EXECUTE") {
+		c.next()
+	}
+	if c.accept("EXECUTE") && (c.accept("FUNCTION") || c.accept("PROCEDURE")) {
+		function := c.qualifiedName(p.dialect)
+		for _, f := range p.cat.Routines {
+			if f.Kind == functionKind && f.Name == function[len(function)-1] && f.Schema == p.schemaOf(function) {
+				r.Language = f.Language
+			}
+		}
+	}
+	p.addRoutine(r)
+	return nil
+}
+
+// triggerEvent reads the timing, the events and the level of a trigger right after its name, e.g.
+// BEFORE INSERT OR UPDATE OF name ON product FOR EACH ROW, and returns them as BEFORE INSERT OR UPDATE FOR EACH ROW
+// together with the qualified name of the table of the trigger. The columns of the UPDATE OF events are left out.
+// Triggers without a level fire for each statement in postgres and for each row anywhere else.
+func triggerEvent(c *ddlCursor, dialect string) (string, []string) {
+	timing := "BEFORE"
+	switch {
+	case c.accept("BEFORE"):
+	case c.accept("AFTER"):
+		timing = "AFTER"
+	case c.accept("INSTEAD", "OF"):
+		timing = "INSTEAD OF"
+	}
+
+	events := make([]string, 0)
+	for !c.done() && !c.peek().is("ON") {
+		if t := c.next(); t.is("INSERT") || t.is("DELETE") || t.is("UPDATE") || t.is("TRUNCATE") {
+			events = append(events, strings.ToUpper(t.text))
+		}
+	}
+	c.accept("ON")
+	tableName := c.qualifiedName(dialect)
+
+	level := "ROW"
+	if dialect == "postgres" {
+		level = "STATEMENT"
+	}
+	for !c.done() && !c.peek().is("FOR") && !c.peek().is("WHEN") && !c.peek().is("BEGIN") &&
+		!c.peek().is("EXECUTE") {
+		c.next()
+	}
+	if c.accept("FOR") {
+		c.accept("EACH")
+		level = strings.ToUpper(c.next().text)
+	}
+
+	return fmt.Sprintf("%s %s FOR EACH %s", timing, strings.Join(events, " OR "), level), tableName
+}
+
+// addRoutine registers the given routine, replacing any routine created before with the same id.
+func (p *dumpParser) addRoutine(r dbRoutine) {
+	id := routineID(r.Schema, r.Name, r.Kind, r.Table, r.Signature)
+	for i, stored := range p.cat.Routines {
+		if routineID(stored.Schema, stored.Name, stored.Kind, stored.Table, stored.Signature) == id {
+			p.cat.Routines[i] = r
+			return
+		}
+	}
+	p.cat.Routines = append(p.cat.Routines, r)
+}
+
+func (p *dumpParser) parseCreateTable(c *ddlCursor) error {
+	c.accept("IF", "NOT", "EXISTS")
+	name := c.qualifiedName(p.dialect)
+	if !p.inSchema(name) {
+		return nil
+	}
+	tableName := p.tableID(name)
+
+	// Partitions are documented within their parent table, e.g.
+	// CREATE TABLE public.events_2020_01 PARTITION OF public.events FOR VALUES FROM ('2020-01-01') TO ('2020-02-01').
+	if c.accept("PARTITION", "OF") {
+		parent := c.qualifiedName(p.dialect)
+		c.parenthesized()
+		p.addPartition(p.tableID(parent), tableName, partitionBound(c, p.dialect))
+		if c.accept("PARTITION", "BY") {
+			p.parsePartitionBy(tableName, c)
+		}
+		return nil
+	}
+
+	body := c.parenthesized()
+	// CREATE TABLE ... AS SELECT, CREATE TABLE ... OF type_name, etc. do not have a definition of their columns.
+	if body == nil {
+		return nil
+	}
+
+	if p.cat.hasTable(tableName) {
+		return fmt.Errorf("table %s is created more than once in the dump", tableName)
+	}
+	p.cat.Tables = append(p.cat.Tables, tableName)
+	if p.dialect == "postgres" {
+		p.cat.Schemas[tableName] = p.schemaOf(name)
+	}
+	p.cat.Columns[tableName] = make([]column, 0)
+
+	for _, element := range splitDDLList(body) {
+		if len(element) == 0 {
+			continue
+		}
+		if isTableConstraint(element[0]) {
+			p.parseTableConstraint(tableName, &ddlCursor{tokens: element})
+			continue
+		}
+		p.parseColumnDefinition(tableName, &ddlCursor{tokens: element})
+	}
+
+	// mysql tables keep their comment, their auto increment counter, their character set and their collation in the
+	// table options, e.g. ENGINE=InnoDB AUTO_INCREMENT=42 DEFAULT CHARSET=utf8mb4 COMMENT='the orders'. The postgres
+	// INHERITS and PARTITION BY clauses go there too.
+	nextValue := int64(1)
+	tableCollation := dbCollation{Table: tableName}
+	for !c.done() {
+		if c.accept("CHARACTER", "SET") || c.accept("CHARSET") || c.accept("COLLATE") {
+			isCollation := c.tokens[c.pos-1].is("COLLATE")
+			if c.peek().isSymbol("=") {
+				c.next()
+			}
+			if isCollation {
+				tableCollation.Collation = identifier(c.next(), p.dialect)
+			} else {
+				tableCollation.Charset = identifier(c.next(), p.dialect)
+			}
+			continue
+		}
+		if c.accept("INHERITS") {
+			for _, parent := range splitDDLList(c.parenthesized()) {
+				pc := &ddlCursor{tokens: parent}
+				p.cat.Inherits = append(p.cat.Inherits, dbInheritance{Table: tableName,
+					Parent: p.tableID(pc.qualifiedName(p.dialect))})
+			}
+			continue
+		}
+		if c.accept("PARTITION", "BY") {
+			p.parsePartitionBy(tableName, c)
+			continue
+		}
+		if c.accept("AUTO_INCREMENT") {
+			if c.peek().isSymbol("=") {
+				c.next()
+			}
+			nextValue = signedNumber(c)
+			continue
+		}
+		if c.accept("COMMENT") {
+			if c.peek().isSymbol("=") {
+				c.next()
+			}
+			if c.peek().kind == ddlString {
+				p.setComment(tableName, "", c.next().text)
+			}
+			continue
+		}
+		c.next()
+	}
+	p.addAutoIncrement(tableName, nextValue)
+	p.setTableCollation(tableCollation)
+
+	return nil
+}
+
+// setTableCollation registers the character set and the collation of a mysql table, and gives them to the text
+// columns of the table without a character set or a collation of their own. The text columns of postgres tables
+// without a collation of their own get the default one.
+func (p *dumpParser) setTableCollation(tableCollation dbCollation) {
+	if p.dialect == "postgres" {
+		tableCollation.Collation = "default"
+	} else if tableCollation.Charset != "" || tableCollation.Collation != "" {
+		p.cat.Collations = append(p.cat.Collations, tableCollation)
+	}
+	for i := range p.cat.Collations {
+		c := &p.cat.Collations[i]
+		if c.Table != tableCollation.Table || c.Col == "" {
+			continue
+		}
+		if c.Charset == "" {
+			c.Charset = tableCollation.Charset
+		}
+		if c.Collation == "" && c.Charset == tableCollation.Charset {
+			c.Collation = tableCollation.Collation
+		}
+	}
+}
+
+// parsePartitionBy reads the partition key of a partitioned table right after PARTITION BY, e.g. RANGE (created_at),
+// followed by the partitions of a mysql table, e.g. (PARTITION p0 VALUES LESS THAN (1990) ENGINE = InnoDB).
+// The partitions of a mysql table created with PARTITIONS n get the names mysql gives them: p0, p1, etc.
+func (p *dumpParser) parsePartitionBy(tableName string, c *ddlCursor) {
+	method := make([]string, 0)
+	for !c.done() && c.peek().kind == ddlWord {
+		method = append(method, strings.ToUpper(c.next().text))
+	}
+	key := strings.Join(method, " ") + " (" + renderDDL(c.parenthesized(), p.dialect) + ")"
+	p.cat.Partitions = append(p.cat.Partitions, dbPartition{Table: tableName, Key: key})
+
+	count := int64(0)
+	for !c.done() && !c.peek().isSymbol("(") {
+		switch {
+		case c.accept("PARTITIONS"):
+			count = signedNumber(c)
+		case c.accept("SUBPARTITION", "BY"):
+			for !c.done() && c.peek().kind == ddlWord {
+				c.next()
+			}
+			c.parenthesized()
+		default:
+			c.next()
+		}
+	}
+
+	if !c.peek().isSymbol("(") {
+		for i := int64(0); i < count; i++ {
+			p.addPartition(tableName, fmt.Sprintf("p%d", i), "")
+		}
+		return
+	}
+	for _, item := range splitDDLList(c.parenthesized()) {
+		ic := &ddlCursor{tokens: item}
+		if !ic.accept("PARTITION") {
+			continue
+		}
+		name := identifier(ic.next(), p.dialect)
+		p.addPartition(tableName, name, partitionBound(ic, p.dialect))
+	}
+}
+
+// addPartition registers the partition with the given id and bound of the partitioned table with the given id.
+func (p *dumpParser) addPartition(tableName string, partitionID string, bound string) {
+	p.partitionIDs[partitionID] = true
+	p.cat.Partitions = append(p.cat.Partitions, dbPartition{Table: tableName, Partition: partitionID, Bound: bound})
+}
+
+// partitionBoundKeywords are the keywords of the bounds of the partitions.
+var partitionBoundKeywords = []string{"FOR", "VALUES", "FROM", "TO", "IN", "WITH", "LESS", "THAN", "DEFAULT"}
+
+// partitionBound reads the bound of a partition, e.g. FOR VALUES FROM ('2020-01-01') TO ('2020-02-01') or
+// VALUES LESS THAN (1990), as the database writes it.
+func partitionBound(c *ddlCursor, dialect string) string {
+	words := make([]string, 0)
+	for !c.done() {
+		switch {
+		case c.peek().isSymbol("("):
+			words = append(words, "("+renderDDL(c.parenthesized(), dialect)+")")
+		case c.peek().is("MAXVALUE") && dialect == "mysql":
+			c.next()
+			words = append(words, "(MAXVALUE)")
+		case isKeywordOf(c.peek(), partitionBoundKeywords):
+			words = append(words, strings.ToUpper(c.next().text))
+		default:
+			return strings.Join(words, " ")
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// acceptViewOption consumes a mysql view option, e.g. ALGORITHM=UNDEFINED, DEFINER=`root`@`localhost` or
+// SQL SECURITY DEFINER, if it is the next thing in the statement.
+func acceptViewOption(c *ddlCursor) bool {
+	switch {
+	case c.accept("ALGORITHM"), c.accept("DEFINER"):
+		if c.peek().isSymbol("=") {
+			c.next()
+		}
+		c.next()
+		if c.peek().isSymbol("@") {
+			c.next()
+			c.next()
+		}
+		return true
+	case c.accept("SQL", "SECURITY"):
+		c.next()
+		return true
+	}
+	return false
+}
+
+// parseCreateView registers the view created by the statement with its definition.
+// A dump does not tell the types of the columns of a view, so we only get their names from the column list of
+// the view or from the select list of its definition.
+// Views created again replace the previous one, e.g. the stand-in tables and views written by mysqldump or the
+// dummy views written by pg_dump to break circular dependencies.
+func (p *dumpParser) parseCreateView(c *ddlCursor, kind string) error {
+	c.accept("IF", "NOT", "EXISTS")
+	name := c.qualifiedName(p.dialect)
+	if !p.inSchema(name) {
+		return nil
+	}
+	viewName := p.tableID(name)
+
+	colNames := identifierList(c.parenthesized(), p.dialect)
+	for !c.done() && !c.peek().is("AS") {
+		c.next()
+	}
+	if !c.accept("AS") {
+		return nil
+	}
+	query := c.tokens[c.pos:]
+	if len(query) >= 2 && query[len(query)-2].is("WITH") && query[len(query)-1].is("DATA") {
+		query = query[:len(query)-2]
+	} else if len(query) >= 3 && query[len(query)-3].is("WITH") && query[len(query)-1].is("DATA") {
+		query = query[:len(query)-3]
+	}
+	if len(colNames) == 0 {
+		colNames = selectListNames(query, p.dialect)
+	}
+
+	if !p.cat.hasTable(viewName) {
+		p.cat.Tables = append(p.cat.Tables, viewName)
+	}
+	if p.dialect == "postgres" {
+		p.cat.Schemas[viewName] = p.schemaOf(name)
+	}
+	p.cat.Kinds[viewName] = kind
+	p.cat.Definitions[viewName] = renderDDL(query, p.dialect)
+	p.cat.Columns[viewName] = make([]column, 0, len(colNames))
+	for _, colName := range colNames {
+		p.cat.Columns[viewName] = append(p.cat.Columns[viewName], column{Name: colName, Nullable: true})
+	}
+	return nil
+}
+
+// selectListNames returns the names of the columns returned by the given select query, e.g.
+// SELECT o.id, count(*) AS lines FROM ... returns id and lines. Expressions without an alias are skipped and
+// a select list with a * returns no names at all, as we cannot tell its columns.
+func selectListNames(tokens []ddlToken, dialect string) []string {
+	start, end, depth := -1, len(tokens), 0
+	for i, t := range tokens {
+		switch {
+		case t.isSymbol("("):
+			depth++
+		case t.isSymbol(")"):
+			depth--
+		case depth == 0 && start == -1 && t.is("SELECT"):
+			start = i + 1
+		case depth == 0 && start != -1 && t.is("FROM"):
+			end = i
+		}
+		if end != len(tokens) {
+			break
+		}
+	}
+	if start == -1 {
+		return nil
+	}
+
+	names := make([]string, 0)
+	list := tokens[start:end]
+	if len(list) > 0 && (list[0].is("DISTINCT") || list[0].is("ALL")) {
+		list = list[1:]
+	}
+	for _, item := range splitDDLList(list) {
+		if len(item) == 0 {
+			continue
+		}
+		last := item[len(item)-1]
+		if last.isSymbol("*") {
+			return nil
+		}
+		if last.kind != ddlWord && last.kind != ddlQuotedIdent {
+			continue
+		}
+		if len(item) > 1 {
+			prev := item[len(item)-2]
+			if !prev.is("AS") && !prev.isSymbol(".") && prev.kind != ddlWord && prev.kind != ddlQuotedIdent &&
+				!prev.isSymbol(")") {
+				continue
+			}
+		}
+		names = append(names, identifier(last, dialect))
+	}
+	return names
+}
+
+// renderDDL writes the given tokens back as sql.
+func renderDDL(tokens []ddlToken, dialect string) string {
+	var b strings.Builder
+	for i, t := range tokens {
+		if i > 0 && !t.isSymbol(".") && !t.isSymbol(",") && !t.isSymbol(")") && !t.isSymbol(";") &&
+			!tokens[i-1].isSymbol(".") &&
+			!tokens[i-1].isSymbol("(") && !(t.isSymbol("(") && isFunctionName(tokens[i-1])) && !t.isSymbol(":") &&
+			!tokens[i-1].isSymbol(":") {
+			b.WriteString(" ")
+		}
+		switch t.kind {
+		case ddlString:
+			b.WriteString("'" + strings.Replace(t.text, "'", "''", -1) + "'")
+		case ddlQuotedIdent:
+			if dialect == "mysql" {
+				b.WriteString("`" + t.text + "`")
+			} else {
+				b.WriteString(`"` + strings.Replace(t.text, `"`, `""`, -1) + `"`)
+			}
+		default:
+			b.WriteString(t.text)
+		}
+	}
+	return b.String()
+}
+
+// isFunctionName checks whether a word followed by an opening parenthesis is the name of a function instead of
+// a keyword, e.g. count(*) but not IN (1, 2).
+func isFunctionName(t ddlToken) bool {
+	if t.kind == ddlQuotedIdent {
+		return true
+	}
+	if t.kind != ddlWord {
+		return false
+	}
+	for _, k := range []string{"AS", "IN", "ON", "AND", "OR", "NOT", "FROM", "JOIN", "WHERE", "EXISTS", "SELECT",
+		"USING", "OVER", "VALUES", "ANY", "ALL", "WITH"} {
+		if t.is(k) {
+			return false
+		}
+	}
+	return true
+}
+
+// isTableConstraint checks whether a table element starting with the given token is a constraint or
+// an index instead of a column definition.
+func isTableConstraint(t ddlToken) bool {
+	for _, k := range []string{"CONSTRAINT", "PRIMARY", "UNIQUE", "FOREIGN", "CHECK", "KEY", "INDEX",
+		"FULLTEXT", "SPATIAL", "EXCLUDE", "LIKE"} {
+		if t.is(k) {
+			return true
+		}
+	}
+	return false
+}
+
+// columnModifiers are the keywords that end the data type in a column definition.
+var columnModifiers = []string{"NOT", "NULL", "DEFAULT", "PRIMARY", "UNIQUE", "REFERENCES", "CONSTRAINT", "CHECK",
+	"COLLATE", "AUTO_INCREMENT", "COMMENT", "GENERATED", "CHARSET", "ON", "AS", "STORED", "VIRTUAL", "INVISIBLE",
+	"VISIBLE", "SRID"}
+
+func isColumnModifier(t ddlToken) bool {
+	for _, k := range columnModifiers {
+		if t.is(k) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *dumpParser) parseColumnDefinition(tableName string, c *ddlCursor) {
+	col := column{Name: identifier(c.next(), p.dialect), Nullable: true}
+
+	// The data type goes until the first column modifier, e.g. "character varying(200)" or "bigint unsigned".
+	typeTokens := make([]ddlToken, 0)
+	for !c.done() && !isColumnModifier(c.peek()) {
+		// mysql "CHARACTER SET utf8mb4" must not be confused with the postgres "character" type.
+		if c.peek().is("CHARACTER") && len(typeTokens) > 0 {
+			break
+		}
+		if c.peek().isSymbol("(") {
+			typeTokens = append(typeTokens, c.tokens[c.pos])
+			typeTokens = append(typeTokens, c.parenthesized()...)
+			typeTokens = append(typeTokens, ddlToken{kind: ddlSymbol, text: ")"})
+			continue
+		}
+		typeTokens = append(typeTokens, c.next())
+	}
+
+	dumpType := parseDumpType(typeTokens, p.dialect)
+	col.DBType = dumpType.dbType
+	col.Length = dumpType.length
+	col.Precision = dumpType.precision
+	col.Scale = dumpType.scale
+
+	isPK := false
+	pkName := ""
+	constraintName := ""
+	def := colDefault{Table: tableName, Col: col.Name}
+	extras := make([]string, 0)
+	checks := make([]tableCheck, 0)
+	collation := dbCollation{Table: tableName, Col: col.Name}
+	var identityOptions []ddlToken
+	for !c.done() {
+		switch {
+		case c.accept("CHARACTER", "SET"), c.accept("CHARSET"):
+			collation.Charset = identifier(c.next(), p.dialect)
+		case c.accept("COLLATE"):
+			name := c.qualifiedName(p.dialect)
+			collation.Collation = name[len(name)-1]
+		case c.accept("DEFAULT"):
+			expr := c.expression()
+			def.Default = renderDDL(expr, p.dialect)
+			// mysql reports the literal defaults without their quotes.
+			if p.dialect == "mysql" && len(expr) == 1 && expr[0].kind == ddlString {
+				def.Default = expr[0].text
+			}
+		case c.accept("AUTO_INCREMENT"):
+			extras = append(extras, "auto_increment")
+		case c.accept("COMMENT"):
+			if c.peek().kind == ddlString {
+				p.setComment(tableName, col.Name, c.next().text)
+			}
+		case c.accept("ON", "UPDATE"):
+			extras = append(extras, "on update "+renderDDL(c.expression(), p.dialect))
+		case c.accept("GENERATED"), c.peek().is("AS") && c.pos+1 < len(c.tokens) && c.tokens[c.pos+1].isSymbol("("):
+			generation, identity, options := p.parseGenerated(c)
+			if identity != "" {
+				def.Identity = identity
+				identityOptions = options
+			} else {
+				def.Generation = generation
+				extras = append(extras, generatedStorage(c, p.dialect))
+			}
+		case c.accept("CONSTRAINT"):
+			constraintName = identifier(c.next(), p.dialect)
+		case c.accept("NOT", "NULL"):
+			col.Nullable = false
+			constraintName = ""
+		case c.accept("PRIMARY", "KEY"):
+			isPK = true
+			pkName = constraintName
+			col.Nullable = false
+			constraintName = ""
+		case c.accept("UNIQUE"):
+			c.accept("KEY")
+			p.addUniqueIndex(tableName, tableIndex{Name: constraintName, Keys: []indexKey{{Column: col.Name}}})
+			constraintName = ""
+		case c.accept("REFERENCES"):
+			fk, targetCols := p.parseReferences(c)
+			p.addForeignKey(tableName, constraintName, []string{col.Name}, fk, targetCols)
+			constraintName = ""
+		case c.accept("CHECK"):
+			checks = append(checks, tableCheck{name: constraintName, clause: c.parenthesized()})
+			constraintName = ""
+		case c.peek().isSymbol("("):
+			c.parenthesized()
+		default:
+			c.next()
+		}
+	}
+
+	col.GoType = dumpType.goType(p.dialect, col.Nullable)
+	p.cat.Columns[tableName] = append(p.cat.Columns[tableName], col)
+
+	// The columns without a character set or a collation of their own get the ones of their table, see
+	// parseCreateTable.
+	if dumpType.collatable() || collation.Charset != "" || collation.Collation != "" {
+		p.cat.Collations = append(p.cat.Collations, collation)
+	}
+
+	def.Extra = strings.Join(extras, " ")
+	if def.Default != "" || def.Identity != "" || def.Generation != "" || def.Extra != "" {
+		p.cat.Defaults = append(p.cat.Defaults, def)
+	}
+
+	if isPK {
+		p.addPrimaryKey(tableName, pkName, []string{col.Name})
+	}
+
+	// The check constraints and the sequence of a column can only be registered once the column is part of the table.
+	for _, check := range checks {
+		p.addCheck(tableName, check.name, check.clause)
+	}
+	if def.Identity != "" {
+		p.addIdentitySequence(tableName, col.Name, identityOptions)
+	}
+
+	if dumpType.enumValues != nil {
+		p.cat.Enums = append(p.cat.Enums, colAndEnum{
+			Table:      tableName,
+			Col:        col.Name,
+			EnumName:   "enum",
+			EnumValues: strings.Join(dumpType.enumValues, ","),
+		})
+	}
+}
+
+// parseGenerated reads the generation of a column right after the GENERATED keyword, e.g.
+// GENERATED ALWAYS AS (price * 2) or GENERATED BY DEFAULT AS IDENTITY, or the mysql AS (price * 2).
+// It returns the generation expression of generated columns or the generation of identity columns together with
+// the options of their sequence, e.g. SEQUENCE NAME public.order_id_seq START WITH 1.
+func (p *dumpParser) parseGenerated(c *ddlCursor) (generation string, identity string, options []ddlToken) {
+	identity = "ALWAYS"
+	if c.accept("BY", "DEFAULT") {
+		identity = "BY DEFAULT"
+	}
+	c.accept("ALWAYS")
+	c.accept("AS")
+	if c.accept("IDENTITY") {
+		return "", identity, c.parenthesized()
+	}
+	return renderDDL(c.parenthesized(), p.dialect), "", nil
+}
+
+// generatedStorage reads how the values of a generated column are kept, e.g. STORED, and returns it the way
+// mysql reports it in the extra information of the column. Generated columns are virtual by default in mysql,
+// while postgres only has stored generated columns.
+func generatedStorage(c *ddlCursor, dialect string) string {
+	switch {
+	case c.accept("STORED"):
+		return "STORED GENERATED"
+	case c.accept("VIRTUAL"):
+		return "VIRTUAL GENERATED"
+	case dialect == "mysql":
+		return "VIRTUAL GENERATED"
+	}
+	return "STORED GENERATED"
+}
+
+// setDefault updates the default of the column with the given colName in the given tableName.
+func (p *dumpParser) setDefault(tableName string, colName string, update func(def *colDefault)) {
+	for i := range p.cat.Defaults {
+		if p.cat.Defaults[i].Table == tableName && p.cat.Defaults[i].Col == colName {
+			update(&p.cat.Defaults[i])
+			return
+		}
+	}
+	def := colDefault{Table: tableName, Col: colName}
+	update(&def)
+	p.cat.Defaults = append(p.cat.Defaults, def)
+}
+
+// parseReferences reads the target of a foreign key, e.g. public."order"(id) ON DELETE RESTRICT, right after
+// the REFERENCES keyword, and returns it with the referenced columns.
+func (p *dumpParser) parseReferences(c *ddlCursor) (foreignKey, []string) {
+	fk := foreignKey{DeleteRule: "NO ACTION", UpdateRule: "NO ACTION"}
+	target := c.qualifiedName(p.dialect)
+	fk.TargetTable = p.tableID(target)
+	targetCols := identifierList(c.parenthesized(), p.dialect)
+
+	for !c.done() {
+		switch {
+		case c.accept("ON", "DELETE"):
+			fk.DeleteRule = referentialAction(c)
+		case c.accept("ON", "UPDATE"):
+			fk.UpdateRule = referentialAction(c)
+		case c.accept("MATCH"):
+			c.next()
+		default:
+			return fk, targetCols
+		}
+	}
+	return fk, targetCols
+}
+
+// referentialAction reads the action of an ON DELETE or ON UPDATE clause of a foreign key.
+func referentialAction(c *ddlCursor) string {
+	switch {
+	case c.accept("NO", "ACTION"):
+		return "NO ACTION"
+	case c.accept("SET", "NULL"):
+		return "SET NULL"
+	case c.accept("SET", "DEFAULT"):
+		return "SET DEFAULT"
+	default:
+		return strings.ToUpper(c.next().text)
+	}
+}
+
+// parseTableConstraint reads a table constraint or an index of a CREATE TABLE or an ALTER TABLE ... ADD statement.
+// Exclusion constraints are ignored.
+func (p *dumpParser) parseTableConstraint(tableName string, c *ddlCursor) {
+	name := ""
+	if c.accept("CONSTRAINT") {
+		// The constraint name is optional in mysql, e.g. CONSTRAINT FOREIGN KEY (...).
+		if !c.peek().is("PRIMARY") && !c.peek().is("UNIQUE") && !c.peek().is("FOREIGN") && !c.peek().is("CHECK") {
+			name = identifier(c.next(), p.dialect)
+		}
+	}
+
+	switch {
+	case c.accept("PRIMARY", "KEY"):
+		p.addPrimaryKey(tableName, name, identifierList(c.parenthesized(), p.dialect))
+	case c.accept("UNIQUE"):
+		_ = c.accept("KEY") || c.accept("INDEX")
+		p.addUniqueIndex(tableName, p.parseIndexDefinition(c, name))
+	case c.accept("KEY"), c.accept("INDEX"):
+		p.addIndex(tableName, p.parseIndexDefinition(c, name))
+	case c.accept("FULLTEXT"), c.accept("SPATIAL"):
+		method := strings.ToLower(c.tokens[c.pos-1].text)
+		_ = c.accept("KEY") || c.accept("INDEX")
+		index := p.parseIndexDefinition(c, name)
+		index.Method = method
+		p.addIndex(tableName, index)
+	case c.accept("FOREIGN", "KEY"):
+		if !c.peek().isSymbol("(") {
+			c.next()
+		}
+		cols := identifierList(c.parenthesized(), p.dialect)
+		if !c.accept("REFERENCES") {
+			return
+		}
+		fk, targetCols := p.parseReferences(c)
+		p.addForeignKey(tableName, name, cols, fk, targetCols)
+	case c.accept("CHECK"):
+		p.addCheck(tableName, name, c.parenthesized())
+	}
+}
+
+// addForeignKey registers the given columns of the given table as a foreign key with the given name, which
+// references the given target columns. Foreign keys without a name get the name the database would give them.
+func (p *dumpParser) addForeignKey(tableName string, name string, cols []string, fk foreignKey, targetCols []string) {
+	if name == "" {
+		if p.dialect == "postgres" {
+			name = p.cat.table(tableName).Name + "_" + strings.Join(cols, "_") + "_fkey"
+		} else {
+			name = fmt.Sprintf("%s_ibfk_%d", tableName, len(p.cat.foreignKeys(tableName))+1)
+		}
+	}
+	fk.Table = tableName
+	fk.Name = name
+	for i, col := range cols {
+		fk.Col = col
+		fk.TargetCol = ""
+		if i < len(targetCols) {
+			fk.TargetCol = targetCols[i]
+		}
+		p.cat.ForeignKeys = append(p.cat.ForeignKeys, fk)
+	}
+}
+
+// addPrimaryKey registers the given columns of the given table as a primary key with the given name, together
+// with the index backing it. Postgres primary keys are backed by a unique index, so the columns are unique as well.
+func (p *dumpParser) addPrimaryKey(tableName string, name string, cols []string) {
+	if name == "" {
+		if p.dialect == "postgres" {
+			name = p.cat.table(tableName).Name + "_pkey"
+		} else {
+			name = "PRIMARY"
+		}
+	}
+	p.addIndex(tableName, tableIndex{Name: name, Keys: columnKeys(cols), Unique: true})
+	for _, col := range cols {
+		p.cat.PrimaryKeys = append(p.cat.PrimaryKeys, primaryKey{Table: tableName, Col: col, Name: name})
+		if p.dialect == "postgres" {
+			p.cat.Uniques = append(p.cat.Uniques, uniqueCol{Table: tableName, Col: col})
+		}
+		for i := range p.cat.Columns[tableName] {
+			if p.cat.Columns[tableName][i].Name == col {
+				p.cat.Columns[tableName][i].Nullable = false
+			}
+		}
+	}
+}
+
+// parseCreateType reads a CREATE TYPE statement of an enum, a composite type or a range type.
+func (p *dumpParser) parseCreateType(c *ddlCursor) error {
+	name := c.qualifiedName(p.dialect)
+	t := dbDataType{Schema: p.schemaOf(name), Name: name[len(name)-1]}
+	switch {
+	case c.accept("AS", "ENUM"):
+		values := make([]string, 0)
+		for _, item := range splitDDLList(c.parenthesized()) {
+			if len(item) == 1 && item[0].kind == ddlString {
+				values = append(values, item[0].text)
+			}
+		}
+		typeName := name[len(name)-1]
+		p.enumTypes[strings.ToLower(typeName)] = values
+		if len(name) > 1 {
+			p.enumTypes[strings.ToLower(strings.Join(name, "."))] = values
+		}
+		t.Kind = enumTypeKind
+		p.addDataType(name, t, values, nil)
+	case c.accept("AS", "RANGE"):
+		t.Kind = rangeTypeKind
+		for _, item := range splitDDLList(c.parenthesized()) {
+			if len(item) > 2 && item[0].is("SUBTYPE") && item[1].isSymbol("=") {
+				t.BaseType = dumpTypeName(item[2:], p.dialect)
+			}
+		}
+		p.addDataType(name, t, nil, nil)
+	case c.accept("AS"):
+		t.Kind = compositeTypeKind
+		attributes := make([][2]string, 0)
+		for _, item := range splitDDLList(c.parenthesized()) {
+			if len(item) > 1 {
+				attributes = append(attributes, [2]string{identifier(item[0], p.dialect),
+					dumpTypeName(item[1:], p.dialect)})
+			}
+		}
+		p.addDataType(name, t, nil, attributes)
+	}
+	return nil
+}
+
+// parseCreateDomain reads a CREATE DOMAIN statement, e.g.
+// CREATE DOMAIN public.email AS text NOT NULL CONSTRAINT email_check CHECK ((VALUE ~~ '%@%'::text)).
+// Check constraints without a name get the name postgres would give them.
+func (p *dumpParser) parseCreateDomain(c *ddlCursor) error {
+	name := c.qualifiedName(p.dialect)
+	c.accept("AS")
+	t := dbDataType{Schema: p.schemaOf(name), Name: name[len(name)-1], Kind: domainTypeKind}
+
+	typeTokens := make([]ddlToken, 0)
+	for !c.done() && !isColumnModifier(c.peek()) {
+		if c.peek().isSymbol("(") {
+			typeTokens = append(typeTokens, c.tokens[c.pos])
+			typeTokens = append(typeTokens, c.parenthesized()...)
+			typeTokens = append(typeTokens, ddlToken{kind: ddlSymbol, text: ")"})
+			continue
+		}
+		typeTokens = append(typeTokens, c.next())
+	}
+	t.BaseType = dumpTypeName(typeTokens, p.dialect)
+
+	checks := make([][2]string, 0)
+	constraintName := ""
+	for !c.done() {
+		switch {
+		case c.accept("DEFAULT"):
+			t.Default = renderDDL(c.expression(), p.dialect)
+		case c.accept("CONSTRAINT"):
+			constraintName = identifier(c.next(), p.dialect)
+		case c.accept("NOT", "NULL"):
+			t.NotNull = true
+			constraintName = ""
+		case c.accept("CHECK"):
+			if constraintName == "" {
+				constraintName = t.Name + "_check"
+			}
+			clause := renderDDL(unwrapParentheses(c.parenthesized()), p.dialect)
+			checks = append(checks, [2]string{constraintName, clause})
+			constraintName = ""
+		default:
+			c.next()
+		}
+	}
+
+	p.addDataType(name, t, nil, checks)
+	return nil
+}
+
+// addDataType registers the user defined type t with the given qualified name in the catalog, together with the
+// labels of an enum or the items of a domain or a composite type given as name and definition pairs.
+// Types are registered even outside of the scanned schemas, so the columns typed with them are resolved, but they
+// are only documented if they belong to the scanned schemas.
+func (p *dumpParser) addDataType(name []string, t dbDataType, labels []string, items [][2]string) {
+	p.userTypes[strings.ToLower(t.Name)] = t
+	if len(name) > 1 {
+		p.userTypes[strings.ToLower(strings.Join(name, "."))] = t
+	}
+	if !p.inSchema(name) {
+		return
+	}
+	if len(labels) == 0 && len(items) == 0 {
+		p.cat.Types = append(p.cat.Types, t)
+	}
+	for _, label := range labels {
+		t.Item = label
+		p.cat.Types = append(p.cat.Types, t)
+	}
+	for _, item := range items {
+		t.Item, t.Definition = item[0], item[1]
+		p.cat.Types = append(p.cat.Types, t)
+	}
+}
+
+// psqlDumpRanges maps the built-in range types of postgres to their subtypes.
+var psqlDumpRanges = map[string]string{
+	"int4range": "integer",
+	"int8range": "bigint",
+	"numrange":  "numeric",
+	"tsrange":   "timestamp without time zone",
+	"tstzrange": "timestamp with time zone",
+	"daterange": "date",
+}
+
+// columnType resolves the type of the given column if it is typed with a domain, a composite type, a range type
+// or an array, just like the ColumnsTypes query of the postgres introspector does.
+func (p *dumpParser) columnType(col column) (colType, bool) {
+	if p.dialect != "postgres" {
+		return colType{}, false
+	}
+	typeName := strings.ToLower(col.DBType)
+	ct := colType{Col: col.Name}
+	if strings.HasSuffix(typeName, "[]") {
+		ct.Kind = arrayTypeKind
+		ct.BaseType = strings.TrimSuffix(typeName, "[]")
+		return ct, true
+	}
+	if subtype, ok := psqlDumpRanges[typeName]; ok {
+		ct.Kind = rangeTypeKind
+		ct.BaseType = subtype
+		return ct, true
+	}
+	t, ok := p.userTypes[typeName]
+	if !ok || t.Kind == enumTypeKind {
+		return colType{}, false
+	}
+	ct.Kind = t.Kind
+	ct.UserType = tableID(t.Schema, t.Name)
+	ct.BaseType = t.BaseType
+	return ct, true
+}
+
+// dumpTypeName returns the name of the data type written with the given tokens as postgres formats it, e.g.
+// character varying(200).
+func dumpTypeName(tokens []ddlToken, dialect string) string {
+	for i, t := range tokens {
+		if t.is("COLLATE") {
+			tokens = tokens[:i]
+			break
+		}
+	}
+	return renderDDL(tokens, dialect)
+}
+
+// parseCreateIndex reads a CREATE INDEX statement. Indexes using the default method of the database are b-trees.
+func (p *dumpParser) parseCreateIndex(c *ddlCursor, unique bool, method string) error {
+	c.accept("CONCURRENTLY")
+	c.accept("IF", "NOT", "EXISTS")
+	name := ""
+	if !c.peek().is("ON") {
+		name = identifier(c.next(), p.dialect)
+	}
+	// mysql allows the index type before the table, e.g. CREATE INDEX idx USING BTREE ON t (c).
+	if c.accept("USING") {
+		method = strings.ToLower(c.next().text)
+	}
+	if !c.accept("ON") {
+		return nil
+	}
+	c.accept("ONLY")
+	tbl := c.qualifiedName(p.dialect)
+	if !p.hasTable(tbl) {
+		return nil
+	}
+	if c.accept("USING") {
+		method = strings.ToLower(c.next().text)
+	}
+
+	index := tableIndex{Name: name, Keys: indexKeys(c.parenthesized(), p.dialect), Unique: unique, Method: method}
+	for !c.done() {
+		switch {
+		case c.accept("WHERE"):
+			index.Predicate = renderDDL(c.tokens[c.pos:], p.dialect)
+			c.pos = len(c.tokens)
+		case c.accept("USING"):
+			index.Method = strings.ToLower(c.next().text)
+		case c.peek().isSymbol("("):
+			// INCLUDE (...) and WITH (...) do not change the keys of the index.
+			c.parenthesized()
+		default:
+			c.next()
+		}
+	}
+
+	if unique {
+		p.addUniqueIndex(p.tableID(tbl), index)
+		return nil
+	}
+	p.addIndex(p.tableID(tbl), index)
+	return nil
+}
+
+// parseIndexDefinition reads the name, the method and the keys of an index defined in a table constraint,
+// e.g. the KEY `name` (`name`) USING BTREE of mysql. The given name is used when the index does not have one.
+func (p *dumpParser) parseIndexDefinition(c *ddlCursor, name string) tableIndex {
+	index := tableIndex{Name: name}
+	if !c.peek().isSymbol("(") && !c.peek().is("USING") {
+		index.Name = identifier(c.next(), p.dialect)
+	}
+	if c.accept("USING") {
+		index.Method = strings.ToLower(c.next().text)
+	}
+	index.Keys = indexKeys(c.parenthesized(), p.dialect)
+	if c.accept("USING") {
+		index.Method = strings.ToLower(c.next().text)
+	}
+	return index
+}
+
+// indexKeys returns the keys of the given comma separated list of index keys, e.g. (id, lower(name) DESC).
+// The sort order, the operator class and the prefix length of a key are not part of the key.
+func indexKeys(tokens []ddlToken, dialect string) []indexKey {
+	keys := make([]indexKey, 0)
+	for _, item := range splitDDLList(tokens) {
+		for len(item) > 0 && (item[len(item)-1].is("ASC") || item[len(item)-1].is("DESC") ||
+			item[len(item)-1].is("FIRST") || item[len(item)-1].is("LAST") || item[len(item)-1].is("NULLS")) {
+			item = item[:len(item)-1]
+		}
+		if len(item) == 0 {
+			continue
+		}
+		if isColumnKey(item) {
+			keys = append(keys, indexKey{Column: identifier(item[0], dialect)})
+			continue
+		}
+		// Expressions are enclosed by parentheses unless they are a function call.
+		keys = append(keys, indexKey{Expression: renderDDL(unwrapParentheses(item), dialect)})
+	}
+	return keys
+}
+
+// unwrapParentheses returns the tokens enclosed by the given tokens when they are all enclosed by a pair of
+// parentheses, e.g. (price > 0), or the given tokens otherwise.
+func unwrapParentheses(tokens []ddlToken) []ddlToken {
+	c := &ddlCursor{tokens: tokens}
+	if inner := c.parenthesized(); inner != nil && c.done() {
+		return inner
+	}
+	return tokens
+}
+
+// isColumnKey checks whether the given index key is a column, which can be followed by an operator class or
+// a collation in postgres, e.g. name text_pattern_ops, or by a prefix length in mysql, e.g. `name`(10).
+func isColumnKey(item []ddlToken) bool {
+	if item[0].kind != ddlWord && item[0].kind != ddlQuotedIdent {
+		return false
+	}
+	if len(item) == 4 && item[1].isSymbol("(") && item[2].kind == ddlNumber && item[3].isSymbol(")") {
+		return true
+	}
+	for _, t := range item[1:] {
+		if t.kind == ddlSymbol {
+			return false
+		}
+	}
+	return true
+}
+
+// columnKeys returns the given columns as index keys.
+func columnKeys(cols []string) []indexKey {
+	keys := make([]indexKey, len(cols))
+	for i := range cols {
+		keys[i] = indexKey{Column: cols[i]}
+	}
+	return keys
+}
+
+// addUniqueIndex registers the given index of the given table as a unique index and marks its columns as unique.
+// Unique indexes without a name get the name the database would give them.
+func (p *dumpParser) addUniqueIndex(tableName string, index tableIndex) {
+	index.Unique = true
+	if index.Name == "" && len(index.Keys) > 0 {
+		if p.dialect == "postgres" {
+			cols := make([]string, 0, len(index.Keys))
+			for _, k := range index.Keys {
+				cols = append(cols, k.Column)
+			}
+			index.Name = p.cat.table(tableName).Name + "_" + strings.Join(cols, "_") + "_key"
+		} else {
+			index.Name = index.Keys[0].Column
+		}
+	}
+	for _, k := range index.Keys {
+		if k.Column != "" {
+			p.cat.Uniques = append(p.cat.Uniques, uniqueCol{Table: tableName, Col: k.Column})
+		}
+	}
+	p.addIndex(tableName, index)
+}
+
+// tableCheck holds the name and the clause of a check constraint while its table is being read.
+type tableCheck struct {
+	name   string
+	clause []ddlToken
+}
+
+// addCheck registers a check constraint with the given name and clause in the given table, together with the
+// columns of the table referenced by the clause. Check constraints without a name get the name the database would
+// give them.
+func (p *dumpParser) addCheck(tableName string, name string, clause []ddlToken) {
+	// pg_dump encloses the clauses of the check constraints with an extra pair of parentheses.
+	clause = unwrapParentheses(clause)
+	cols := p.referencedColumns(tableName, clause)
+	if name == "" {
+		if p.dialect == "postgres" {
+			name = p.cat.table(tableName).Name
+			if len(cols) > 0 {
+				name += "_" + cols[0]
+			}
+			name += "_check"
+		} else {
+			name = fmt.Sprintf("%s_chk_%d", tableName, len(p.cat.checks(tableName))+1)
+		}
+	}
+	if p.cat.Checks.exists(name, tableName) {
+		return
+	}
+	check := checkColumn{Table: tableName, Name: name, Clause: renderDDL(clause, p.dialect)}
+	if len(cols) == 0 {
+		p.cat.Checks = append(p.cat.Checks, check)
+	}
+	for _, col := range cols {
+		check.Col = col
+		p.cat.Checks = append(p.cat.Checks, check)
+	}
+}
+
+// referencedColumns returns the columns of the given table that are referenced by the given tokens, in the order
+// of the columns in the table.
+func (p *dumpParser) referencedColumns(tableName string, tokens []ddlToken) []string {
+	cols := make([]string, 0)
+	for _, col := range p.cat.Columns[tableName] {
+		for _, t := range tokens {
+			if (t.kind == ddlWord || t.kind == ddlQuotedIdent) && identifier(t, p.dialect) == col.Name {
+				cols = append(cols, col.Name)
+				break
+			}
+		}
+	}
+	return cols
+}
+
+// addIndex registers the given index of the given table, unless the table already has an index with the same name.
+func (p *dumpParser) addIndex(tableName string, index tableIndex) {
+	if index.Method == "" {
+		index.Method = "btree"
+	}
+	if p.cat.Indexes.exists(index.Name, tableName) {
+		return
+	}
+	for _, k := range index.Keys {
+		p.cat.Indexes = append(p.cat.Indexes, indexColumn{Table: tableName, Name: index.Name, Unique: index.Unique,
+			Method: index.Method, Predicate: index.Predicate, Col: k.Column, Expression: k.Expression})
+	}
+}
+
+func (p *dumpParser) parseAlterTable(c *ddlCursor) error {
+	c.accept("IF", "EXISTS")
+	c.accept("ONLY")
+	name := c.qualifiedName(p.dialect)
+	if !p.hasTable(name) {
+		return nil
+	}
+	tableName := p.tableID(name)
+
+	for _, action := range splitDDLList(c.tokens[c.pos:]) {
+		ac := &ddlCursor{tokens: action}
+		if ac.accept("ADD") && isTableConstraint(ac.peek()) {
+			p.parseTableConstraint(tableName, ac)
+			continue
+		}
+		ac.pos = 0
+		if ac.accept("ALTER") {
+			p.parseAlterColumn(tableName, ac)
+			continue
+		}
+		if ac.accept("ATTACH", "PARTITION") {
+			partition := ac.qualifiedName(p.dialect)
+			p.addPartition(tableName, p.tableID(partition), partitionBound(ac, p.dialect))
+		}
+	}
+	return nil
+}
+
+// parseComment reads the comment of a table or a column right after COMMENT ON, e.g.
+// COMMENT ON COLUMN public.product.name IS 'the name of the product'.
+func (p *dumpParser) parseComment(c *ddlCursor) error {
+	isColumn := false
+	switch {
+	case c.accept("TABLE"), c.accept("VIEW"), c.accept("MATERIALIZED", "VIEW"):
+	case c.accept("COLUMN"):
+		isColumn = true
+	default:
+		return nil
+	}
+
+	name := c.qualifiedName(p.dialect)
+	colName := ""
+	if isColumn {
+		if len(name) < 2 {
+			return nil
+		}
+		colName = name[len(name)-1]
+		name = name[:len(name)-1]
+	}
+	if !p.hasTable(name) || !c.accept("IS") {
+		return nil
+	}
+
+	comment := ""
+	if c.peek().kind == ddlString {
+		comment = c.next().text
+	}
+	p.setComment(p.tableID(name), colName, comment)
+	return nil
+}
+
+// setComment sets the comment of the column with the given colName in the given tableName, or the comment of the
+// table itself when colName is empty. An empty comment removes it.
+func (p *dumpParser) setComment(tableName string, colName string, comment string) {
+	for i := range p.cat.Comments {
+		if p.cat.Comments[i].Table == tableName && p.cat.Comments[i].Col == colName {
+			p.cat.Comments = append(p.cat.Comments[:i], p.cat.Comments[i+1:]...)
+			break
+		}
+	}
+	if comment != "" {
+		p.cat.Comments = append(p.cat.Comments, dbComment{Table: tableName, Col: colName, Comment: comment})
+	}
+}
+
+// parseAlterColumn reads the changes of the default or the identity of a column, e.g. the
+// ALTER COLUMN id SET DEFAULT nextval('order_id_seq'::regclass) written by pg_dump.
+func (p *dumpParser) parseAlterColumn(tableName string, c *ddlCursor) {
+	c.accept("COLUMN")
+	colName := identifier(c.next(), p.dialect)
+	switch {
+	case c.accept("SET", "DEFAULT"):
+		def := renderDDL(c.tokens[c.pos:], p.dialect)
+		p.setDefault(tableName, colName, func(d *colDefault) { d.Default = def })
+	case c.accept("DROP", "DEFAULT"):
+		p.setDefault(tableName, colName, func(d *colDefault) { d.Default = "" })
+	case c.accept("ADD", "GENERATED"):
+		_, identity, options := p.parseGenerated(c)
+		p.setDefault(tableName, colName, func(d *colDefault) { d.Identity = identity })
+		p.addIdentitySequence(tableName, colName, options)
+	case c.accept("DROP", "IDENTITY"):
+		p.setDefault(tableName, colName, func(d *colDefault) { d.Identity = "" })
+	}
+}
+
+// parseCreateSequence registers the sequence created by the statement, e.g.
+// CREATE SEQUENCE public.order_id_seq AS integer START WITH 1 INCREMENT BY 1 NO MAXVALUE CACHE 1.
+// A schema only dump does not tell the current value of a sequence, so it is the value right before its start
+// value unless a setval call of the dump tells otherwise.
+func (p *dumpParser) parseCreateSequence(c *ddlCursor) error {
+	c.accept("IF", "NOT", "EXISTS")
+	name := c.qualifiedName(p.dialect)
+	if len(name) == 0 || !p.inSchema(name) {
+		return nil
+	}
+	seq := dbSequence{Schema: p.schemaOf(name), Name: name[len(name)-1], Kind: sequenceKind, DataType: "bigint",
+		Increment: 1}
+	p.parseSequenceOptions(c, &seq, true)
+	p.addSequence(seq)
+	return nil
+}
+
+// parseAlterSequence updates a sequence created before, e.g. ALTER SEQUENCE public.order_id_seq OWNED BY
+// public."order".id.
+func (p *dumpParser) parseAlterSequence(c *ddlCursor) error {
+	c.accept("IF", "EXISTS")
+	name := c.qualifiedName(p.dialect)
+	if len(name) == 0 {
+		return nil
+	}
+	if seq := p.sequence(p.schemaOf(name), name[len(name)-1]); seq != nil {
+		p.parseSequenceOptions(c, seq, false)
+	}
+	return nil
+}
+
+// parseSetval reads the current value of a sequence given by a setval call, e.g.
+// SELECT pg_catalog.setval('public.order_id_seq', 42, true). When the last argument is false the given value is
+// the next value of the sequence instead of its current value.
+func (p *dumpParser) parseSetval(c *ddlCursor) error {
+	name := c.qualifiedName(p.dialect)
+	if len(name) == 0 || name[len(name)-1] != "setval" {
+		return nil
+	}
+	args := splitDDLList(c.parenthesized())
+	if len(args) < 2 || len(args[0]) != 1 || args[0][0].kind != ddlString {
+		return nil
+	}
+	tokens, err := tokenizeDDL(args[0][0].text, p.dialect)
+	if err != nil {
+		return err
+	}
+	seqName := (&ddlCursor{tokens: tokens}).qualifiedName(p.dialect)
+	if len(seqName) == 0 {
+		return nil
+	}
+	seq := p.sequence(p.schemaOf(seqName), seqName[len(seqName)-1])
+	if seq == nil {
+		return nil
+	}
+	seq.CurrentValue = signedNumber(&ddlCursor{tokens: args[1]})
+	if len(args) > 2 && len(args[2]) == 1 && args[2][0].is("FALSE") {
+		seq.CurrentValue -= seq.Increment
+	}
+	return nil
+}
+
+// parseSequenceOptions reads the options of a sequence into the given sequence. When isNew is true the current
+// value of the sequence is set from its start value. Sequences without a maximum value can give any value of their
+// data type. Any option not needed by godic, e.g. CACHE 1, is skipped.
+func (p *dumpParser) parseSequenceOptions(c *ddlCursor, seq *dbSequence, isNew bool) {
+	var start, maxValue *int64
+	typeMax := isNew
+	for !c.done() {
+		switch {
+		case c.accept("AS"):
+			seq.DataType = strings.ToLower(identifier(c.next(), p.dialect))
+			typeMax = maxValue == nil
+		case c.accept("INCREMENT"):
+			c.accept("BY")
+			seq.Increment = signedNumber(c)
+		case c.accept("START"):
+			c.accept("WITH")
+			n := signedNumber(c)
+			start = &n
+		case c.accept("MAXVALUE"):
+			n := signedNumber(c)
+			maxValue = &n
+		case c.accept("NO", "MAXVALUE"):
+			maxValue = nil
+			typeMax = true
+		case c.accept("SEQUENCE", "NAME"):
+			if name := c.qualifiedName(p.dialect); len(name) > 0 {
+				seq.Schema, seq.Name = p.schemaOf(name), name[len(name)-1]
+			}
+		case c.accept("OWNED", "BY"):
+			if c.accept("NONE") {
+				seq.Table, seq.Col = "", ""
+				continue
+			}
+			name := c.qualifiedName(p.dialect)
+			if len(name) > 1 && p.hasTable(name[:len(name)-1]) {
+				seq.Table, seq.Col = p.tableID(name[:len(name)-1]), name[len(name)-1]
+			}
+		default:
+			c.next()
+		}
+	}
+
+	switch {
+	case maxValue != nil && *maxValue > 0:
+		seq.MaxValue = uint64(*maxValue)
+	case maxValue != nil:
+		seq.MaxValue = 0
+	case typeMax:
+		seq.MaxValue = integerMaxValues[psqlDumpTypes[seq.DataType].goType]
+	}
+	if isNew {
+		if start == nil {
+			first := int64(1)
+			if seq.Increment < 0 {
+				first = -1
+			}
+			start = &first
+		}
+		seq.CurrentValue = *start - seq.Increment
+	}
+}
+
+// psqlSequenceTypes maps the names of the postgres integer types reported by the lib/pq driver to the names of the
+// data types of the sequences.
+var psqlSequenceTypes = map[string]string{
+	"INT2": "smallint",
+	"INT4": "integer",
+	"INT8": "bigint",
+}
+
+// addIdentitySequence registers the sequence of the identity column with the given colName in the given tableName
+// with the given options. The sequence of an identity column has the type of its column and, unless its options
+// tell otherwise, it is named after its table and its column.
+func (p *dumpParser) addIdentitySequence(tableName string, colName string, options []ddlToken) {
+	schema := p.cat.Schemas[tableName]
+	seq := dbSequence{Schema: schema, Name: strings.TrimPrefix(tableName, schema+".") + "_" + colName + "_seq",
+		Kind: sequenceKind, Table: tableName, Col: colName, DataType: "bigint", Increment: 1}
+	if col, err := p.cat.column(colName, tableName); err == nil && psqlSequenceTypes[col.DBType] != "" {
+		seq.DataType = psqlSequenceTypes[col.DBType]
+	}
+	p.parseSequenceOptions(&ddlCursor{tokens: options}, &seq, true)
+	p.addSequence(seq)
+}
+
+// addAutoIncrement registers the auto increment counter of the mysql table with the given tableName, if the table has
+// an auto_increment column, with the given next value of the counter.
+func (p *dumpParser) addAutoIncrement(tableName string, nextValue int64) {
+	for _, def := range p.cat.Defaults {
+		if def.Table != tableName || !strings.Contains(def.Extra, "auto_increment") {
+			continue
+		}
+		col, err := p.cat.column(def.Col, tableName)
+		if err != nil {
+			return
+		}
+		dataType := strings.ToLower(col.DBType)
+		if strings.HasPrefix(col.GoType, "uint") {
+			dataType += " unsigned"
+		}
+		p.addSequence(dbSequence{Name: tableName, Kind: autoIncrementKind, Table: tableName, Col: col.Name,
+			DataType: dataType, Increment: 1, CurrentValue: nextValue - 1})
+		return
+	}
+}
+
+// sequence returns the sequence with the given name in the given schema, or nil if it has not been created.
+func (p *dumpParser) sequence(schema string, name string) *dbSequence {
+	for i := range p.cat.Sequences {
+		if p.cat.Sequences[i].Schema == schema && p.cat.Sequences[i].Name == name {
+			return &p.cat.Sequences[i]
+		}
+	}
+	return nil
+}
+
+// addSequence registers the given sequence, replacing any sequence created before with the same name.
+func (p *dumpParser) addSequence(seq dbSequence) {
+	if existing := p.sequence(seq.Schema, seq.Name); existing != nil {
+		*existing = seq
+		return
+	}
+	p.cat.Sequences = append(p.cat.Sequences, seq)
+}
+
+// signedNumber reads an integer number that may have a minus sign, e.g. -1.
+func signedNumber(c *ddlCursor) int64 {
+	sign := int64(1)
+	if c.peek().isSymbol("-") {
+		c.next()
+		sign = -1
+	}
+	n, _ := strconv.ParseInt(c.next().text, 10, 64)
+	return sign * n
+}
+
+// dumpType holds the data type of a column read from a dump.
+type dumpType struct {
+	name       string
+	dbType     string
+	length     int64
+	precision  int64
+	scale      int64
+	unsigned   bool
+	enumValues []string
+}
+
+// parseDumpType reads the data type of a column from the given type tokens, e.g. character varying(200).
+func parseDumpType(tokens []ddlToken, dialect string) dumpType {
+	t := dumpType{}
+	words := make([]string, 0)
+	var args []ddlToken
+	for i := 0; i < len(tokens); i++ {
+		tk := tokens[i]
+		switch {
+		case tk.isSymbol("("):
+			depth := 1
+			j := i + 1
+			for ; j < len(tokens) && depth > 0; j++ {
+				if tokens[j].isSymbol("(") {
+					depth++
+				} else if tokens[j].isSymbol(")") {
+					depth--
+				}
+			}
+			if args == nil {
+				args = tokens[i+1 : j-1]
+			}
+			i = j - 1
+		case tk.isSymbol("[") || tk.isSymbol("]"):
+			words = append(words, tk.text)
+		case tk.isSymbol("."):
+			// Schema qualified types, e.g. public.counting_option.
+			if len(words) > 0 {
+				words = words[:len(words)-1]
+			}
+		case tk.is("UNSIGNED"):
+			t.unsigned = true
+		case tk.is("ZEROFILL"):
+		default:
+			words = append(words, identifier(tk, dialect))
+		}
+	}
+
+	t.name = strings.ToLower(strings.Join(words, " "))
+	t.name = strings.Replace(t.name, " [ ]", "[]", -1)
+	t.name = strings.Replace(t.name, " [", "[", -1)
+
+	if len(args) > 0 && args[0].kind == ddlNumber {
+		t.length, _ = strconv.ParseInt(args[0].text, 10, 64)
+	}
+
+	if dialect == "mysql" && (t.name == "enum" || t.name == "set") {
+		t.enumValues = make([]string, 0)
+		for _, item := range splitDDLList(args) {
+			if len(item) == 1 && item[0].kind == ddlString {
+				t.enumValues = append(t.enumValues, item[0].text)
+			}
+		}
+		if t.name == "set" {
+			t.enumValues = nil
+		}
+	}
+
+	if dialect == "postgres" {
+		t.dbType = psqlDumpTypes[t.name].dbType
+	} else {
+		t.dbType = mysqlDumpTypes[t.name].dbType
+	}
+	if t.dbType == "" {
+		// User defined types keep their name, so we can match them with the enum types of the dump.
+		t.dbType = t.name
+		if dialect == "mysql" || strings.HasSuffix(t.name, "[]") {
+			t.dbType = strings.ToUpper(t.name)
+		}
+	}
+
+	if t.dbType == "DECIMAL" || t.dbType == "NUMERIC" {
+		t.precision = t.length
+		// mysql decimal columns have a precision of 10 unless they declare another one.
+		if t.precision == 0 && dialect == "mysql" {
+			t.precision = 10
+		}
+		if items := splitDDLList(args); len(items) > 1 && len(items[1]) == 1 && items[1][0].kind == ddlNumber {
+			t.scale, _ = strconv.ParseInt(items[1][0].text, 10, 64)
+		}
+	}
+
+	// The length is only meaningful for character types.
+	if !strings.Contains(t.dbType, "CHAR") && t.dbType != "BINARY" && t.dbType != "VARBINARY" {
+		t.length = 0
+	}
+
+	return t
+}
+
+// collatable checks whether the values of a column of the dumpType are text with a collation.
+func (t dumpType) collatable() bool {
+	switch t.dbType {
+	case "CHAR", "VARCHAR", "TEXT", "BPCHAR":
+		return true
+	}
+	return false
+}
+
+// goType returns the go type a driver would use to scan a column of the dumpType.
+func (t dumpType) goType(dialect string, nullable bool) string {
+	if dialect == "postgres" {
+		if gt := psqlDumpTypes[t.name].goType; gt != "" {
+			return gt
+		}
+		return "interface {}"
+	}
+
+	mt, ok := mysqlDumpTypes[t.name]
+	if !ok {
+		return "sql.RawBytes"
+	}
+	if nullable && mt.nullGoType != "" {
+		return mt.nullGoType
+	}
+	if t.unsigned && strings.HasPrefix(mt.goType, "int") {
+		return "u" + mt.goType
+	}
+	return mt.goType
+}
+
+// dumpTypeInfo describes how a data type of a dump is reported by the database driver.
+type dumpTypeInfo struct {
+	dbType     string
+	goType     string
+	nullGoType string
+}
+
+// psqlDumpTypes maps the postgres data types to the names and go types reported by the lib/pq driver.
+var psqlDumpTypes = map[string]dumpTypeInfo{
+	"smallint":                    {dbType: "INT2", goType: "int16"},
+	"int2":                        {dbType: "INT2", goType: "int16"},
+	"smallserial":                 {dbType: "INT2", goType: "int16"},
+	"integer":                     {dbType: "INT4", goType: "int32"},
+	"int":                         {dbType: "INT4", goType: "int32"},
+	"int4":                        {dbType: "INT4", goType: "int32"},
+	"serial":                      {dbType: "INT4", goType: "int32"},
+	"bigint":                      {dbType: "INT8", goType: "int64"},
+	"int8":                        {dbType: "INT8", goType: "int64"},
+	"bigserial":                   {dbType: "INT8", goType: "int64"},
+	"character varying":           {dbType: "VARCHAR", goType: "string"},
+	"varchar":                     {dbType: "VARCHAR", goType: "string"},
+	"character":                   {dbType: "BPCHAR", goType: "interface {}"},
+	"char":                        {dbType: "BPCHAR", goType: "interface {}"},
+	"text":                        {dbType: "TEXT", goType: "string"},
+	"boolean":                     {dbType: "BOOL", goType: "bool"},
+	"bool":                        {dbType: "BOOL", goType: "bool"},
+	"numeric":                     {dbType: "NUMERIC", goType: "interface {}"},
+	"decimal":                     {dbType: "NUMERIC", goType: "interface {}"},
+	"real":                        {dbType: "FLOAT4", goType: "interface {}"},
+	"double precision":            {dbType: "FLOAT8", goType: "interface {}"},
+	"money":                       {dbType: "MONEY", goType: "interface {}"},
+	"date":                        {dbType: "DATE", goType: "time.Time"},
+	"time":                        {dbType: "TIME", goType: "time.Time"},
+	"time without time zone":      {dbType: "TIME", goType: "time.Time"},
+	"time with time zone":         {dbType: "TIMETZ", goType: "time.Time"},
+	"timestamp":                   {dbType: "TIMESTAMP", goType: "time.Time"},
+	"timestamp without time zone": {dbType: "TIMESTAMP", goType: "time.Time"},
+	"timestamp with time zone":    {dbType: "TIMESTAMPTZ", goType: "time.Time"},
+	"timestamptz":                 {dbType: "TIMESTAMPTZ", goType: "time.Time"},
+	"interval":                    {dbType: "INTERVAL", goType: "interface {}"},
+	"bytea":                       {dbType: "BYTEA", goType: "[]uint8"},
+	"uuid":                        {dbType: "UUID", goType: "interface {}"},
+	"json":                        {dbType: "JSON", goType: "interface {}"},
+	"jsonb":                       {dbType: "JSONB", goType: "interface {}"},
+	"inet":                        {dbType: "INET", goType: "interface {}"},
+	"cidr":                        {dbType: "CIDR", goType: "interface {}"},
+	"xml":                         {dbType: "XML", goType: "interface {}"},
+}
+
+// mysqlDumpTypes maps the mysql data types to the names and go types reported by the go-sql-driver/mysql driver.
+var mysqlDumpTypes = map[string]dumpTypeInfo{
+	"tinyint":    {dbType: "TINYINT", goType: "int8", nullGoType: "sql.NullInt64"},
+	"bool":       {dbType: "TINYINT", goType: "int8", nullGoType: "sql.NullInt64"},
+	"boolean":    {dbType: "TINYINT", goType: "int8", nullGoType: "sql.NullInt64"},
+	"smallint":   {dbType: "SMALLINT", goType: "int16", nullGoType: "sql.NullInt64"},
+	"year":       {dbType: "YEAR", goType: "int16", nullGoType: "sql.NullInt64"},
+	"mediumint":  {dbType: "MEDIUMINT", goType: "int32", nullGoType: "sql.NullInt64"},
+	"int":        {dbType: "INT", goType: "int32", nullGoType: "sql.NullInt64"},
+	"integer":    {dbType: "INT", goType: "int32", nullGoType: "sql.NullInt64"},
+	"bigint":     {dbType: "BIGINT", goType: "int64", nullGoType: "sql.NullInt64"},
+	"float":      {dbType: "FLOAT", goType: "float32", nullGoType: "sql.NullFloat64"},
+	"double":     {dbType: "DOUBLE", goType: "float64", nullGoType: "sql.NullFloat64"},
+	"real":       {dbType: "DOUBLE", goType: "float64", nullGoType: "sql.NullFloat64"},
+	"decimal":    {dbType: "DECIMAL", goType: "sql.RawBytes"},
+	"numeric":    {dbType: "DECIMAL", goType: "sql.RawBytes"},
+	"char":       {dbType: "CHAR", goType: "sql.RawBytes"},
+	"varchar":    {dbType: "VARCHAR", goType: "sql.RawBytes"},
+	"binary":     {dbType: "BINARY", goType: "sql.RawBytes"},
+	"varbinary":  {dbType: "VARBINARY", goType: "sql.RawBytes"},
+	"tinytext":   {dbType: "TEXT", goType: "sql.RawBytes"},
+	"text":       {dbType: "TEXT", goType: "sql.RawBytes"},
+	"mediumtext": {dbType: "TEXT", goType: "sql.RawBytes"},
+	"longtext":   {dbType: "TEXT", goType: "sql.RawBytes"},
+	"tinyblob":   {dbType: "BLOB", goType: "sql.RawBytes"},
+	"blob":       {dbType: "BLOB", goType: "sql.RawBytes"},
+	"mediumblob": {dbType: "BLOB", goType: "sql.RawBytes"},
+	"longblob":   {dbType: "BLOB", goType: "sql.RawBytes"},
+	"enum":       {dbType: "CHAR", goType: "sql.RawBytes"},
+	"set":        {dbType: "CHAR", goType: "sql.RawBytes"},
+	"json":       {dbType: "JSON", goType: "sql.RawBytes"},
+	"bit":        {dbType: "BIT", goType: "sql.RawBytes"},
+	"time":       {dbType: "TIME", goType: "sql.RawBytes"},
+	"date":       {dbType: "DATE", goType: "mysql.NullTime"},
+	"datetime":   {dbType: "DATETIME", goType: "mysql.NullTime"},
+	"timestamp":  {dbType: "TIMESTAMP", goType: "mysql.NullTime"},
+}
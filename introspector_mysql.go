@@ -0,0 +1,698 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	_ "github.com/go-sql-driver/mysql"
+	"strconv"
+	"strings"
+)
+
+const mysqlDbSource string = "{user}:{password}@tcp({host}:{port})/{database}"
+
+func init() {
+	registerIntrospector("mysql", introspectorDriver{
+		sqlDriver:       "mysql",
+		dataSource:      formatMysqlSource,
+		newIntrospector: newMysqlIntrospector,
+	})
+}
+
+// mysqlVars represents the information needed to make a connection with a mysql database.
+var mysqlVars = map[string]string{
+	"user":     "",
+	"password": "",
+	"host":     "",
+	"port":     "",
+	"database": "",
+}
+
+// formatMysqlSource formats the mysqlVars map into a valid dataSourceName url using the given *Config
+// so we can connect to a mysql database.
+func formatMysqlSource(conf *Config) string {
+	mysqlVars["user"] = conf.DatabaseUser
+	mysqlVars["password"] = conf.DatabasePassword
+	mysqlVars["host"] = conf.DatabaseHost
+	mysqlVars["port"] = strconv.Itoa(conf.DatabasePort)
+	mysqlVars["database"] = conf.DatabaseName
+	format := mysqlDbSource
+	for k, v := range mysqlVars {
+		format = strings.Replace(format, "{"+k+"}", v, -1)
+	}
+	return format
+}
+
+// mysqlIntrospector is the Introspector of mysql and mariadb databases.
+type mysqlIntrospector struct {
+	db     *sql.DB
+	schema string
+	server mysqlServer
+	// backslashEscapes tells whether backslashes escape characters in string literals, which is not the case when
+	// the sql_mode of the server has NO_BACKSLASH_ESCAPES.
+	backslashEscapes bool
+}
+
+// newMysqlIntrospector returns the Introspector of the mysql database behind the given connection. The version of
+// the server is read once here, as it decides which introspection queries can be used, together with its sql_mode,
+// which decides how the literals of the written statements are escaped.
+func newMysqlIntrospector(db *sql.DB, conf *Config) (Introspector, error) {
+	var version, sqlMode string
+	if err := db.QueryRow("SELECT VERSION(), @@SESSION.sql_mode;").Scan(&version, &sqlMode); err != nil {
+		return nil, err
+	}
+	return &mysqlIntrospector{db: db, schema: conf.DatabaseSchema, server: parseMysqlVersion(version),
+		backslashEscapes: !strings.Contains(strings.ToUpper(sqlMode), "NO_BACKSLASH_ESCAPES")}, nil
+}
+
+func (in *mysqlIntrospector) Catalog() (*catalog, error) {
+	enums, indexes, checks := mysqlQueryEnumTypesAndCols, mysqlQueryGetIndexes, ""
+	if !in.server.hasRegexpReplace() {
+		enums = mysqlQueryEnumColumnTypes
+	}
+	if !in.server.hasIndexExpressions() {
+		indexes = mysqlQueryGetIndexesWithoutExpressions
+	}
+	switch {
+	case in.server.mariadb && in.server.hasCheckConstraints():
+		checks = fmt.Sprintf(mariadbQueryGetChecks, in.schema)
+	case in.server.hasCheckConstraints():
+		checks = fmt.Sprintf(mysqlQueryGetChecks, in.schema)
+	}
+
+	cat, err := readSqlCatalog(in.db, sqlQueries{
+		TableNames:  fmt.Sprintf(mysqlQueryGetTableNames, in.schema),
+		Views:       fmt.Sprintf(mysqlQueryGetViews, in.schema),
+		Columns:     mysqlQueryGetColumns,
+		PrimaryKeys: fmt.Sprintf(mysqlQueryGetPks, in.schema),
+		ForeignKeys: fmt.Sprintf(mysqlQueryGetFKs, in.schema),
+		Enums:       fmt.Sprintf(enums, in.schema),
+		Uniques:     fmt.Sprintf(mysqlQueryGetUniquesColumns, in.schema),
+		Indexes:     fmt.Sprintf(indexes, in.schema),
+		Checks:      checks,
+		Defaults:    fmt.Sprintf(mysqlQueryGetColumnsDefaults, in.schema),
+		Comments:    fmt.Sprintf(mysqlQueryGetComments, in.schema),
+		Collations:  fmt.Sprintf(mysqlQueryGetCollations, in.schema, in.schema),
+		Partitions:  fmt.Sprintf(mysqlQueryGetPartitions, in.schema),
+		Routines:    fmt.Sprintf(mysqlQueryGetRoutines, in.schema),
+		Triggers:    fmt.Sprintf(mysqlQueryGetTriggers, in.schema),
+		Sequences:   fmt.Sprintf(mysqlQueryGetAutoIncrements, in.schema),
+		Stats:       fmt.Sprintf(mysqlQueryGetTablesStats, in.schema),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Without REGEXP_REPLACE the enum query returns the whole column type, e.g. enum('unit','decimal').
+	if !in.server.hasRegexpReplace() {
+		for i := range cat.Enums {
+			cat.Enums[i].EnumValues = strings.Join(mysqlEnumValues(cat.Enums[i].EnumValues), ",")
+		}
+	}
+
+	// mariadb reports the columns without a default value but NULL with the default NULL, while a NULL literal
+	// is reported quoted.
+	if in.server.mariadb {
+		defaults := make(ColumnsDefaults, 0, len(cat.Defaults))
+		for _, d := range cat.Defaults {
+			if d.Default == "NULL" {
+				d.Default = ""
+			}
+			if d.Default != "" || d.Generation != "" || d.Extra != "" {
+				defaults = append(defaults, d)
+			}
+		}
+		cat.Defaults = defaults
+	}
+
+	return cat, nil
+}
+
+// mysqlServer describes the version of the mysql or mariadb server behind a connection.
+type mysqlServer struct {
+	mariadb bool
+	major   int
+	minor   int
+	patch   int
+}
+
+// parseMysqlVersion parses the given result of VERSION(), e.g. 8.0.36, 5.7.44-log or 10.6.16-MariaDB-1:10.6.16.
+// Old mariadb servers may prefix their version with 5.5.5- for the sake of old mysql clients.
+func parseMysqlVersion(version string) mysqlServer {
+	server := mysqlServer{mariadb: strings.Contains(strings.ToLower(version), "mariadb")}
+	if server.mariadb {
+		version = strings.TrimPrefix(version, "5.5.5-")
+	}
+	if i := strings.IndexAny(version, "-+~ "); i >= 0 {
+		version = version[:i]
+	}
+	numbers := []*int{&server.major, &server.minor, &server.patch}
+	for i, part := range strings.SplitN(version, ".", len(numbers)) {
+		*numbers[i], _ = strconv.Atoi(part)
+	}
+	return server
+}
+
+// atLeast checks whether the server has the given version or a later one.
+func (s mysqlServer) atLeast(major, minor, patch int) bool {
+	if s.major != major {
+		return s.major > major
+	}
+	if s.minor != minor {
+		return s.minor > minor
+	}
+	return s.patch >= patch
+}
+
+// hasRegexpReplace checks whether the server has the REGEXP_REPLACE function, added in mysql 8.0.4.
+func (s mysqlServer) hasRegexpReplace() bool {
+	if s.mariadb {
+		return s.atLeast(10, 0, 5)
+	}
+	return s.atLeast(8, 0, 4)
+}
+
+// hasIndexExpressions checks whether information_schema.statistics has the expressions of functional indexes,
+// which mysql added in 8.0.13 and mariadb does not have.
+func (s mysqlServer) hasIndexExpressions() bool {
+	return !s.mariadb && s.atLeast(8, 0, 13)
+}
+
+// hasCheckConstraints checks whether the server enforces check constraints and lists them in
+// information_schema.check_constraints. mariadb added the table name to the list in 10.2.22 and 10.3.10.
+func (s mysqlServer) hasCheckConstraints() bool {
+	if s.mariadb {
+		return s.atLeast(10, 3, 10) || (s.major == 10 && s.minor == 2 && s.patch >= 22)
+	}
+	return s.atLeast(8, 0, 16)
+}
+
+// mysqlEnumValues returns the values of the given enum column type, e.g. enum('unit','decimal'). The quotes
+// within a value are doubled in the column type.
+func mysqlEnumValues(columnType string) []string {
+	values := make([]string, 0)
+	start := strings.Index(columnType, "(")
+	end := strings.LastIndex(columnType, ")")
+	if start < 0 || end < start {
+		return values
+	}
+	var value strings.Builder
+	quoted := false
+	list := columnType[start+1 : end]
+	for i := 0; i < len(list); i++ {
+		switch c := list[i]; {
+		case c == '\'' && quoted && i+1 < len(list) && list[i+1] == '\'':
+			value.WriteByte(c)
+			i++
+		case c == '\'':
+			if quoted {
+				values = append(values, value.String())
+				value.Reset()
+			}
+			quoted = !quoted
+		case quoted:
+			value.WriteByte(c)
+		}
+	}
+	return values
+}
+
+// CommentStatements returns ALTER TABLE statements that write the given descriptions as comments. Mysql can
+// only comment a column while redefining it, so the statement of a column repeats its current definition.
+// Views cannot have comments in mysql so their descriptions are skipped.
+func (in *mysqlIntrospector) CommentStatements(cat *catalog, comments []descriptionComment) ([]string, error) {
+	statements := make([]string, 0, len(comments))
+	for _, c := range comments {
+		if cat.kind(c.Table) != tableKind {
+			continue
+		}
+		if c.Column == "" {
+			statements = append(statements, fmt.Sprintf("ALTER TABLE %s COMMENT = %s",
+				mysqlQuoteIdentifier(c.Table), mysqlQuoteLiteral(c.Description, in.backslashEscapes)))
+			continue
+		}
+		definition, err := in.columnDefinition(c.Table, c.Column)
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, fmt.Sprintf("ALTER TABLE %s MODIFY COLUMN %s %s COMMENT %s",
+			mysqlQuoteIdentifier(c.Table), mysqlQuoteIdentifier(c.Column), definition,
+			mysqlQuoteLiteral(c.Description, in.backslashEscapes)))
+	}
+	return statements, nil
+}
+
+// ExecStatements refuses to run the statements that comment a column. They redefine the column from its
+// introspected definition, which can miss some of its attributes, e.g. its visibility or its SRID, and they can
+// rebuild the whole table under a lock, so they are only written with the script mode to be applied by hand.
+func (in *mysqlIntrospector) ExecStatements(statements []string) error {
+	for _, s := range statements {
+		if mysqlModifiesColumn(s) {
+			return fmt.Errorf("mysql column comments redefine their columns, so they can only be written with " +
+				"the script mode and applied by hand")
+		}
+	}
+	return execStatements(in.db, statements)
+}
+
+// mysqlModifiesColumn checks whether the given statement, written by CommentStatements, redefines a column.
+func mysqlModifiesColumn(statement string) bool {
+	rest := strings.TrimPrefix(statement, "ALTER TABLE `")
+	// The quoted table name ends at the first backtick that is not doubled, see mysqlQuoteIdentifier.
+	for i := 0; i < len(rest); i++ {
+		if rest[i] != '`' {
+			continue
+		}
+		if i+1 < len(rest) && rest[i+1] == '`' {
+			i++
+			continue
+		}
+		return strings.HasPrefix(rest[i+1:], " MODIFY COLUMN ")
+	}
+	return false
+}
+
+// mysqlProfileDialect writes the sampled aggregate queries of the profiling job for mysql.
+var mysqlProfileDialect = sqlProfileDialect{text: "CAST(%s AS CHAR)", length: "CHAR_LENGTH(%s)"}
+
+// ProfileColumn reads the first sampleRows rows of the table, as mysql cannot sample the pages of a table.
+func (in *mysqlIntrospector) ProfileColumn(ctx context.Context, cat *catalog, tableID string, col column,
+	sampleRows int64) (columnProfile, error) {
+	return profileSqlColumn(ctx, in.db, mysqlProfileDialect, mysqlQuoteIdentifier(tableID), col,
+		mysqlQuoteIdentifier(col.Name), sampleRows)
+}
+
+// columnDefinition returns the current definition of the given column without its name and its comment.
+func (in *mysqlIntrospector) columnDefinition(tableName string, colName string) (string, error) {
+	var colType, nullable, extra, generation string
+	var def, charset, collation sql.NullString
+	err := in.db.QueryRow(mysqlQueryGetColumnDefinition, in.schema, tableName, colName).Scan(&colType, &nullable,
+		&def, &extra, &generation, &charset, &collation)
+	if err != nil {
+		return "", err
+	}
+	if in.server.mariadb && def.String == "NULL" {
+		def.Valid = false
+	}
+	return mysqlColumnDefinition(colType, nullable == "YES", def, extra, generation, charset, collation,
+		in.backslashEscapes), nil
+}
+
+// mysqlColumnDefinition builds a column definition from the values reported by information_schema.columns.
+// backslashEscapes tells how the literal default of the column is escaped, see mysqlQuoteLiteral.
+func mysqlColumnDefinition(colType string, nullable bool, def sql.NullString, extra string, generation string,
+	charset sql.NullString, collation sql.NullString, backslashEscapes bool) string {
+	parts := []string{colType}
+
+	// mysql 8 marks expression defaults with DEFAULT_GENERATED and generated columns with
+	// VIRTUAL GENERATED or STORED GENERATED, none of which can be written back as they are.
+	extras := make([]string, 0)
+	storage := "VIRTUAL"
+	for _, e := range strings.Fields(extra) {
+		switch strings.ToUpper(e) {
+		case "DEFAULT_GENERATED", "GENERATED", "VIRTUAL":
+		case "STORED":
+			storage = "STORED"
+		default:
+			extras = append(extras, e)
+		}
+	}
+	expressionDefault := strings.Contains(strings.ToUpper(extra), "DEFAULT_GENERATED")
+
+	if generation != "" {
+		parts = append(parts, fmt.Sprintf("GENERATED ALWAYS AS (%s) %s", generation, storage))
+	} else if charset.Valid && collation.Valid {
+		parts = append(parts, "CHARACTER SET "+charset.String, "COLLATE "+collation.String)
+	}
+
+	if nullable {
+		parts = append(parts, "NULL")
+	} else {
+		parts = append(parts, "NOT NULL")
+	}
+
+	if def.Valid && generation == "" {
+		parts = append(parts, "DEFAULT "+mysqlDefaultValue(def.String, expressionDefault, backslashEscapes))
+	}
+
+	return strings.Join(append(parts, extras...), " ")
+}
+
+// mysqlDefaultValue returns the given column default as it has to be written in a column definition.
+// information_schema.columns reports literals without quotes in mysql but with quotes in mariadb.
+func mysqlDefaultValue(def string, expression bool, backslashEscapes bool) string {
+	upper := strings.ToUpper(def)
+	switch {
+	case strings.HasPrefix(upper, "CURRENT_TIMESTAMP"), strings.HasPrefix(def, "'"), strings.HasPrefix(def, "b'"):
+		return def
+	case expression:
+		return "(" + def + ")"
+	default:
+		return mysqlQuoteLiteral(def, backslashEscapes)
+	}
+}
+
+// mysqlQuoteIdentifier quotes the given identifier so it can be used in a mysql statement.
+func mysqlQuoteIdentifier(name string) string {
+	return "`" + strings.Replace(name, "`", "``", -1) + "`"
+}
+
+// mysqlQuoteLiteral quotes the given string so it can be used as a literal in a mysql statement. The backslashes
+// are only escaped when the server reads them as escapes, see mysqlIntrospector.backslashEscapes.
+func mysqlQuoteLiteral(value string, backslashEscapes bool) string {
+	if backslashEscapes {
+		value = strings.Replace(value, `\`, `\\`, -1)
+	}
+	return "'" + strings.Replace(value, "'", "''", -1) + "'"
+}
+
+var mysqlQueryGetTableNames = `
+	SELECT ''         as table_schema,
+		   TABLE_NAME as table_name
+	FROM   information_schema.tables 
+	WHERE  TABLE_TYPE = 'BASE TABLE'
+		   AND TABLE_SCHEMA = '%s';
+`
+
+var mysqlQueryGetViews = `
+	SELECT ''              as view_schema,
+		   TABLE_NAME      as view_name,
+		   'view'          as view_kind,
+		   VIEW_DEFINITION as view_definition
+	FROM   information_schema.views
+	WHERE  TABLE_SCHEMA = '%s';
+`
+
+// The primary keys are read from the constraints rather than from the index named PRIMARY, whose name is not
+// compared the same way by every mysql and mariadb version.
+var mysqlQueryGetPks = `
+	SELECT kcu.column_name, 
+		   kcu.table_name, 
+		   tc.constraint_name 
+	FROM   information_schema.table_constraints AS tc 
+		   INNER JOIN information_schema.key_column_usage AS kcu 
+				   ON kcu.constraint_schema = tc.constraint_schema 
+					  AND kcu.constraint_name = tc.constraint_name 
+					  AND kcu.table_name = tc.table_name 
+	WHERE  tc.table_schema = '%s' 
+		   AND tc.constraint_type = 'PRIMARY KEY' 
+	ORDER  BY kcu.table_name, 
+			  kcu.ordinal_position;
+`
+
+var mysqlQueryGetFKs = `
+	SELECT rf.table_name              AS origin_table_name, 
+		   rf.referenced_table_name   AS target_table_name, 
+		   kcu.column_name, 
+		   rf.delete_rule, 
+		   rf.update_rule, 
+		   kcu.referenced_column_name AS target_column_name, 
+		   rf.constraint_name 
+	FROM   information_schema.referential_constraints AS rf 
+		   JOIN information_schema.key_column_usage AS kcu 
+			 ON kcu.constraint_schema = rf.constraint_schema 
+				AND kcu.constraint_name = rf.constraint_name 
+				AND kcu.table_name = rf.table_name 
+	WHERE  rf.constraint_schema = '%s' 
+	ORDER  BY rf.table_name, 
+			  rf.constraint_name, 
+			  kcu.ordinal_position; 
+`
+
+var mysqlQueryEnumTypesAndCols = `
+	SELECT col.table_name  AS table_name, 
+		   col.column_name AS column_name, 
+		   col.data_type   AS enum_type, 
+		   Regexp_replace(REPLACE(col.column_type, 'enum', ''), '[\)\(\']', '') 
+	FROM   information_schema.columns AS col 
+	WHERE  col.data_type = 'enum' 
+		   AND col.table_schema = '%s'; 
+`
+
+// mysqlQueryEnumColumnTypes replaces mysqlQueryEnumTypesAndCols on the servers without REGEXP_REPLACE. The enum
+// values are parsed from the column type by mysqlEnumValues.
+var mysqlQueryEnumColumnTypes = `
+	SELECT col.table_name  AS table_name, 
+		   col.column_name AS column_name, 
+		   col.data_type   AS enum_type, 
+		   col.column_type AS column_type 
+	FROM   information_schema.columns AS col 
+	WHERE  col.data_type = 'enum' 
+		   AND col.table_schema = '%s'; 
+`
+
+var mysqlQueryGetUniquesColumns = `
+	SELECT DISTINCT kcu.table_name  AS table_name, 
+					kcu.column_name AS column_name
+	FROM   information_schema.key_column_usage AS kcu 
+	WHERE  kcu.table_schema = '%[1]s' 
+		   AND kcu.constraint_name IN (SELECT tc.constraint_name 
+									   FROM   information_schema.table_constraints 
+											  AS tc 
+									   WHERE  tc.constraint_type = 'UNIQUE' 
+											  AND tc.table_schema = '%[1]s');
+`
+
+// mysql does not have partial indexes. The keys of functional indexes have an expression instead of a column.
+var mysqlQueryGetIndexes = `
+	SELECT st.table_name                  AS table_name, 
+		   st.index_name                  AS index_name, 
+		   st.non_unique = 0              AS is_unique, 
+		   LOWER(st.index_type)           AS index_method, 
+		   ''                             AS index_predicate, 
+		   COALESCE(st.column_name, '')   AS column_name, 
+		   COALESCE(st.expression, '')    AS expression 
+	FROM   information_schema.statistics AS st 
+	WHERE  st.table_schema = '%s' 
+	ORDER  BY st.table_name, 
+			  CAST(st.index_name AS BINARY), 
+			  st.seq_in_index; 
+`
+
+// mysqlQueryGetIndexesWithoutExpressions replaces mysqlQueryGetIndexes on the servers that do not have functional
+// indexes, whose information_schema.statistics does not have the expression column.
+var mysqlQueryGetIndexesWithoutExpressions = `
+	SELECT st.table_name                  AS table_name, 
+		   st.index_name                  AS index_name, 
+		   st.non_unique = 0              AS is_unique, 
+		   LOWER(st.index_type)           AS index_method, 
+		   ''                             AS index_predicate, 
+		   COALESCE(st.column_name, '')   AS column_name, 
+		   ''                             AS expression 
+	FROM   information_schema.statistics AS st 
+	WHERE  st.table_schema = '%s' 
+	ORDER  BY st.table_name, 
+			  CAST(st.index_name AS BINARY), 
+			  st.seq_in_index; 
+`
+
+// mysql does not tell which columns are referenced by a check constraint, but the columns are always quoted in
+// the clause of the constraint, so we look for them there.
+var mysqlQueryGetChecks = `
+	SELECT tc.table_name                  AS table_name, 
+		   cc.constraint_name             AS constraint_name, 
+		   cc.check_clause                AS check_clause, 
+		   COALESCE(col.column_name, '')  AS column_name 
+	FROM   information_schema.table_constraints AS tc 
+		   JOIN information_schema.check_constraints AS cc 
+			 ON cc.constraint_schema = tc.constraint_schema 
+				AND cc.constraint_name = tc.constraint_name 
+		   LEFT JOIN information_schema.columns AS col 
+				  ON col.table_schema = tc.table_schema 
+					 AND col.table_name = tc.table_name 
+					 AND LOCATE(CONCAT('` + "`" + `', col.column_name, '` + "`" + `'), cc.check_clause) > 0 
+	WHERE  tc.table_schema = '%[1]s' 
+		   AND tc.constraint_type = 'CHECK' 
+	ORDER  BY tc.table_name, 
+			  cc.constraint_name, 
+			  col.ordinal_position; 
+`
+
+// mariadb lists the table of every check constraint in information_schema.check_constraints, where the checks
+// written next to a column are named after their column.
+var mariadbQueryGetChecks = `
+	SELECT cc.table_name                  AS table_name, 
+		   cc.constraint_name             AS constraint_name, 
+		   cc.check_clause                AS check_clause, 
+		   COALESCE(col.column_name, '')  AS column_name 
+	FROM   information_schema.check_constraints AS cc 
+		   LEFT JOIN information_schema.columns AS col 
+				  ON col.table_schema = cc.constraint_schema 
+					 AND col.table_name = cc.table_name 
+					 AND LOCATE(CONCAT('` + "`" + `', col.column_name, '` + "`" + `'), cc.check_clause) > 0 
+	WHERE  cc.constraint_schema = '%s' 
+	ORDER  BY cc.table_name, 
+			  cc.constraint_name, 
+			  col.ordinal_position; 
+`
+
+// mysql does not have identity columns, auto_increment columns are reported in the extra information.
+var mysqlQueryGetColumnsDefaults = `
+	SELECT col.table_name                          AS table_name, 
+		   col.column_name                         AS column_name, 
+		   COALESCE(col.column_default, '')        AS column_default, 
+		   ''                                      AS identity_generation, 
+		   COALESCE(col.generation_expression, '') AS generation_expression, 
+		   col.extra                               AS extra 
+	FROM   information_schema.columns AS col 
+	WHERE  col.table_schema = '%s' 
+		   AND ( col.column_default IS NOT NULL 
+				  OR col.extra <> '' ); 
+`
+
+// mysql gives the views the comment VIEW, so we only read the comments of the base tables.
+var mysqlQueryGetComments = `
+	SELECT tab.table_name    AS table_name, 
+		   ''                AS column_name, 
+		   tab.table_comment AS comment 
+	FROM   information_schema.tables AS tab 
+	WHERE  tab.table_schema = '%[1]s' 
+		   AND tab.table_type = 'BASE TABLE' 
+		   AND tab.table_comment <> '' 
+	UNION ALL 
+	SELECT col.table_name     AS table_name, 
+		   col.column_name    AS column_name, 
+		   col.column_comment AS comment 
+	FROM   information_schema.columns AS col 
+	WHERE  col.table_schema = '%[1]s' 
+		   AND col.column_comment <> ''; 
+`
+
+// mysql routines cannot be overloaded, so they do not need a signature. The parameters of a function do not have a
+// mode, and the parameter with the position 0 is the return value of the function.
+var mysqlQueryGetRoutines = `
+	SELECT ''                                         AS routine_schema, 
+		   r.routine_name                             AS routine_name, 
+		   LOWER(r.routine_type)                      AS routine_kind, 
+		   ''                                         AS routine_signature, 
+		   COALESCE((SELECT GROUP_CONCAT(CONCAT_WS(' ', p.parameter_mode, p.parameter_name, p.dtd_identifier) 
+										 ORDER BY p.ordinal_position SEPARATOR ', ') 
+					 FROM   information_schema.parameters AS p 
+					 WHERE  p.specific_schema = r.routine_schema 
+							AND p.specific_name = r.specific_name 
+							AND p.ordinal_position > 0), '') AS routine_arguments, 
+		   CASE r.routine_type 
+			 WHEN 'FUNCTION' THEN r.dtd_identifier 
+			 ELSE '' 
+		   END                                        AS return_type, 
+		   LOWER(r.routine_body)                      AS routine_language, 
+		   ''                                         AS table_name, 
+		   ''                                         AS routine_event, 
+		   COALESCE(r.routine_definition, '')         AS routine_body 
+	FROM   information_schema.routines AS r 
+	WHERE  r.routine_schema = '%s' 
+	ORDER  BY r.routine_name; 
+`
+
+var mysqlQueryGetTriggers = `
+	SELECT ''                                         AS routine_schema, 
+		   t.trigger_name                             AS routine_name, 
+		   'trigger'                                  AS routine_kind, 
+		   ''                                         AS routine_signature, 
+		   ''                                         AS routine_arguments, 
+		   ''                                         AS return_type, 
+		   'sql'                                      AS routine_language, 
+		   t.event_object_table                       AS table_name, 
+		   CONCAT(t.action_timing, ' ', t.event_manipulation, ' FOR EACH ', t.action_orientation) AS routine_event, 
+		   t.action_statement                         AS routine_body 
+	FROM   information_schema.triggers AS t 
+	WHERE  t.trigger_schema = '%s' 
+	ORDER  BY t.trigger_name; 
+`
+
+// mysql does not have sequences, every table with an auto_increment column has its own counter, which keeps the
+// next value to give. The counters are named after their table, and their range is the range of their column.
+// Since mysql 8.0 the counters of information_schema.tables are cached for information_schema_stats_expiry seconds.
+var mysqlQueryGetAutoIncrements = `
+	SELECT ''                                         AS sequence_schema, 
+		   tab.table_name                             AS sequence_name, 
+		   'auto_increment'                           AS sequence_kind, 
+		   tab.table_name                             AS table_name, 
+		   col.column_name                            AS column_name, 
+		   col.column_type                            AS data_type, 
+		   @@auto_increment_increment                 AS increment, 
+		   CAST(tab.auto_increment AS SIGNED) - 1     AS current_value, 
+		   0                                          AS max_value 
+	FROM   information_schema.tables AS tab 
+		   JOIN information_schema.columns AS col 
+			 ON col.table_schema = tab.table_schema 
+				AND col.table_name = tab.table_name 
+	WHERE  tab.table_schema = '%s' 
+		   AND tab.table_type = 'BASE TABLE' 
+		   AND tab.auto_increment IS NOT NULL 
+		   AND col.extra LIKE '%%auto_increment%%' 
+	ORDER  BY sequence_name; 
+`
+
+// The character sets and the collations of the text columns, followed by the default ones of the tables, which are
+// given to the new columns of the tables.
+var mysqlQueryGetCollations = `
+	SELECT TABLE_NAME                        AS table_name, 
+		   COLUMN_NAME                       AS column_name, 
+		   COALESCE(CHARACTER_SET_NAME, '')  AS character_set, 
+		   COLLATION_NAME                    AS collation_name 
+	FROM   information_schema.columns 
+	WHERE  TABLE_SCHEMA = '%s' 
+		   AND COLLATION_NAME IS NOT NULL 
+	UNION ALL 
+	SELECT t.TABLE_NAME                      AS table_name, 
+		   ''                                AS column_name, 
+		   COALESCE(c.CHARACTER_SET_NAME, '') AS character_set, 
+		   t.TABLE_COLLATION                 AS collation_name 
+	FROM   information_schema.tables AS t 
+		   LEFT JOIN information_schema.collations AS c 
+				  ON c.COLLATION_NAME = t.TABLE_COLLATION 
+	WHERE  t.TABLE_SCHEMA = '%s' 
+		   AND t.TABLE_TYPE = 'BASE TABLE' 
+		   AND t.TABLE_COLLATION IS NOT NULL; 
+`
+
+// The partitions of mysql tables are not tables themselves, so they are only listed here. The subpartitions are
+// left out. The bounds of the partitions are written like in their definition, e.g. VALUES LESS THAN (1990).
+var mysqlQueryGetPartitions = `
+	SELECT TABLE_NAME                                                         AS table_name, 
+		   CONCAT(PARTITION_METHOD, ' (', COALESCE(PARTITION_EXPRESSION, ''), ')') AS partition_key, 
+		   PARTITION_NAME                                                     AS partition_name, 
+		   CASE 
+			 WHEN PARTITION_METHOD LIKE 'RANGE%%' THEN CONCAT('VALUES LESS THAN (', PARTITION_DESCRIPTION, ')') 
+			 WHEN PARTITION_METHOD LIKE 'LIST%%' THEN CONCAT('VALUES IN (', PARTITION_DESCRIPTION, ')') 
+			 ELSE '' 
+		   END                                                                AS partition_bound 
+	FROM   information_schema.partitions 
+	WHERE  TABLE_SCHEMA = '%s' 
+		   AND PARTITION_NAME IS NOT NULL 
+		   AND COALESCE(SUBPARTITION_ORDINAL_POSITION, 1) = 1 
+	ORDER  BY TABLE_NAME, 
+			  PARTITION_ORDINAL_POSITION; 
+`
+
+// mysql does not vacuum its tables and it does not tell when they were analyzed. The row count of an InnoDB table is
+// an estimate, and like the counters the statistics are cached since mysql 8.0.
+var mysqlQueryGetTablesStats = `
+	SELECT tab.table_name                    AS table_name, 
+		   COALESCE(tab.table_rows, 0)       AS row_count, 
+		   COALESCE(tab.data_length, 0)      AS table_size, 
+		   COALESCE(tab.index_length, 0)     AS index_size, 
+		   ''                                AS last_vacuum, 
+		   ''                                AS last_analyze 
+	FROM   information_schema.tables AS tab 
+	WHERE  tab.table_schema = '%s' 
+		   AND tab.table_type = 'BASE TABLE' 
+	ORDER  BY table_name; 
+`
+
+var mysqlQueryGetColumnDefinition = `
+	SELECT col.column_type, 
+		   col.is_nullable, 
+		   col.column_default, 
+		   col.extra, 
+		   COALESCE(col.generation_expression, ''), 
+		   col.character_set_name, 
+		   col.collation_name 
+	FROM   information_schema.columns AS col 
+	WHERE  col.table_schema = ? 
+		   AND col.table_name = ? 
+		   AND col.column_name = ?;
+`
+
+var mysqlQueryGetColumns = "SELECT * FROM `%[2]s` LIMIT 0;"
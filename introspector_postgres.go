@@ -0,0 +1,748 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/lib/pq"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const psqlDbSource string = "user=%s password=%s host=%s port=%d dbname=%s sslmode=disable"
+
+func init() {
+	registerIntrospector("postgres", introspectorDriver{
+		sqlDriver:       "postgres",
+		dataSource:      formatPsqlSource,
+		newIntrospector: newPsqlIntrospector,
+	})
+}
+
+// formatPsqlSource formats a valid dataSourceName using the given *Config so we can connect to a postgres database.
+func formatPsqlSource(conf *Config) string {
+	return fmt.Sprintf(psqlDbSource, conf.DatabaseUser, conf.DatabasePassword, conf.DatabaseHost,
+		conf.DatabasePort, conf.DatabaseName)
+}
+
+// psqlIntrospector is the Introspector of postgres databases.
+// The tables of postgres databases are identified by their schema, so godic can read several schemas at once.
+type psqlIntrospector struct {
+	db      *sql.DB
+	schemas []string
+}
+
+// newPsqlIntrospector returns the Introspector of the postgres database behind the given connection.
+func newPsqlIntrospector(db *sql.DB, conf *Config) (Introspector, error) {
+	return &psqlIntrospector{db: db, schemas: conf.schemas()}, nil
+}
+
+func (in *psqlIntrospector) Catalog() (*catalog, error) {
+	schemas, err := in.readSchemas()
+	if err != nil {
+		return nil, err
+	}
+	list := psqlLiteralList(schemas)
+
+	return readSqlCatalog(in.db, sqlQueries{
+		TableNames:   fmt.Sprintf(psqlQueryGetTableNames, list),
+		Views:        fmt.Sprintf(psqlQueryGetViews, list),
+		Columns:      psqlQueryGetColumns,
+		PrimaryKeys:  fmt.Sprintf(psqlQueryGetPKs, list),
+		ForeignKeys:  fmt.Sprintf(psqlQueryGetFKs, list),
+		Enums:        fmt.Sprintf(psqlQueryEnumTypesAndCols, list),
+		ColumnsTypes: fmt.Sprintf(psqlQueryGetColumnsTypes, list),
+		Types:        fmt.Sprintf(psqlQueryGetTypes, list),
+		Uniques:      fmt.Sprintf(psqlQueryGetUniquesColumns, list),
+		Indexes:      fmt.Sprintf(psqlQueryGetIndexes, list),
+		Checks:       fmt.Sprintf(psqlQueryGetChecks, list),
+		Defaults:     fmt.Sprintf(psqlQueryGetColumnsDefaults, list),
+		Comments:     fmt.Sprintf(psqlQueryGetComments, list),
+		Collations:   fmt.Sprintf(psqlQueryGetCollations, list),
+		Partitions:   fmt.Sprintf(psqlQueryGetPartitions, list),
+		Inherits:     fmt.Sprintf(psqlQueryGetInherits, list),
+		Routines:     fmt.Sprintf(psqlQueryGetRoutines, list),
+		Triggers:     fmt.Sprintf(psqlQueryGetTriggers, list),
+		Sequences:    fmt.Sprintf(psqlQueryGetSequences, list),
+		Stats:        fmt.Sprintf(psqlQueryGetTablesStats, list),
+	})
+}
+
+// readSchemas returns the schemas scanned by the introspector. If the schemas were given as allSchemas
+// readSchemas will return all the non system schemas of the database.
+func (in *psqlIntrospector) readSchemas() ([]string, error) {
+	if len(in.schemas) != 1 || in.schemas[0] != allSchemas {
+		return in.schemas, nil
+	}
+
+	schemas := make([]string, 0)
+	rows, err := in.db.Query(psqlQueryGetSchemas)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var schema string
+		if err = rows.Scan(&schema); err != nil {
+			return nil, err
+		}
+		schemas = append(schemas, schema)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return schemas, nil
+}
+
+// psqlLiteralList returns the given values as a list of sql literals that can be used with the IN operator.
+func psqlLiteralList(values []string) string {
+	literals := make([]string, len(values))
+	for i := range values {
+		literals[i] = pq.QuoteLiteral(values[i])
+	}
+	if len(literals) == 0 {
+		return "NULL"
+	}
+	return strings.Join(literals, ", ")
+}
+
+// psqlCommentObjects maps the kind of a table to the object type used in the COMMENT ON statement.
+var psqlCommentObjects = map[string]string{
+	tableKind:            "TABLE",
+	viewKind:             "VIEW",
+	materializedViewKind: "MATERIALIZED VIEW",
+}
+
+func (in *psqlIntrospector) CommentStatements(cat *catalog, comments []descriptionComment) ([]string, error) {
+	statements := make([]string, 0, len(comments))
+	for _, c := range comments {
+		t := cat.table(c.Table)
+		name := pq.QuoteIdentifier(t.Name)
+		if t.Schema != "" {
+			name = pq.QuoteIdentifier(t.Schema) + "." + name
+		}
+		if c.Column != "" {
+			statements = append(statements, fmt.Sprintf("COMMENT ON COLUMN %s.%s IS %s", name,
+				pq.QuoteIdentifier(c.Column), pq.QuoteLiteral(c.Description)))
+			continue
+		}
+		object, ok := psqlCommentObjects[t.Kind]
+		if !ok {
+			return nil, fmt.Errorf("cannot write a comment for %s of kind %s", t.ID, t.Kind)
+		}
+		statements = append(statements, fmt.Sprintf("COMMENT ON %s %s IS %s", object, name,
+			pq.QuoteLiteral(c.Description)))
+	}
+	return statements, nil
+}
+
+func (in *psqlIntrospector) ExecStatements(statements []string) error {
+	return execStatements(in.db, statements)
+}
+
+// psqlProfileDialect writes the sampled aggregate queries of the profiling job for postgres.
+var psqlProfileDialect = sqlProfileDialect{text: "CAST(%s AS TEXT)", length: "LENGTH(%s)"}
+
+// ProfileColumn samples tables and materialized views with TABLESAMPLE SYSTEM, which reads random pages of the
+// table instead of scanning it. The sampled percentage is taken from the estimated rows of the table, so the
+// sample holds about sampleRows rows, and the seed is repeated so every query of a column reads the same pages.
+// Views cannot be sampled, so their first sampleRows rows are read.
+func (in *psqlIntrospector) ProfileColumn(ctx context.Context, cat *catalog, tableID string, col column,
+	sampleRows int64) (columnProfile, error) {
+	t := cat.table(tableID)
+	from := pq.QuoteIdentifier(t.Name)
+	if t.Schema != "" {
+		from = pq.QuoteIdentifier(t.Schema) + "." + from
+	}
+	if rows := cat.estimatedRows(tableID); t.Kind != viewKind && rows > sampleRows {
+		percent := float64(sampleRows) * 100 / float64(rows)
+		from += fmt.Sprintf(" TABLESAMPLE SYSTEM (%s) REPEATABLE (%d)", strconv.FormatFloat(percent, 'f', -1, 64),
+			time.Now().Unix())
+	}
+	return profileSqlColumn(ctx, in.db, psqlProfileDialect, from, col, pq.QuoteIdentifier(col.Name), sampleRows)
+}
+
+var psqlQueryGetSchemas = `
+	SELECT nspname AS schema_name
+	FROM   pg_catalog.pg_namespace
+	WHERE  nspname NOT IN ( 'pg_catalog', 'information_schema' )
+		   AND nspname NOT LIKE 'pg\_toast%'
+		   AND nspname NOT LIKE 'pg\_temp\_%'
+	ORDER  BY nspname;
+`
+
+// The partitions of the partitioned tables are documented within their parent table, see psqlQueryGetPartitions.
+var psqlQueryGetTableNames = `
+	SELECT TABLE_SCHEMA as table_schema,
+		   TABLE_NAME as table_name
+	FROM   information_schema.tables AS t 
+	WHERE  TABLE_TYPE = 'BASE TABLE'
+		   AND TABLE_SCHEMA IN ( %s )
+		   AND NOT EXISTS (SELECT 1 
+						   FROM   pg_class AS c 
+								  JOIN pg_namespace AS pgn 
+									ON pgn.oid = c.relnamespace 
+						   WHERE  pgn.nspname = t.TABLE_SCHEMA 
+								  AND c.relname = t.TABLE_NAME 
+								  AND c.relispartition);
+`
+
+var psqlQueryGetViews = `
+	SELECT pgn.nspname                    AS view_schema, 
+		   tbl.relname                    AS view_name, 
+		   CASE tbl.relkind 
+			 WHEN 'm' THEN 'materialized view' 
+			 ELSE 'view' 
+		   END                            AS view_kind, 
+		   pg_get_viewdef(tbl.oid, true)  AS view_definition 
+	FROM   pg_class AS tbl 
+		   JOIN pg_namespace AS pgn 
+			 ON pgn.oid = tbl.relnamespace 
+	WHERE  tbl.relkind IN ( 'v', 'm' ) 
+		   AND pgn.nspname IN ( %s ) 
+	ORDER  BY pgn.nspname, 
+			  tbl.relname; 
+`
+
+// The keys and enums are read from pg_catalog instead of information_schema, because the names of the
+// constraints in postgres are only unique per table, so joining the information_schema views by
+// constraint name mixes up the constraints of different tables and schemas.
+
+var psqlQueryGetPKs = `
+	SELECT pga.attname                   AS column_name, 
+		   pgn.nspname || '.' || tbl.relname AS table_name, 
+		   con.conname                       AS constraint_name 
+	FROM   pg_constraint AS con 
+		   JOIN pg_class AS tbl 
+			 ON tbl.oid = con.conrelid 
+		   JOIN pg_namespace AS pgn 
+			 ON pgn.oid = tbl.relnamespace 
+		   CROSS JOIN LATERAL unnest(con.conkey) WITH ORDINALITY AS k(attnum, position) 
+		   JOIN pg_attribute AS pga 
+			 ON pga.attrelid = con.conrelid 
+				AND pga.attnum = k.attnum 
+	WHERE  con.contype = 'p' 
+		   AND NOT tbl.relispartition 
+		   AND pgn.nspname IN ( %s ) 
+	ORDER  BY table_name, 
+			  k.position; 
+`
+
+var psqlQueryGetFKs = `
+	SELECT pgn.nspname || '.' || tbl.relname         AS origin_table_name, 
+		   target_pgn.nspname || '.' || target.relname AS target_table_name, 
+		   pga.attname                               AS column_name, 
+		   CASE con.confdeltype 
+			 WHEN 'r' THEN 'RESTRICT' 
+			 WHEN 'c' THEN 'CASCADE' 
+			 WHEN 'n' THEN 'SET NULL' 
+			 WHEN 'd' THEN 'SET DEFAULT' 
+			 ELSE 'NO ACTION' 
+		   END                                       AS delete_rule, 
+		   CASE con.confupdtype 
+			 WHEN 'r' THEN 'RESTRICT' 
+			 WHEN 'c' THEN 'CASCADE' 
+			 WHEN 'n' THEN 'SET NULL' 
+			 WHEN 'd' THEN 'SET DEFAULT' 
+			 ELSE 'NO ACTION' 
+		   END                                       AS update_rule, 
+		   target_pga.attname                        AS target_column_name, 
+		   con.conname                               AS constraint_name 
+	FROM   pg_constraint AS con 
+		   JOIN pg_class AS tbl 
+			 ON tbl.oid = con.conrelid 
+		   JOIN pg_namespace AS pgn 
+			 ON pgn.oid = tbl.relnamespace 
+		   JOIN pg_class AS target 
+			 ON target.oid = con.confrelid 
+		   JOIN pg_namespace AS target_pgn 
+			 ON target_pgn.oid = target.relnamespace 
+		   CROSS JOIN LATERAL unnest(con.conkey, con.confkey) WITH ORDINALITY AS k(attnum, target_attnum, position) 
+		   JOIN pg_attribute AS pga 
+			 ON pga.attrelid = con.conrelid 
+				AND pga.attnum = k.attnum 
+		   JOIN pg_attribute AS target_pga 
+			 ON target_pga.attrelid = con.confrelid 
+				AND target_pga.attnum = k.target_attnum 
+	WHERE  con.contype = 'f' 
+		   AND NOT tbl.relispartition 
+		   AND pgn.nspname IN ( %s ) 
+	ORDER  BY origin_table_name, 
+			  con.conname, 
+			  k.position; 
+`
+
+var psqlQueryEnumTypesAndCols = `
+	SELECT pgn.nspname || '.' || tbl.relname                 AS table_name, 
+		   pga.attname                                       AS column_name, 
+		   t.typname                                         AS enum_name, 
+		   String_agg(e.enumlabel, ',' ORDER BY e.enumsortorder) AS enum_value 
+	FROM   pg_attribute AS pga 
+		   JOIN pg_class AS tbl 
+			 ON tbl.oid = pga.attrelid 
+		   JOIN pg_namespace AS pgn 
+			 ON pgn.oid = tbl.relnamespace 
+		   JOIN pg_type AS t 
+			 ON t.oid = pga.atttypid 
+		   JOIN pg_enum AS e 
+			 ON e.enumtypid = t.oid 
+	WHERE  tbl.relkind IN ( 'r', 'p', 'v', 'm' ) 
+		   AND pga.attnum > 0 
+		   AND NOT pga.attisdropped 
+		   AND NOT tbl.relispartition 
+		   AND pgn.nspname IN ( %s ) 
+	GROUP  BY pgn.nspname, 
+			  tbl.relname, 
+			  pga.attname, 
+			  t.typname; 
+`
+
+// The columns typed with a domain, a composite type, a range type or an array. The user type of an array is empty
+// and its base type is the type of its elements. The types of pg_catalog, e.g. int4range, have no user type either.
+var psqlQueryGetColumnsTypes = `
+	SELECT pgn.nspname || '.' || tbl.relname                         AS table_name, 
+		   pga.attname                                               AS column_name, 
+		   CASE 
+			 WHEN t.typtype = 'd' THEN 'domain' 
+			 WHEN t.typtype = 'c' THEN 'composite' 
+			 WHEN t.typtype = 'r' THEN 'range' 
+			 ELSE 'array' 
+		   END                                                       AS type_kind, 
+		   CASE 
+			 WHEN t.typcategory = 'A' OR tn.nspname = 'pg_catalog' THEN '' 
+			 ELSE tn.nspname || '.' || t.typname 
+		   END                                                       AS user_type, 
+		   CASE 
+			 WHEN t.typtype = 'd' THEN Format_type(t.typbasetype, t.typtypmod) 
+			 WHEN t.typtype = 'r' THEN Format_type(r.rngsubtype, NULL) 
+			 WHEN t.typcategory = 'A' THEN Format_type(t.typelem, NULL) 
+			 ELSE '' 
+		   END                                                       AS base_type 
+	FROM   pg_attribute AS pga 
+		   JOIN pg_class AS tbl 
+			 ON tbl.oid = pga.attrelid 
+		   JOIN pg_namespace AS pgn 
+			 ON pgn.oid = tbl.relnamespace 
+		   JOIN pg_type AS t 
+			 ON t.oid = pga.atttypid 
+		   JOIN pg_namespace AS tn 
+			 ON tn.oid = t.typnamespace 
+		   LEFT JOIN pg_range AS r 
+				  ON r.rngtypid = t.oid 
+	WHERE  tbl.relkind IN ( 'r', 'p', 'v', 'm', 'f' ) 
+		   AND pga.attnum > 0 
+		   AND NOT pga.attisdropped 
+		   AND ( t.typtype IN ( 'd', 'c', 'r' ) OR t.typcategory = 'A' ) 
+		   AND NOT tbl.relispartition 
+		   AND pgn.nspname IN ( %s ) 
+	ORDER  BY table_name, 
+			  pga.attnum; 
+`
+
+// The enums, domains, composite types and range types of the schemas. Every type is listed once per item: the
+// labels of an enum, the check constraints of a domain and the attributes of a composite type. Types without items
+// are listed once with an empty item. The composite types of the tables are left out.
+var psqlQueryGetTypes = `
+	SELECT tn.nspname                                               AS type_schema, 
+		   t.typname                                                AS type_name, 
+		   CASE t.typtype 
+			 WHEN 'e' THEN 'enum' 
+			 WHEN 'd' THEN 'domain' 
+			 WHEN 'c' THEN 'composite' 
+			 ELSE 'range' 
+		   END                                                      AS type_kind, 
+		   CASE 
+			 WHEN t.typtype = 'd' THEN Format_type(t.typbasetype, t.typtypmod) 
+			 WHEN t.typtype = 'r' THEN Format_type(r.rngsubtype, NULL) 
+			 ELSE '' 
+		   END                                                      AS base_type, 
+		   t.typnotnull                                             AS not_null, 
+		   COALESCE(t.typdefault, '')                               AS type_default, 
+		   COALESCE(i.item, '')                                     AS item, 
+		   COALESCE(i.definition, '')                               AS definition 
+	FROM   pg_type AS t 
+		   JOIN pg_namespace AS tn 
+			 ON tn.oid = t.typnamespace 
+		   LEFT JOIN pg_class AS c 
+				  ON c.oid = t.typrelid 
+		   LEFT JOIN pg_range AS r 
+				  ON r.rngtypid = t.oid 
+		   LEFT JOIN LATERAL (SELECT e.enumlabel :: TEXT          AS item, 
+									 ''                           AS definition, 
+									 e.enumsortorder :: FLOAT8    AS position 
+							  FROM   pg_enum AS e 
+							  WHERE  e.enumtypid = t.oid 
+							  UNION ALL 
+							  SELECT con.conname :: TEXT, 
+									 Pg_get_expr(con.conbin, 0, true), 
+									 con.oid :: BIGINT :: FLOAT8 
+							  FROM   pg_constraint AS con 
+							  WHERE  con.contypid = t.oid 
+									 AND con.contype = 'c' 
+							  UNION ALL 
+							  SELECT a.attname :: TEXT, 
+									 Format_type(a.atttypid, a.atttypmod), 
+									 a.attnum :: FLOAT8 
+							  FROM   pg_attribute AS a 
+							  WHERE  a.attrelid = t.typrelid 
+									 AND a.attnum > 0 
+									 AND NOT a.attisdropped) AS i 
+				  ON true 
+	WHERE  ( t.typtype IN ( 'e', 'd', 'r' ) OR ( t.typtype = 'c' AND c.relkind = 'c' ) ) 
+		   AND tn.nspname IN ( %s ) 
+	ORDER  BY type_schema, 
+			  type_name, 
+			  i.position; 
+`
+
+var psqlQueryGetUniquesColumns = `
+	SELECT DISTINCT 
+           pgn.nspname || '.' || tbl.relname AS table_name, 
+		   pga.attname                     AS column_name
+	FROM   pg_index AS pgi 
+		   JOIN pg_class AS pgc 
+			 ON pgc.oid = pgi.indexrelid 
+		   JOIN pg_namespace AS pgn 
+			 ON pgn.oid = pgc.relnamespace 
+		   JOIN pg_class AS tbl 
+			 ON tbl.oid = pgi.indrelid 
+		   JOIN pg_attribute AS pga 
+			 ON pga.attrelid = pgc.oid 
+	WHERE  pgi.indisunique = true 
+		   AND NOT tbl.relispartition 
+		   AND pgn.nspname IN ( %s ); 
+`
+
+// The keys of an index are kept in indkey, where expressions have the attribute number 0. The columns added to
+// an index with INCLUDE are not keys of the index, so they are left out.
+var psqlQueryGetIndexes = `
+	SELECT pgn.nspname || '.' || tbl.relname                     AS table_name, 
+		   idx.relname                                           AS index_name, 
+		   pgi.indisunique                                       AS is_unique, 
+		   am.amname                                             AS index_method, 
+		   COALESCE(pg_get_expr(pgi.indpred, pgi.indrelid, true), '') AS index_predicate, 
+		   COALESCE(pga.attname, '')                             AS column_name, 
+		   CASE 
+			 WHEN k.attnum = 0 THEN pg_get_indexdef(pgi.indexrelid, k.position :: INT, true) 
+			 ELSE '' 
+		   END                                                   AS expression 
+	FROM   pg_index AS pgi 
+		   JOIN pg_class AS idx 
+			 ON idx.oid = pgi.indexrelid 
+		   JOIN pg_class AS tbl 
+			 ON tbl.oid = pgi.indrelid 
+		   JOIN pg_namespace AS pgn 
+			 ON pgn.oid = tbl.relnamespace 
+		   JOIN pg_am AS am 
+			 ON am.oid = idx.relam 
+		   CROSS JOIN LATERAL UNNEST(pgi.indkey :: INT2[]) WITH ORDINALITY AS k(attnum, position) 
+		   LEFT JOIN pg_attribute AS pga 
+				  ON pga.attrelid = pgi.indrelid 
+					 AND pga.attnum = k.attnum 
+	WHERE  NOT tbl.relispartition 
+		   AND pgn.nspname IN ( %s ) 
+		   AND k.position <= pgi.indnkeyatts 
+	ORDER  BY table_name, 
+			  idx.relname, 
+			  k.position; 
+`
+
+// The columns referenced by a check constraint are ordered by their position in the table.
+var psqlQueryGetChecks = `
+	SELECT pgn.nspname || '.' || tbl.relname              AS table_name, 
+		   con.conname                                    AS constraint_name, 
+		   pg_get_expr(con.conbin, con.conrelid, true)    AS check_clause, 
+		   COALESCE(pga.attname, '')                      AS column_name 
+	FROM   pg_constraint AS con 
+		   JOIN pg_class AS tbl 
+			 ON tbl.oid = con.conrelid 
+		   JOIN pg_namespace AS pgn 
+			 ON pgn.oid = tbl.relnamespace 
+		   LEFT JOIN LATERAL UNNEST(con.conkey) AS k(attnum) 
+				  ON true 
+		   LEFT JOIN pg_attribute AS pga 
+				  ON pga.attrelid = con.conrelid 
+					 AND pga.attnum = k.attnum 
+	WHERE  con.contype = 'c' 
+		   AND NOT tbl.relispartition 
+		   AND pgn.nspname IN ( %s ) 
+	ORDER  BY table_name, 
+			  con.conname, 
+			  k.attnum; 
+`
+
+// The default of a generated column is its generation expression, so we tell them apart with attgenerated.
+var psqlQueryGetColumnsDefaults = `
+	SELECT pgn.nspname || '.' || tbl.relname AS table_name, 
+		   pga.attname                       AS column_name, 
+		   CASE 
+			 WHEN pga.attgenerated = '' THEN COALESCE(pg_get_expr(def.adbin, def.adrelid), '') 
+			 ELSE '' 
+		   END                               AS column_default, 
+		   CASE pga.attidentity 
+			 WHEN 'a' THEN 'ALWAYS' 
+			 WHEN 'd' THEN 'BY DEFAULT' 
+			 ELSE '' 
+		   END                               AS identity_generation, 
+		   CASE 
+			 WHEN pga.attgenerated <> '' THEN COALESCE(pg_get_expr(def.adbin, def.adrelid), '') 
+			 ELSE '' 
+		   END                               AS generation_expression, 
+		   CASE pga.attgenerated 
+			 WHEN 's' THEN 'STORED GENERATED' 
+			 ELSE '' 
+		   END                               AS extra 
+	FROM   pg_attribute AS pga 
+		   JOIN pg_class AS tbl 
+			 ON tbl.oid = pga.attrelid 
+		   JOIN pg_namespace AS pgn 
+			 ON pgn.oid = tbl.relnamespace 
+		   LEFT JOIN pg_attrdef AS def 
+				  ON def.adrelid = pga.attrelid 
+					 AND def.adnum = pga.attnum 
+	WHERE  tbl.relkind IN ( 'r', 'p', 'v', 'm' ) 
+		   AND pga.attnum > 0 
+		   AND NOT pga.attisdropped 
+		   AND ( def.adbin IS NOT NULL 
+				  OR pga.attidentity <> '' ) 
+		   AND NOT tbl.relispartition 
+		   AND pgn.nspname IN ( %s ); 
+`
+
+// The comments of the tables have an objsubid of 0, which does not match any column.
+var psqlQueryGetComments = `
+	SELECT pgn.nspname || '.' || tbl.relname AS table_name, 
+		   COALESCE(pga.attname, '')         AS column_name, 
+		   d.description                     AS comment 
+	FROM   pg_description AS d 
+		   JOIN pg_class AS tbl 
+			 ON tbl.oid = d.objoid 
+				AND d.classoid = 'pg_catalog.pg_class'::regclass 
+		   JOIN pg_namespace AS pgn 
+			 ON pgn.oid = tbl.relnamespace 
+		   LEFT JOIN pg_attribute AS pga 
+				  ON pga.attrelid = tbl.oid 
+					 AND pga.attnum = d.objsubid 
+					 AND d.objsubid > 0 
+	WHERE  tbl.relkind IN ( 'r', 'p', 'v', 'm' ) 
+		   AND d.description <> '' 
+		   AND NOT tbl.relispartition 
+		   AND pgn.nspname IN ( %s ); 
+`
+
+// The functions and procedures that belong to an extension are left out, they are documented by the extension.
+// Aggregate and window functions are left out too.
+var psqlQueryGetRoutines = `
+	SELECT pgn.nspname                                AS routine_schema, 
+		   p.proname                                  AS routine_name, 
+		   CASE p.prokind 
+			 WHEN 'p' THEN 'procedure' 
+			 ELSE 'function' 
+		   END                                        AS routine_kind, 
+		   pg_get_function_identity_arguments(p.oid)  AS routine_signature, 
+		   pg_get_function_arguments(p.oid)           AS routine_arguments, 
+		   COALESCE(pg_get_function_result(p.oid), '') AS return_type, 
+		   l.lanname                                  AS routine_language, 
+		   ''                                         AS table_name, 
+		   ''                                         AS routine_event, 
+		   COALESCE(p.prosrc, '')                     AS routine_body 
+	FROM   pg_proc AS p 
+		   JOIN pg_namespace AS pgn 
+			 ON pgn.oid = p.pronamespace 
+		   JOIN pg_language AS l 
+			 ON l.oid = p.prolang 
+	WHERE  p.prokind IN ( 'f', 'p' ) 
+		   AND pgn.nspname IN ( %s ) 
+		   AND NOT EXISTS (SELECT 1 
+						   FROM   pg_depend AS d 
+						   WHERE  d.classid = 'pg_catalog.pg_proc'::regclass 
+								  AND d.objid = p.oid 
+								  AND d.deptype = 'e') 
+	ORDER  BY routine_schema, 
+			  routine_name, 
+			  routine_signature; 
+`
+
+// The timing, the events and the level of a trigger are kept as bits of tgtype: 1 ROW, 2 BEFORE, 4 INSERT,
+// 8 DELETE, 16 UPDATE, 32 TRUNCATE and 64 INSTEAD. The triggers created by postgres for the foreign keys are internal.
+// The row triggers of a partitioned table are cloned on its partitions, and the clones are not internal since
+// postgres 13, so the triggers of the partitions are left out.
+var psqlQueryGetTriggers = `
+	SELECT pgn.nspname                                AS routine_schema, 
+		   tg.tgname                                  AS routine_name, 
+		   'trigger'                                  AS routine_kind, 
+		   ''                                         AS routine_signature, 
+		   ''                                         AS routine_arguments, 
+		   ''                                         AS return_type, 
+		   l.lanname                                  AS routine_language, 
+		   pgn.nspname || '.' || tbl.relname          AS table_name, 
+		   CASE 
+			 WHEN tg.tgtype :: INT & 2 <> 0 THEN 'BEFORE' 
+			 WHEN tg.tgtype :: INT & 64 <> 0 THEN 'INSTEAD OF' 
+			 ELSE 'AFTER' 
+		   END || ' ' || 
+		   CONCAT_WS(' OR ', 
+				CASE WHEN tg.tgtype :: INT & 4 <> 0 THEN 'INSERT' END, 
+				CASE WHEN tg.tgtype :: INT & 8 <> 0 THEN 'DELETE' END, 
+				CASE WHEN tg.tgtype :: INT & 16 <> 0 THEN 'UPDATE' END, 
+				CASE WHEN tg.tgtype :: INT & 32 <> 0 THEN 'TRUNCATE' END) || 
+		   CASE 
+			 WHEN tg.tgtype :: INT & 1 <> 0 THEN ' FOR EACH ROW' 
+			 ELSE ' FOR EACH STATEMENT' 
+		   END                                        AS routine_event, 
+		   pg_get_triggerdef(tg.oid, true)            AS routine_body 
+	FROM   pg_trigger AS tg 
+		   JOIN pg_class AS tbl 
+			 ON tbl.oid = tg.tgrelid 
+		   JOIN pg_namespace AS pgn 
+			 ON pgn.oid = tbl.relnamespace 
+		   JOIN pg_proc AS p 
+			 ON p.oid = tg.tgfoid 
+		   JOIN pg_language AS l 
+			 ON l.oid = p.prolang 
+	WHERE  NOT tg.tgisinternal 
+		   AND NOT tbl.relispartition 
+		   AND pgn.nspname IN ( %s ) 
+	ORDER  BY routine_schema, 
+			  table_name, 
+			  routine_name; 
+`
+
+// The column fed by a sequence is the column that owns it, either as a serial column (an auto dependency) or as an
+// identity column (an internal dependency). The last value of a sequence is null until the sequence is used, so
+// then its current value is the value right before its start value.
+var psqlQueryGetSequences = `
+	SELECT s.schemaname                                    AS sequence_schema, 
+		   s.sequencename                                  AS sequence_name, 
+		   'sequence'                                      AS sequence_kind, 
+		   COALESCE(tn.nspname || '.' || tbl.relname, '')  AS table_name, 
+		   COALESCE(a.attname, '')                         AS column_name, 
+		   s.data_type :: TEXT                             AS data_type, 
+		   s.increment_by                                  AS increment, 
+		   COALESCE(s.last_value, s.start_value - s.increment_by) AS current_value, 
+		   GREATEST(s.max_value, 0)                        AS max_value 
+	FROM   pg_sequences AS s 
+		   JOIN pg_namespace AS sn 
+			 ON sn.nspname = s.schemaname 
+		   JOIN pg_class AS seq 
+			 ON seq.relnamespace = sn.oid 
+				AND seq.relname = s.sequencename 
+		   LEFT JOIN pg_depend AS d 
+				  ON d.classid = 'pg_catalog.pg_class'::regclass 
+					 AND d.objid = seq.oid 
+					 AND d.refclassid = 'pg_catalog.pg_class'::regclass 
+					 AND d.deptype IN ( 'a', 'i' ) 
+		   LEFT JOIN pg_class AS tbl 
+				  ON tbl.oid = d.refobjid 
+		   LEFT JOIN pg_namespace AS tn 
+				  ON tn.oid = tbl.relnamespace 
+		   LEFT JOIN pg_attribute AS a 
+				  ON a.attrelid = d.refobjid 
+					 AND a.attnum = d.refobjsubid 
+	WHERE  s.schemaname IN ( %s ) 
+	ORDER  BY sequence_schema, 
+			  sequence_name; 
+`
+
+// The collations of the columns whose type is collatable, e.g. default or "C". Postgres has a single character set,
+// the encoding of the database, so the character set of the columns is left empty.
+var psqlQueryGetCollations = `
+	SELECT pgn.nspname || '.' || tbl.relname   AS table_name, 
+		   pga.attname                         AS column_name, 
+		   ''                                  AS character_set, 
+		   co.collname                         AS collation_name 
+	FROM   pg_attribute AS pga 
+		   JOIN pg_class AS tbl 
+			 ON tbl.oid = pga.attrelid 
+		   JOIN pg_namespace AS pgn 
+			 ON pgn.oid = tbl.relnamespace 
+		   JOIN pg_collation AS co 
+			 ON co.oid = pga.attcollation 
+	WHERE  tbl.relkind IN ( 'r', 'p', 'v', 'm', 'f' ) 
+		   AND pga.attnum > 0 
+		   AND NOT pga.attisdropped 
+		   AND NOT tbl.relispartition 
+		   AND pgn.nspname IN ( %s ) 
+	ORDER  BY table_name, 
+			  pga.attnum; 
+`
+
+// The partitions of a partitioned table are given by their id, like the tables. The partitions of a partition are
+// listed with the partition they belong to, which is not documented as a table itself.
+var psqlQueryGetPartitions = `
+	SELECT pgn.nspname || '.' || c.relname                    AS table_name, 
+		   pg_get_partkeydef(c.oid)                            AS partition_key, 
+		   COALESCE(pn.nspname || '.' || p.relname, '')        AS partition_name, 
+		   COALESCE(pg_get_expr(p.relpartbound, p.oid), '')    AS partition_bound 
+	FROM   pg_class AS c 
+		   JOIN pg_namespace AS pgn 
+			 ON pgn.oid = c.relnamespace 
+		   LEFT JOIN pg_inherits AS i 
+				  ON i.inhparent = c.oid 
+		   LEFT JOIN pg_class AS p 
+				  ON p.oid = i.inhrelid 
+		   LEFT JOIN pg_namespace AS pn 
+				  ON pn.oid = p.relnamespace 
+	WHERE  c.relkind = 'p' 
+		   AND pgn.nspname IN ( %s ) 
+	ORDER  BY table_name, 
+			  partition_name; 
+`
+
+// The tables that inherit from other tables with the INHERITS clause, in the order of their parents.
+var psqlQueryGetInherits = `
+	SELECT cn.nspname || '.' || c.relname   AS table_name, 
+		   pn.nspname || '.' || p.relname   AS parent_name 
+	FROM   pg_inherits AS i 
+		   JOIN pg_class AS c 
+			 ON c.oid = i.inhrelid 
+		   JOIN pg_namespace AS cn 
+			 ON cn.oid = c.relnamespace 
+		   JOIN pg_class AS p 
+			 ON p.oid = i.inhparent 
+		   JOIN pg_namespace AS pn 
+			 ON pn.oid = p.relnamespace 
+	WHERE  c.relkind = 'r' 
+		   AND NOT c.relispartition 
+		   AND cn.nspname IN ( %s ) 
+	ORDER  BY table_name, 
+			  i.inhseqno; 
+`
+
+// The row count of a table is the estimate kept in reltuples, which is -1 until the table is vacuumed or analyzed for
+// the first time. The size of a table includes its toast table. Materialized views have sizes too. The row count
+// and the sizes of a partitioned table are the sums of those of its leaf partitions.
+var psqlQueryGetTablesStats = `
+	SELECT pgn.nspname || '.' || c.relname                AS table_name, 
+		   COALESCE(leaf.row_count, GREATEST(c.reltuples, 0)) :: BIGINT AS row_count, 
+		   COALESCE(leaf.table_size, pg_table_size(c.oid)) :: BIGINT AS table_size, 
+		   COALESCE(leaf.index_size, pg_indexes_size(c.oid)) :: BIGINT AS index_size, 
+		   COALESCE(TO_CHAR(GREATEST(s.last_vacuum, s.last_autovacuum) AT TIME ZONE 'UTC', 
+							'YYYY-MM-DD"T"HH24:MI:SS"Z"'), '') AS last_vacuum, 
+		   COALESCE(TO_CHAR(GREATEST(s.last_analyze, s.last_autoanalyze) AT TIME ZONE 'UTC', 
+							'YYYY-MM-DD"T"HH24:MI:SS"Z"'), '') AS last_analyze 
+	FROM   pg_class AS c 
+		   JOIN pg_namespace AS pgn 
+			 ON pgn.oid = c.relnamespace 
+		   LEFT JOIN pg_stat_user_tables AS s 
+				  ON s.relid = c.oid 
+		   LEFT JOIN LATERAL (SELECT SUM(GREATEST(l.reltuples, 0))  AS row_count, 
+									 SUM(pg_table_size(l.oid))       AS table_size, 
+									 SUM(pg_indexes_size(l.oid))     AS index_size 
+							  FROM   pg_partition_tree(c.oid) AS pt 
+									 JOIN pg_class AS l 
+									   ON l.oid = pt.relid 
+							  WHERE  c.relkind = 'p' 
+									 AND pt.isleaf) AS leaf 
+				  ON true 
+	WHERE  c.relkind IN ( 'r', 'p', 'm' ) 
+		   AND NOT c.relispartition 
+		   AND pgn.nspname IN ( %s ) 
+	ORDER  BY table_name; 
+`
+
+var psqlQueryGetColumns = "SELECT * FROM %q.%q LIMIT 0;"
@@ -0,0 +1,344 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const sqliteDbSource string = "file:%s?mode=ro"
+
+// sqliteSqlDriver is the name under which the sqlite driver is registered in database/sql.
+const sqliteSqlDriver string = "sqlite3"
+
+func init() {
+	registerIntrospector("sqlite", introspectorDriver{
+		sqlDriver:       sqliteSqlDriver,
+		dataSource:      formatSqliteSource,
+		newIntrospector: newSqliteIntrospector,
+	})
+}
+
+// formatSqliteSource formats a valid dataSourceName using the given *Config so we can open a sqlite database.
+// For sqlite the database name is the path to the database file.
+func formatSqliteSource(conf *Config) string {
+	return fmt.Sprintf(sqliteDbSource, conf.DatabaseName)
+}
+
+// sqliteIntrospector is the Introspector of sqlite databases.
+type sqliteIntrospector struct {
+	db *sql.DB
+}
+
+// newSqliteIntrospector returns the Introspector of the sqlite database behind the given connection.
+func newSqliteIntrospector(db *sql.DB, conf *Config) (Introspector, error) {
+	return &sqliteIntrospector{db: db}, nil
+}
+
+func (in *sqliteIntrospector) Catalog() (*catalog, error) {
+	// sqlite creates the table that keeps the auto increment counters along with the first AUTOINCREMENT table.
+	var counters int
+	if err := in.db.QueryRow(sqliteQueryHasSequences).Scan(&counters); err != nil {
+		return nil, err
+	}
+	sequences := ""
+	if counters > 0 {
+		sequences = sqliteQueryGetAutoIncrements
+	}
+
+	// sqlite only estimates the rows of the tables when they are analyzed, which creates the table sqlite_stat1.
+	var analyzed int
+	if err := in.db.QueryRow(sqliteQueryHasStatistics).Scan(&analyzed); err != nil {
+		return nil, err
+	}
+	rows := "0"
+	if analyzed > 0 {
+		rows = sqliteAnalyzedRows
+	}
+
+	// sqlite does not have enum types, comments, functions nor procedures, and it only keeps its check constraints
+	// in the sql of its tables, so there are no queries for them.
+	cat, err := readSqlCatalog(in.db, sqlQueries{
+		TableNames:  sqliteQueryGetTableNames,
+		Views:       sqliteQueryGetViews,
+		Columns:     sqliteQueryGetColumns,
+		PrimaryKeys: sqliteQueryGetPKs,
+		ForeignKeys: sqliteQueryGetFKs,
+		Uniques:     sqliteQueryGetUniquesColumns,
+		Indexes:     sqliteQueryGetIndexes,
+		Defaults:    sqliteQueryGetColumnsDefaults,
+		Triggers:    sqliteQueryGetTriggers,
+		Sequences:   sequences,
+		Stats:       fmt.Sprintf(sqliteQueryGetTablesStats, rows),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// go-sqlite3 does not report the nullability nor the sizes of the columns, so they are read with pragma_table_xinfo.
+	if err = in.readColumnsInfo(cat); err != nil {
+		return nil, err
+	}
+
+	// sqlite only keeps the whole CREATE TRIGGER statement of the triggers, so their events are read from it.
+	for i := range cat.Routines {
+		cat.Routines[i].Event, err = sqliteTriggerEvent(cat.Routines[i].Body)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return cat, nil
+}
+
+// readColumnsInfo sets the nullability of the columns of the given catalog and the sizes of their declared types.
+func (in *sqliteIntrospector) readColumnsInfo(cat *catalog) error {
+	rows, err := in.db.Query(sqliteQueryGetColumnsInfo)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tableName, colName, declType string
+		var nullable bool
+		if err := rows.Scan(&tableName, &colName, &declType, &nullable); err != nil {
+			return err
+		}
+		position := cat.columnPosition(colName, tableName)
+		if position == 0 {
+			continue
+		}
+		col := &cat.Columns[tableName][position-1]
+		col.Nullable = nullable
+		col.Length, col.Precision, col.Scale = declaredTypeSize(declType)
+	}
+
+	return rows.Err()
+}
+
+// sqliteProfileDialect writes the sampled aggregate queries of the profiling job for sqlite.
+var sqliteProfileDialect = sqlProfileDialect{text: "CAST(%s AS TEXT)", length: "LENGTH(%s)"}
+
+// ProfileColumn reads the first sampleRows rows of the table, as sqlite cannot sample the pages of a table.
+func (in *sqliteIntrospector) ProfileColumn(ctx context.Context, cat *catalog, tableID string, col column,
+	sampleRows int64) (columnProfile, error) {
+	return profileSqlColumn(ctx, in.db, sqliteProfileDialect, fmt.Sprintf("%q", tableID), col,
+		fmt.Sprintf("%q", col.Name), sampleRows)
+}
+
+// sqliteTriggerEvent returns the event of the trigger created by the given CREATE TRIGGER statement.
+func sqliteTriggerEvent(createTrigger string) (string, error) {
+	tokens, err := tokenizeDDL(createTrigger, "sqlite")
+	if err != nil {
+		return "", err
+	}
+	c := &ddlCursor{tokens: tokens}
+	for !c.done() && !c.next().is("TRIGGER") {
+	}
+	c.accept("IF", "NOT", "EXISTS")
+	c.qualifiedName("sqlite")
+	event, _ := triggerEvent(c, "sqlite")
+	return event, nil
+}
+
+var sqliteQueryGetTableNames = `
+	SELECT ''     AS table_schema,
+		   m.name AS table_name
+	FROM   sqlite_master AS m
+	WHERE  m.type = 'table'
+		   AND m.name NOT LIKE 'sqlite_%';
+`
+
+// sqlite only keeps the whole CREATE VIEW statement of the views, so that is their definition.
+var sqliteQueryGetViews = `
+	SELECT ''     AS view_schema,
+		   m.name AS view_name,
+		   'view' AS view_kind,
+		   m.sql  AS view_definition
+	FROM   sqlite_master AS m
+	WHERE  m.type = 'view';
+`
+
+var sqliteQueryGetPKs = `
+	SELECT p.name AS column_name,
+		   m.name AS table_name,
+		   ''     AS constraint_name
+	FROM   sqlite_master AS m
+		   JOIN pragma_table_info(m.name) AS p
+	WHERE  m.type = 'table'
+		   AND m.name NOT LIKE 'sqlite_%'
+		   AND p.pk > 0
+	ORDER  BY m.name,
+			  p.pk;
+`
+
+// sqlite does not keep the names of the foreign keys, so we name them after their table and their id.
+var sqliteQueryGetFKs = `
+	SELECT m.name                 AS origin_table_name,
+		   fk."table"             AS target_table_name,
+		   fk."from"              AS column_name,
+		   fk.on_delete           AS delete_rule,
+		   fk.on_update           AS update_rule,
+		   COALESCE(fk."to", '')  AS target_column_name,
+		   m.name || '_fk_' || fk.id AS constraint_name
+	FROM   sqlite_master AS m
+		   JOIN pragma_foreign_key_list(m.name) AS fk
+	WHERE  m.type = 'table'
+		   AND m.name NOT LIKE 'sqlite_%'
+	ORDER  BY m.name,
+			  fk.id,
+			  fk.seq;
+`
+
+// The INTEGER PRIMARY KEY of a table is its rowid, which cannot be null even without a NOT NULL constraint. The other
+// columns of a primary key can be null in sqlite. The hidden columns of virtual tables are left out.
+var sqliteQueryGetColumnsInfo = `
+	SELECT m.name  AS table_name,
+		   p.name  AS column_name,
+		   p.type  AS column_type,
+		   CASE
+			 WHEN p."notnull" = 1 THEN 0
+			 WHEN p.pk = 1
+				  AND UPPER(p.type) = 'INTEGER'
+				  AND (SELECT COUNT(*)
+					   FROM   pragma_table_info(m.name) AS k
+					   WHERE  k.pk > 0) = 1 THEN 0
+			 ELSE 1
+		   END     AS is_nullable
+	FROM   sqlite_master AS m
+		   JOIN pragma_table_xinfo(m.name) AS p
+	WHERE  m.type IN ( 'table', 'view' )
+		   AND m.name NOT LIKE 'sqlite_%'
+		   AND p.hidden <> 1;
+`
+
+var sqliteQueryGetUniquesColumns = `
+	SELECT DISTINCT m.name  AS table_name,
+					ii.name AS column_name
+	FROM   sqlite_master AS m
+		   JOIN pragma_index_list(m.name) AS il
+		   JOIN pragma_index_info(il.name) AS ii
+	WHERE  m.type = 'table'
+		   AND m.name NOT LIKE 'sqlite_%'
+		   AND il."unique" = 1;
+`
+
+// sqlite indexes are always b-trees. sqlite does not report the expressions of the keys of an index, so they are
+// only marked as expressions, and the predicate of a partial index is taken from the sql of the index.
+var sqliteQueryGetIndexes = `
+	SELECT m.name                                   AS table_name,
+		   il.name                                  AS index_name,
+		   il."unique"                              AS is_unique,
+		   'btree'                                  AS index_method,
+		   CASE
+			 WHEN il.partial = 1 THEN TRIM(SUBSTR(ix.sql, INSTR(UPPER(ix.sql), ' WHERE ') + 7))
+			 ELSE ''
+		   END                                      AS index_predicate,
+		   COALESCE(ii.name, '')                    AS column_name,
+		   CASE ii.cid WHEN -2 THEN 'expression' ELSE '' END AS expression
+	FROM   sqlite_master AS m
+		   JOIN pragma_index_list(m.name) AS il
+		   JOIN pragma_index_xinfo(il.name) AS ii
+		   LEFT JOIN sqlite_master AS ix
+				  ON ix.type = 'index'
+					 AND ix.name = il.name
+	WHERE  m.type = 'table'
+		   AND m.name NOT LIKE 'sqlite_%'
+		   AND ii."key" = 1
+	ORDER  BY m.name,
+			  il.name,
+			  ii.seqno;
+`
+
+// sqlite does not keep the expressions of the generated columns apart, so we only tell whether they are virtual or
+// stored.
+var sqliteQueryGetColumnsDefaults = `
+	SELECT m.name                       AS table_name,
+		   p.name                       AS column_name,
+		   COALESCE(p.dflt_value, '')   AS column_default,
+		   ''                           AS identity_generation,
+		   ''                           AS generation_expression,
+		   CASE p.hidden
+			 WHEN 2 THEN 'VIRTUAL GENERATED'
+			 WHEN 3 THEN 'STORED GENERATED'
+			 ELSE ''
+		   END                          AS extra
+	FROM   sqlite_master AS m
+		   JOIN pragma_table_xinfo(m.name) AS p
+	WHERE  m.type = 'table'
+		   AND m.name NOT LIKE 'sqlite_%'
+		   AND ( p.dflt_value IS NOT NULL
+				  OR p.hidden IN ( 2, 3 ) );
+`
+
+var sqliteQueryGetTriggers = `
+	SELECT ''         AS routine_schema,
+		   m.name     AS routine_name,
+		   'trigger'  AS routine_kind,
+		   ''         AS routine_signature,
+		   ''         AS routine_arguments,
+		   ''         AS return_type,
+		   'sql'      AS routine_language,
+		   m.tbl_name AS table_name,
+		   ''         AS routine_event,
+		   m.sql      AS routine_body
+	FROM   sqlite_master AS m
+	WHERE  m.type = 'trigger'
+	ORDER  BY m.name;
+`
+
+var sqliteQueryHasSequences = `
+	SELECT COUNT(*)
+	FROM   sqlite_master AS m
+	WHERE  m.type = 'table'
+		   AND m.name = 'sqlite_sequence';
+`
+
+// The counters of sqlite are named after their table and they only feed its INTEGER PRIMARY KEY column. A table
+// only gets a counter once a row is inserted in it.
+var sqliteQueryGetAutoIncrements = `
+	SELECT ''               AS sequence_schema,
+		   s.name           AS sequence_name,
+		   'auto_increment' AS sequence_kind,
+		   s.name           AS table_name,
+		   p.name           AS column_name,
+		   p.type           AS data_type,
+		   1                AS increment,
+		   s.seq            AS current_value,
+		   0                AS max_value
+	FROM   sqlite_sequence AS s
+		   JOIN pragma_table_info(s.name) AS p
+	WHERE  p.pk = 1
+	ORDER  BY s.name;
+`
+
+var sqliteQueryHasStatistics = `
+	SELECT COUNT(*)
+	FROM   sqlite_master AS m
+	WHERE  m.type = 'table'
+		   AND m.name = 'sqlite_stat1';
+`
+
+// The rows of a table are only estimated by ANALYZE, so they are 0 until the table is analyzed, and the sizes of the
+// tables are unknown. The row count is given by sqliteAnalyzedRows once the database is analyzed.
+var sqliteQueryGetTablesStats = `
+	SELECT m.name AS table_name,
+		   %s     AS row_count,
+		   0      AS table_size,
+		   0      AS index_size,
+		   ''     AS last_vacuum,
+		   ''     AS last_analyze
+	FROM   sqlite_master AS m
+	WHERE  m.type = 'table'
+		   AND m.name NOT LIKE 'sqlite_%%'
+	ORDER  BY m.name;
+`
+
+// The statistics of every index of a table start with the rows of the index, and the statistics of a table
+// without indexes are its rows, so the rows of a table are the most rows of its statistics.
+var sqliteAnalyzedRows = `COALESCE((SELECT MAX(CAST(s.stat AS INTEGER))
+				 FROM   sqlite_stat1 AS s
+				 WHERE  s.tbl = m.name), 0)`
+
+var sqliteQueryGetColumns = "SELECT * FROM %[2]q LIMIT 0;"
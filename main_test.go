@@ -3,18 +3,25 @@ package main
 import (
 	"context"
 	"database/sql"
+	"flag"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
 	"testing"
 )
 
 // variables that hold the global access to the psql and mysql testing databases.
 var (
-	mysqlTestDb *sql.DB
-	psqlTestDb  *sql.DB
+	mysqlTestDb  *sql.DB
+	psqlTestDb   *sql.DB
+	sqliteTestDb *sql.DB
 )
 
+// sqliteTestDir holds the temporary directory where the sqlite testing database file is created.
+var sqliteTestDir string
+
 // variables that represent the uris to connect to the psql and mysql testing databases.
 var (
 	psqlDatabaseUri  = "user=test password=secret host=localhost port=5432 dbname=%s sslmode=disable"
@@ -39,6 +46,11 @@ const (
 	testMysqlDatabaseHost     = "localhost"
 	testMysqlDatabaseDriver   = "mysql"
 	testMysqlDatabaseSchema   = "test_db"
+
+	// sqlite database constant vars
+	testSqliteDatabaseFile   = "test_db.sqlite"
+	testSqliteDatabaseDriver = "sqlite"
+	testSqliteDatabaseSchema = "main"
 )
 
 func createPsqlConf() *Config {
@@ -96,6 +108,8 @@ func createPsqlDatabase() error {
 		CREATE UNIQUE INDEX product_id_uindex ON product (id);
 	
 		CREATE UNIQUE INDEX product_name_uindex ON product (name);
+
+		CREATE INDEX product_lower_name_index ON product (lower(name));
 	`
 	q4 := `
 		CREATE TABLE order_line 
@@ -110,6 +124,39 @@ func createPsqlDatabase() error {
 		  ); 
 	
 		CREATE UNIQUE INDEX order_line_id_uindex ON order_line (id);
+
+		CREATE INDEX order_line_product_id_index ON order_line USING hash (product_id) WHERE product_id > 0;
+	`
+	q5 := `
+		CREATE SCHEMA billing;
+
+		CREATE TABLE billing."order" 
+		  ( 
+			 id       SERIAL NOT NULL CONSTRAINT billing_order_pk PRIMARY KEY, 
+			 order_id INTEGER NOT NULL CONSTRAINT billing_order_order_id_fk REFERENCES 
+			 public."order" 
+		  ); 
+	
+		-- the names of these objects clash on purpose with the ones of the public schema.
+		CREATE TYPE billing.counting_option AS enum ('box', 'pallet'); 
+
+		CREATE TABLE billing.order_line 
+		  ( 
+			 id              SERIAL NOT NULL CONSTRAINT order_line_pk PRIMARY KEY, 
+			 order_id        INTEGER NOT NULL CONSTRAINT order_line_order_id_fk REFERENCES 
+			 billing."order" ON DELETE CASCADE, 
+			 counting_option billing.counting_option NOT NULL 
+		  ); 
+	`
+	q6 := `
+		CREATE VIEW product_name AS SELECT id, name FROM product;
+
+		CREATE MATERIALIZED VIEW order_line_count AS 
+		  SELECT order_id, count(*) AS lines FROM order_line GROUP BY order_id;
+
+		COMMENT ON TABLE product IS 'The products we sell.';
+
+		COMMENT ON COLUMN product.name IS 'The name of the product.';
 	`
 
 	ctx := context.Background()
@@ -118,7 +165,7 @@ func createPsqlDatabase() error {
 		return err
 	}
 
-	for _, q := range []string{q1, q2, q3, q4} {
+	for _, q := range []string{q1, q2, q3, q4, q5, q6} {
 		_, err = tx.Exec(q)
 		if err != nil {
 			if rollbackErr := tx.Rollback(); rollbackErr != nil {
@@ -196,13 +243,13 @@ func createMysqlDatabase() error {
 		CREATE TABLE product 
 		  ( 
 			 id   BIGINT UNSIGNED AUTO_INCREMENT,
-			 name VARCHAR(200) NOT NULL,
+			 name VARCHAR(200) NOT NULL COMMENT 'The name of the product.',
 			 counting_option ENUM('unit', 'decimal') NOT NULL,
 			 CONSTRAINT product_id_uindex
 					unique (id),
 			 CONSTRAINT product_name_uindex
 					unique (name)
-		  );
+		  ) COMMENT = 'The products we sell.';
 
 		ALTER TABLE product ADD PRIMARY KEY (id);
 	`
@@ -225,6 +272,9 @@ func createMysqlDatabase() error {
 		ALTER TABLE` + " `order_line` " +
 		`ADD PRIMARY KEY (id);
 	`
+	q4 := `
+		CREATE VIEW product_name AS SELECT id, name FROM product;
+	`
 
 	ctx := context.Background()
 	tx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
@@ -232,7 +282,7 @@ func createMysqlDatabase() error {
 		return err
 	}
 
-	for _, q := range []string{q1, q2, q3} {
+	for _, q := range []string{q1, q2, q3, q4} {
 		_, err = tx.Exec(q)
 
 		if err != nil {
@@ -264,43 +314,217 @@ func removeMysqlDatabase() error {
 	return nil
 }
 
-func TestMain(m *testing.M) {
-	var err error
-	err = createPsqlDatabase()
+func createSqliteConf() *Config {
+	return &Config{
+		ServerPort:     0000,
+		DatabaseName:   filepath.Join(sqliteTestDir, testSqliteDatabaseFile),
+		DatabaseDriver: testSqliteDatabaseDriver,
+		DatabaseSchema: testSqliteDatabaseSchema,
+		ForceDelete:    false,
+	}
+}
+
+func createSqliteDatabase() error {
+	dir, err := ioutil.TempDir("", "godic")
 	if err != nil {
-		removePsqlDatabase()
-		log.Fatalln(err)
+		return err
 	}
+	sqliteTestDir = dir
 
-	err = createMysqlDatabase()
+	db, err := sql.Open(sqliteSqlDriver, filepath.Join(sqliteTestDir, testSqliteDatabaseFile))
 	if err != nil {
-		removeMysqlDatabase()
-		log.Fatalln(err)
+		return err
+	}
+	defer db.Close()
+
+	q1 := `
+		CREATE TABLE "order"
+		  (
+			 id INTEGER NOT NULL CONSTRAINT order_pk PRIMARY KEY
+		  );
+
+		CREATE UNIQUE INDEX order_id_uindex
+		  ON "order" (id);
+	`
+	q2 := `
+		CREATE TABLE product
+		  (
+			 id              INTEGER NOT NULL CONSTRAINT product_pk PRIMARY KEY,
+			 name            VARCHAR(200) NOT NULL,
+			 counting_option TEXT NOT NULL DEFAULT 'unit' CHECK (counting_option IN ('unit', 'decimal'))
+		  );
+
+		CREATE UNIQUE INDEX product_id_uindex ON product (id);
+
+		CREATE UNIQUE INDEX product_name_uindex ON product (name);
+
+		CREATE INDEX product_lower_name_index ON product (lower(name));
+	`
+	q3 := `
+		CREATE TABLE order_line
+		  (
+			 id         INTEGER NOT NULL CONSTRAINT order_line_pk PRIMARY KEY,
+			 order_id   INTEGER NOT NULL CONSTRAINT order_line_order_id_fk REFERENCES
+			 "order" (id)
+			 ON DELETE RESTRICT,
+			 product_id INTEGER NOT NULL CONSTRAINT order_line_product_id_fk REFERENCES
+			 product (id) ON DELETE
+			 RESTRICT
+		  );
+
+		CREATE UNIQUE INDEX order_line_id_uindex ON order_line (id);
+
+		CREATE INDEX order_line_product_id_index ON order_line (product_id) WHERE product_id > 0;
+	`
+	q4 := `
+		CREATE VIEW product_name AS SELECT id, name FROM product;
+	`
+
+	ctx := context.Background()
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, q := range []string{q1, q2, q3, q4} {
+		_, err = tx.Exec(q)
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				return err
+			}
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func removeSqliteDatabase() error {
+	return os.RemoveAll(sqliteTestDir)
+}
+
+// testIndex returns a b-tree index with the given name on the given columns.
+func testIndex(name string, unique bool, cols ...string) tableIndex {
+	return tableIndex{Name: name, Keys: columnKeys(cols), Unique: unique, Method: "btree"}
+}
+
+// readTestCatalog reads the catalog of the given testing database with the Introspector registered for the
+// driver of the given *Config.
+func readTestCatalog(t *testing.T, db *sql.DB, conf *Config) *catalog {
+	t.Helper()
+	introspector, err := introspectors[conf.DatabaseDriver].newIntrospector(db, conf)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the introspector; got %s", err)
+	}
+	cat, err := introspector.Catalog()
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when reading the catalog; got %s", err)
+	}
+	return cat
+}
+
+// skipWithoutPsql skips the calling test when the postgres testing database is not created by TestMain in short mode.
+func skipWithoutPsql(t *testing.T) {
+	t.Helper()
+	if psqlTestDb == nil {
+		t.Skip("the postgres tests are skipped in short mode")
+	}
+}
+
+// skipWithoutMysql skips the calling test when the mysql testing database is not created by TestMain in short mode.
+func skipWithoutMysql(t *testing.T) {
+	t.Helper()
+	if mysqlTestDb == nil {
+		t.Skip("the mysql tests are skipped in short mode")
+	}
+}
+
+// pingTestServer checks whether the testing server of the given sql driver can be reached with the given uri.
+func pingTestServer(driver string, uri string) error {
+	db, err := sql.Open(driver, uri)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	return db.Ping()
+}
+
+// The postgres and mysql testing servers must be reachable, unless the tests are run with -short, which skips their
+// tests so the sqlite and the dump tests can run without them.
+func TestMain(m *testing.M) {
+	flag.Parse()
+
+	var err error
+	// The http handlers log their errors.
+	_logger = log.New(os.Stderr, "Error Logger:\t", log.Ldate|log.Ltime|log.Lshortfile)
+
+	if testing.Short() {
+		log.Println("skipping the postgres and mysql tests in short mode")
+	} else {
+		if err = pingTestServer("postgres", fmt.Sprintf(psqlDatabaseUri, "postgres")); err != nil {
+			log.Fatalf("the postgres testing server cannot be reached, run the tests with -short to skip them: %s", err)
+		}
+		if err = pingTestServer("mysql", fmt.Sprintf(mysqlDatabaseUri, "mysql")); err != nil {
+			log.Fatalf("the mysql testing server cannot be reached, run the tests with -short to skip them: %s", err)
+		}
+
+		err = createPsqlDatabase()
+		if err != nil {
+			removePsqlDatabase()
+			log.Fatalln(err)
+		}
+		psqlDB, err := sql.Open("postgres", fmt.Sprintf(psqlDatabaseUri, testPsqlDatabaseName))
+		if err != nil {
+			log.Fatalln(err)
+		}
+		psqlTestDb = psqlDB
+
+		err = createMysqlDatabase()
+		if err != nil {
+			removeMysqlDatabase()
+			log.Fatalln(err)
+		}
+		mysqlDB, err := sql.Open("mysql", fmt.Sprintf(mysqlDatabaseUri, testMysqlDatabaseName))
+		if err != nil {
+			log.Fatalln(err)
+		}
+		mysqlTestDb = mysqlDB
 	}
 
-	psqlDB, err := sql.Open("postgres", fmt.Sprintf(psqlDatabaseUri, testPsqlDatabaseName))
+	err = createSqliteDatabase()
 	if err != nil {
+		removeSqliteDatabase()
 		log.Fatalln(err)
 	}
-	psqlTestDb = psqlDB
 
-	mysqlDB, err := sql.Open("mysql", fmt.Sprintf(mysqlDatabaseUri, testMysqlDatabaseName))
+	sqliteDB, err := sql.Open(sqliteSqlDriver, filepath.Join(sqliteTestDir, testSqliteDatabaseFile))
 	if err != nil {
 		log.Fatalln(err)
 	}
-	mysqlTestDb = mysqlDB
+	sqliteTestDb = sqliteDB
 
 	code := m.Run()
 
-	psqlTestDb.Close()
-	mysqlTestDb.Close()
+	if psqlTestDb != nil {
+		psqlTestDb.Close()
+		if err = removePsqlDatabase(); err != nil {
+			log.Println(err)
+		}
+	}
 
-	err = removePsqlDatabase()
-	if err != nil {
-		log.Println(err)
+	if mysqlTestDb != nil {
+		mysqlTestDb.Close()
+		if err = removeMysqlDatabase(); err != nil {
+			log.Println(err)
+		}
 	}
 
-	err = removeMysqlDatabase()
+	sqliteTestDb.Close()
+	err = removeSqliteDatabase()
 	if err != nil {
 		log.Println(err)
 	}
@@ -1,7 +1,11 @@
 package main
 
 import (
+	"fmt"
 	"github.com/pkg/errors"
+	"sort"
+	"strings"
+	"time"
 )
 
 // databaseInfo holds general information about the database.
@@ -15,11 +19,127 @@ type databaseInfo struct {
 	Schema   string `json:"schema"`
 }
 
-// table represents a table in database.
+// Kinds of the relations documented by godic.
+const (
+	tableKind            = "table"
+	viewKind             = "view"
+	materializedViewKind = "materialized view"
+)
+
+// table represents a table in database. Views and materialized views are documented as tables of their
+// own kind and they carry the sql of their definition.
 type table struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
+	ID           string            `json:"id"`
+	Schema       string            `json:"schema"`
+	Name         string            `json:"name"`
+	Kind         string            `json:"kind"`
+	Definition   string            `json:"definition,omitempty"`
+	Description  string            `json:"description"`
+	PrimaryKey   *keyConstraint    `json:"primary_key"`
+	ForeignKeys  []keyConstraint   `json:"foreign_keys"`
+	Indexes      []tableIndex      `json:"indexes"`
+	Checks       []checkConstraint `json:"checks"`
+	PartitionKey string            `json:"partition_key,omitempty"`
+	Partitions   []tablePartition  `json:"partitions,omitempty"`
+	Inherits     []string          `json:"inherits,omitempty"`
+	Charset      string            `json:"charset,omitempty"`
+	Collation    string            `json:"collation,omitempty"`
+	Profiling    bool              `json:"profiling"`
+}
+
+// tablePartition holds a partition of a partitioned table. Bound is the definition of the values that go into
+// the partition, e.g. FOR VALUES FROM ('2020-01-01') TO ('2020-02-01'), and it is empty for hash partitions of
+// mysql tables.
+type tablePartition struct {
+	Name  string `json:"name"`
+	Bound string `json:"bound"`
+}
+
+// keyConstraint holds a primary key or a foreign key constraint of a table. The columns of a key are kept in
+// the order given in the constraint, so the nth column of a foreign key references the nth target column.
+type keyConstraint struct {
+	Name          string   `json:"name"`
+	Columns       []string `json:"columns"`
+	TargetTable   string   `json:"target_table,omitempty"`
+	TargetColumns []string `json:"target_columns,omitempty"`
+	DeleteRule    string   `json:"delete_rule,omitempty"`
+	UpdateRule    string   `json:"update_rule,omitempty"`
+}
+
+// String returns a readable definition of the key, e.g. (order_id) -> public.order (id) ON DELETE RESTRICT.
+func (k *keyConstraint) String() string {
+	if k == nil {
+		return "none"
+	}
+	s := fmt.Sprintf("%s (%s)", k.Name, strings.Join(k.Columns, ", "))
+	if k.TargetTable != "" {
+		s += fmt.Sprintf(" -> %s (%s) ON DELETE %s ON UPDATE %s", k.TargetTable, strings.Join(k.TargetColumns, ", "),
+			k.DeleteRule, k.UpdateRule)
+	}
+	return s
+}
+
+// tableIndex holds an index of a table. The keys of an index are kept in the order given in its definition.
+// Predicate is the condition of a partial index.
+type tableIndex struct {
+	Name      string     `json:"name"`
+	Keys      []indexKey `json:"keys"`
+	Unique    bool       `json:"unique"`
+	Method    string     `json:"method"`
+	Predicate string     `json:"predicate,omitempty"`
+}
+
+// indexKey holds a key of an index, which is either a column or an expression.
+type indexKey struct {
+	Column     string `json:"column,omitempty"`
+	Expression string `json:"expression,omitempty"`
+}
+
+// String returns the column or the expression of the key.
+func (k indexKey) String() string {
+	if k.Column != "" {
+		return k.Column
+	}
+	return "(" + k.Expression + ")"
+}
+
+// String returns a readable definition of the index, e.g. order_line_id_uindex UNIQUE btree (id).
+func (i tableIndex) String() string {
+	keys := make([]string, len(i.Keys))
+	for j := range i.Keys {
+		keys[j] = i.Keys[j].String()
+	}
+	s := i.Name
+	if i.Unique {
+		s += " UNIQUE"
+	}
+	s += fmt.Sprintf(" %s (%s)", i.Method, strings.Join(keys, ", "))
+	if i.Predicate != "" {
+		s += " WHERE " + i.Predicate
+	}
+	return s
+}
+
+// checkConstraint holds a check constraint of a table together with the columns referenced by its clause.
+type checkConstraint struct {
+	Name    string   `json:"name"`
+	Clause  string   `json:"clause"`
+	Columns []string `json:"columns"`
+}
+
+// String returns a readable definition of the check constraint, e.g. product_price_check CHECK (price >= 0).
+func (c checkConstraint) String() string {
+	return fmt.Sprintf("%s CHECK (%s)", c.Name, c.Clause)
+}
+
+// tableID returns the id of the table with the given name in the given schema.
+// Tables of database engines that are not read by schema, like mysql or sqlite, have an empty schema and
+// their id is just their name.
+func tableID(schema string, name string) string {
+	if schema == "" {
+		return name
+	}
+	return schema + "." + name
 }
 
 // Tables is a collection of tables.
@@ -30,46 +150,299 @@ func (t Tables) count() int {
 	return len(t)
 }
 
-// get will the get the table with the given name.
+// get will the get the table with the given id.
 // If the table does not exist get() will return an error.
-func (t Tables) get(tableName string) (table, error) {
+func (t Tables) get(tableID string) (table, error) {
 	for i := range t {
-		if t[i].Name == tableName {
+		if t[i].ID == tableID {
 			return t[i], nil
 		}
 	}
-	return table{}, errors.Errorf("there is no table with the given name %s", tableName)
+	return table{}, errors.Errorf("there is no table with the given id %s", tableID)
 }
 
-// exists checks whether a table with the given tableName exists or not.
-func (t Tables) exists(tableName string) bool {
+// exists checks whether a table with the given tableID exists or not.
+func (t Tables) exists(tableID string) bool {
 	for i := range t {
-		if t[i].Name == tableName {
+		if t[i].ID == tableID {
 			return true
 		}
 	}
 	return false
 }
 
-// colMetadata holds metadata about a column in a table from the database.
+// Kinds of the routines documented by godic.
+const (
+	functionKind  = "function"
+	procedureKind = "procedure"
+	triggerKind   = "trigger"
+)
+
+// routine represents a function, a stored procedure or a trigger of the database. Table and Event are only set
+// for triggers, e.g. public.product and BEFORE INSERT OR UPDATE FOR EACH ROW. The body of a routine is not stored,
+// only its hash, which is enough to tell when the routine has been changed.
+type routine struct {
+	ID          string `json:"id"`
+	Schema      string `json:"schema"`
+	Name        string `json:"name"`
+	Kind        string `json:"kind"`
+	Arguments   string `json:"arguments"`
+	ReturnType  string `json:"return_type"`
+	Language    string `json:"language"`
+	Table       string `json:"table,omitempty"`
+	Event       string `json:"event,omitempty"`
+	BodyHash    string `json:"body_hash"`
+	Description string `json:"description"`
+}
+
+// routineID returns the id of a routine with the given name in the given schema.
+// Triggers are identified by the table they fire on, because postgres only requires the names of the triggers to
+// be unique by table, and postgres functions and procedures by their signature, because they can be overloaded.
+func routineID(schema string, name string, kind string, table string, signature string) string {
+	id := tableID(schema, name)
+	if kind == triggerKind {
+		return id + " ON " + table
+	}
+	if signature != "" {
+		return id + "(" + signature + ")"
+	}
+	return id
+}
+
+// Routines is a collection of routines.
+type Routines []routine
+
+// get will get the routine with the given id.
+// If the routine does not exist get() will return an error.
+func (rs Routines) get(routineID string) (routine, error) {
+	for i := range rs {
+		if rs[i].ID == routineID {
+			return rs[i], nil
+		}
+	}
+	return routine{}, errors.Errorf("there is no routine with the given id %s", routineID)
+}
+
+// exists checks whether a routine with the given routineID exists or not.
+func (rs Routines) exists(routineID string) bool {
+	for i := range rs {
+		if rs[i].ID == routineID {
+			return true
+		}
+	}
+	return false
+}
+
+// Kinds of the user defined types documented by godic. Arrays are not documented as types of their own, but the
+// columns typed with an array are resolved to the type of their elements.
+const (
+	enumTypeKind      = "enum"
+	domainTypeKind    = "domain"
+	compositeTypeKind = "composite"
+	rangeTypeKind     = "range"
+	arrayTypeKind     = "array"
+)
+
+// dataType represents a user defined type of the database: an enum, a domain, a composite type or a range type.
+// BaseType is the type a domain is based on or the subtype of a range. NotNull, Default and Checks are the
+// constraints of a domain, Values the labels of an enum and Attributes the attributes of a composite type.
+type dataType struct {
+	ID          string            `json:"id"`
+	Schema      string            `json:"schema"`
+	Name        string            `json:"name"`
+	Kind        string            `json:"kind"`
+	BaseType    string            `json:"base_type,omitempty"`
+	NotNull     bool              `json:"not_null,omitempty"`
+	Default     string            `json:"default,omitempty"`
+	Checks      []checkConstraint `json:"checks,omitempty"`
+	Values      []string          `json:"values,omitempty"`
+	Attributes  []typeAttribute   `json:"attributes,omitempty"`
+	Description string            `json:"description"`
+}
+
+// typeAttribute holds an attribute of a composite type.
+type typeAttribute struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// DataTypes is a collection of user defined types.
+type DataTypes []dataType
+
+// get will get the user defined type with the given id.
+// If the type does not exist get() will return an error.
+func (ts DataTypes) get(typeID string) (dataType, error) {
+	for i := range ts {
+		if ts[i].ID == typeID {
+			return ts[i], nil
+		}
+	}
+	return dataType{}, errors.Errorf("there is no type with the given id %s", typeID)
+}
+
+// exists checks whether a user defined type with the given typeID exists or not.
+func (ts DataTypes) exists(typeID string) bool {
+	for i := range ts {
+		if ts[i].ID == typeID {
+			return true
+		}
+	}
+	return false
+}
+
+// Kinds of the sequences documented by godic. The auto increment counters of mysql and sqlite tables are
+// documented as sequences of their own kind.
+const (
+	sequenceKind      = "sequence"
+	autoIncrementKind = "auto_increment"
+)
+
+// sequenceUsageWarning is the usage of a sequence, in percent, from which it is reported as close to exhaustion.
+const sequenceUsageWarning = 75.0
+
+// sequence represents a postgres sequence or the auto increment counter of a table, together with the column it
+// feeds, if any. MaxValue is the largest value the sequence can give, which is the smallest of the maximum value
+// of the sequence and the largest value the column it feeds can hold, and Usage is the percentage of it already
+// given by the sequence, rounded to two decimals. Sequences are read live from the database, as their current value changes all the time.
+type sequence struct {
+	ID           string  `json:"id"`
+	Schema       string  `json:"schema"`
+	Name         string  `json:"name"`
+	Kind         string  `json:"kind"`
+	Table        string  `json:"table"`
+	Column       string  `json:"column"`
+	DataType     string  `json:"data_type"`
+	Increment    int64   `json:"increment"`
+	CurrentValue int64   `json:"current_value"`
+	MaxValue     uint64  `json:"max_value"`
+	Usage        float64 `json:"usage"`
+	Warning      bool    `json:"warning"`
+}
+
+// Sequences is a collection of sequences.
+type Sequences []sequence
+
+// tableStats holds the statistics of a table taken when the database is synced. The rows of postgres and mysql
+// tables are the estimate kept by the database, the rows of sqlite tables are the estimate of their last ANALYZE,
+// and the sizes are in bytes. LastVacuum and LastAnalyze are only
+// known for postgres tables, and they are empty while the table has not been vacuumed or analyzed.
+type tableStats struct {
+	Time        time.Time `json:"time"`
+	Rows        int64     `json:"rows"`
+	TableSize   int64     `json:"table_size"`
+	IndexSize   int64     `json:"index_size"`
+	LastVacuum  string    `json:"last_vacuum,omitempty"`
+	LastAnalyze string    `json:"last_analyze,omitempty"`
+}
+
+// tableStatsSeries holds the statistics of a table taken on every sync, ordered by time.
+type tableStatsSeries struct {
+	Table   string       `json:"table"`
+	Samples []tableStats `json:"samples"`
+}
+
+// TablesStats is a collection of time series of statistics of tables.
+type TablesStats []tableStatsSeries
+
+// colMetadata holds metadata about a column in a table from the database. Position is the ordinal position of
+// the column in its table, starting at 1.
 type colMetadata struct {
-	ID            string   `json:"id"`
-	Name          string   `json:"name"`
-	DBType        string   `json:"db_type"`
-	Nullable      bool     `json:"nullable"`
-	GoType        string   `json:"go_type"`
-	Length        int64    `json:"length"`
-	TBName        string   `json:"table_name"`
-	Description   string   `json:"description"`
-	IsPrimaryKey  bool     `json:"is_primary_key"`
-	IsForeignKey  bool     `json:"is_foreign_key"`
-	TargetTableFK string   `json:"target_table_fk"`
-	DeleteRule    string   `json:"delete_rule"`
-	UpdateRule    string   `json:"update_rule"`
-	HasENUM       bool     `json:"has_enum"`
-	ENUMName      string   `json:"enum_name"`
-	ENUMValues    []string `json:"enum_values"`
-	IsUnique      bool     `json:"is_unique"`
+	ID            string         `json:"id"`
+	Name          string         `json:"name"`
+	Position      int            `json:"position"`
+	DBType        string         `json:"db_type"`
+	Nullable      bool           `json:"nullable"`
+	GoType        string         `json:"go_type"`
+	Length        int64          `json:"length"`
+	Precision     int64          `json:"precision"`
+	Scale         int64          `json:"scale"`
+	TBName        string         `json:"table_name"`
+	Description   string         `json:"description"`
+	IsPrimaryKey  bool           `json:"is_primary_key"`
+	IsForeignKey  bool           `json:"is_foreign_key"`
+	TargetTableFK string         `json:"target_table_fk"`
+	TargetColFK   string         `json:"target_col_fk"`
+	DeleteRule    string         `json:"delete_rule"`
+	UpdateRule    string         `json:"update_rule"`
+	HasENUM       bool           `json:"has_enum"`
+	ENUMName      string         `json:"enum_name"`
+	ENUMValues    []string       `json:"enum_values"`
+	IsUnique      bool           `json:"is_unique"`
+	Default       string         `json:"default"`
+	Identity      string         `json:"identity"`
+	Generation    string         `json:"generation_expression"`
+	Extra         string         `json:"extra"`
+	TypeKind      string         `json:"type_kind,omitempty"`
+	UserType      string         `json:"user_type,omitempty"`
+	BaseType      string         `json:"base_type,omitempty"`
+	DomainChecks  []string       `json:"domain_checks,omitempty"`
+	Charset       string         `json:"charset,omitempty"`
+	Collation     string         `json:"collation,omitempty"`
+	Profile       *columnProfile `json:"profile,omitempty"`
+}
+
+// columnProfile holds the statistics of the values of a column computed by the profiling job from a sample of the
+// rows of its table. Min, Max and the values of TopValues are given as text whatever the type of the column is,
+// and AvgLength is only computed for the columns that are not numbers nor times.
+type columnProfile struct {
+	Time          time.Time    `json:"time"`
+	SampledRows   int64        `json:"sampled_rows"`
+	NullFraction  float64      `json:"null_fraction"`
+	DistinctCount int64        `json:"distinct_count"`
+	Min           string       `json:"min"`
+	Max           string       `json:"max"`
+	AvgLength     float64      `json:"avg_length"`
+	TopValues     []valueCount `json:"top_values"`
+}
+
+// valueCount holds a value of a column and the number of sampled rows having it.
+type valueCount struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
+// legacy checks whether the column was stored by a previous version of godic, which did not record all the
+// metadata of the columns: the default, the identity and the generation, the type kind, the decimal sizes, the
+// target column of a foreign key, the character set, the collation and the position. The position is the last
+// metadata recorded, so only these columns do not have one. The empty metadata of a legacy column is only not
+// recorded yet, so compareColumnMetadata does not report it as a change, movedColumns does not move the column and
+// getUnreportedColumnChanges syncs it silently.
+func (c colMetadata) legacy() bool {
+	return c.Position == 0
+}
+
+// setStructure copies the structural metadata of the given col, as it is read from the database, onto the column.
+// The data authored by the users, like the description of the column, and the profile of the column are kept.
+func (c *colMetadata) setStructure(col colMetadata) {
+	c.Name = col.Name
+	c.Position = col.Position
+	c.DBType = col.DBType
+	c.Nullable = col.Nullable
+	c.GoType = col.GoType
+	c.Length = col.Length
+	c.Precision = col.Precision
+	c.Scale = col.Scale
+	c.TBName = col.TBName
+	c.IsPrimaryKey = col.IsPrimaryKey
+	c.IsForeignKey = col.IsForeignKey
+	c.TargetTableFK = col.TargetTableFK
+	c.TargetColFK = col.TargetColFK
+	c.DeleteRule = col.DeleteRule
+	c.UpdateRule = col.UpdateRule
+	c.HasENUM = col.HasENUM
+	c.ENUMName = col.ENUMName
+	c.ENUMValues = col.ENUMValues
+	c.IsUnique = col.IsUnique
+	c.Default = col.Default
+	c.Identity = col.Identity
+	c.Generation = col.Generation
+	c.Extra = col.Extra
+	c.TypeKind = col.TypeKind
+	c.UserType = col.UserType
+	c.BaseType = col.BaseType
+	c.DomainChecks = col.DomainChecks
+	c.Charset = col.Charset
+	c.Collation = col.Collation
 }
 
 // ColumnsMetadata is a collection of colMetadata.
@@ -99,7 +472,7 @@ func (cols ColumnsMetadata) getByColumnID(columnID string) (colMetadata, error)
 
 // getAllColumnsFromTable will get all the columns metadata from the given tableName.
 // If the table does not exist getAllColumnsFromTable will return an empty ColumnsMetadata slice.
-// The returning ColumnsMetadata will not contained sorted columns.
+// The returning ColumnsMetadata will contain the columns sorted by their position.
 func (cols ColumnsMetadata) getAllColumnsFromTable(tableName string) ColumnsMetadata {
 	tableCols := make(ColumnsMetadata, 0)
 	for _, c := range cols {
@@ -107,13 +480,32 @@ func (cols ColumnsMetadata) getAllColumnsFromTable(tableName string) ColumnsMeta
 			tableCols = append(tableCols, c)
 		}
 	}
+	tableCols.sort()
 	return tableCols
 }
 
-// primaryKey holds information about a primary key.
+// sort sorts the columns by table and then by position. The legacy columns do not have a position, see
+// colMetadata.legacy, so they are sorted by name after the others.
+func (cols ColumnsMetadata) sort() {
+	sort.SliceStable(cols, func(i, j int) bool {
+		if cols[i].TBName != cols[j].TBName {
+			return cols[i].TBName < cols[j].TBName
+		}
+		if (cols[i].Position == 0) != (cols[j].Position == 0) {
+			return cols[j].Position == 0
+		}
+		if cols[i].Position != cols[j].Position {
+			return cols[i].Position < cols[j].Position
+		}
+		return cols[i].Name < cols[j].Name
+	})
+}
+
+// primaryKey holds information about a column of a primary key.
 type primaryKey struct {
 	Table string
 	Col   string
+	Name  string
 }
 
 // PrimaryKeys is a collection of primary keys.
@@ -141,13 +533,17 @@ func (pks PrimaryKeys) get(colName string, tableName string) (primaryKey, error)
 	return primaryKey{}, errors.Errorf("primary key with name %s does not exist", colName)
 }
 
-// foreignKey holds information about a foreign key.
+// foreignKey holds information about a column of a foreign key.
+// An empty TargetCol means that the column references the column of the primary key of TargetTable in the
+// same position.
 type foreignKey struct {
 	Table       string
 	TargetTable string
 	Col         string
 	DeleteRule  string
 	UpdateRule  string
+	TargetCol   string
+	Name        string
 }
 
 // ForeignKeys is a collection of foreign keys.
@@ -237,6 +633,274 @@ func (ucs UniqueCols) get(colName string, tableName string) (uniqueCol, error) {
 		"given table %s.", colName, tableName)
 }
 
+// indexColumn holds a key of an index as it is read from the database. Every key of an index repeats the
+// information of the index, and the keys are read in the order given in the definition of the index.
+type indexColumn struct {
+	Table      string
+	Name       string
+	Unique     bool
+	Method     string
+	Predicate  string
+	Col        string
+	Expression string
+}
+
+// IndexColumns is a collection of index keys.
+type IndexColumns []indexColumn
+
+// exists checks whether an index with the given indexName exists or not in the given tableName.
+func (ics IndexColumns) exists(indexName string, tableName string) bool {
+	for i := range ics {
+		if ics[i].Name == indexName && ics[i].Table == tableName {
+			return true
+		}
+	}
+	return false
+}
+
+// checkColumn holds a column referenced by a check constraint as it is read from the database. Every column of
+// a check constraint repeats the clause of the constraint. Check constraints that do not reference any column are
+// read with an empty Col.
+type checkColumn struct {
+	Table  string
+	Name   string
+	Clause string
+	Col    string
+}
+
+// CheckColumns is a collection of columns of check constraints.
+type CheckColumns []checkColumn
+
+// exists checks whether a check constraint with the given checkName exists or not in the given tableName.
+func (ccs CheckColumns) exists(checkName string, tableName string) bool {
+	for i := range ccs {
+		if ccs[i].Name == checkName && ccs[i].Table == tableName {
+			return true
+		}
+	}
+	return false
+}
+
+// colDefault holds the default value of a column and how its values are generated by the database.
+// Identity is the generation of an identity column (ALWAYS or BY DEFAULT), Generation is the expression of a
+// generated column and Extra holds any other information given by the database, e.g. the mysql auto_increment.
+type colDefault struct {
+	Table      string
+	Col        string
+	Default    string
+	Identity   string
+	Generation string
+	Extra      string
+}
+
+// ColumnsDefaults is a collection of columns with their defaults.
+type ColumnsDefaults []colDefault
+
+// exists checks whether the column with the given colName in the given tableName has a default or not.
+func (cds ColumnsDefaults) exists(colName string, tableName string) bool {
+	for i := range cds {
+		if cds[i].Col == colName && cds[i].Table == tableName {
+			return true
+		}
+	}
+	return false
+}
+
+// get will get the default of the column with the given colName from the given tableName.
+// If the column does not have a default get() will return an error.
+func (cds ColumnsDefaults) get(colName string, tableName string) (colDefault, error) {
+	for i := range cds {
+		if cds[i].Col == colName && cds[i].Table == tableName {
+			return cds[i], nil
+		}
+	}
+	return colDefault{}, errors.Errorf("there is no column %s in table %s with a default.", colName, tableName)
+}
+
+// dbComment holds the comment written in the database for a table or, when Col is not empty, for a column.
+type dbComment struct {
+	Table   string
+	Col     string
+	Comment string
+}
+
+// Comments is a collection of comments written in the database.
+type Comments []dbComment
+
+// get will get the comment of the column with the given colName in the given tableName.
+// The comment of the table itself has an empty colName. If there is no comment get() will return an error.
+func (cs Comments) get(colName string, tableName string) (dbComment, error) {
+	for i := range cs {
+		if cs[i].Col == colName && cs[i].Table == tableName {
+			return cs[i], nil
+		}
+	}
+	return dbComment{}, errors.Errorf("there is no comment for column %s in table %s.", colName, tableName)
+}
+
+// dbPartition holds a partitioned table as it is read from the database, together with one of its partitions.
+// Key is the partition key of the table, e.g. RANGE (created_at), and Partition is empty for a partitioned table
+// without partitions.
+type dbPartition struct {
+	Table     string
+	Key       string
+	Partition string
+	Bound     string
+}
+
+// DBPartitions is a collection of partitioned tables read from the database.
+type DBPartitions []dbPartition
+
+// dbInheritance holds a table that inherits from the Parent table, as it is read from the database.
+type dbInheritance struct {
+	Table  string
+	Parent string
+}
+
+// DBInheritances is a collection of tables that inherit from other tables read from the database.
+type DBInheritances []dbInheritance
+
+// dbRoutine holds a function, a stored procedure or a trigger as it is read from the database.
+// Signature is what tells apart the overloaded functions of postgres, and it is empty for any other database engine.
+type dbRoutine struct {
+	Schema     string
+	Name       string
+	Kind       string
+	Signature  string
+	Arguments  string
+	ReturnType string
+	Language   string
+	Table      string
+	Event      string
+	Body       string
+}
+
+// DBRoutines is a collection of routines read from the database.
+type DBRoutines []dbRoutine
+
+// dbDataType holds a user defined type as it is read from the database, together with one of its items: a label of
+// an enum, a check constraint of a domain (Item is its name and Definition its clause) or an attribute of a
+// composite type (Item is its name and Definition its type).
+type dbDataType struct {
+	Schema     string
+	Name       string
+	Kind       string
+	BaseType   string
+	NotNull    bool
+	Default    string
+	Item       string
+	Definition string
+}
+
+// DBDataTypes is a collection of user defined types read from the database.
+type DBDataTypes []dbDataType
+
+// colType holds a column typed with a domain, a composite type, a range type or an array. UserType is the id of
+// the user defined type of the column and it is empty for arrays and for the built-in types.
+// BaseType is the type a domain is based on, the subtype of a range or the type of the elements of an array.
+type colType struct {
+	Table    string
+	Col      string
+	Kind     string
+	UserType string
+	BaseType string
+}
+
+// ColumnsTypes is a collection of columns with their resolved types.
+type ColumnsTypes []colType
+
+// get will get the resolved type of the column with the given colName from the given tableName.
+// If the type of the column is not resolved get() will return an error.
+func (cts ColumnsTypes) get(colName string, tableName string) (colType, error) {
+	for i := range cts {
+		if cts[i].Col == colName && cts[i].Table == tableName {
+			return cts[i], nil
+		}
+	}
+	return colType{}, errors.Errorf("there is no resolved type for column %s in table %s.", colName, tableName)
+}
+
+// dbCollation holds the character set and the collation of a column as they are read from the database, or of a
+// table when Col is empty. Database engines without character sets per column, like postgres, have an empty Charset.
+type dbCollation struct {
+	Table     string
+	Col       string
+	Charset   string
+	Collation string
+}
+
+// DBCollations is a collection of character sets and collations of columns and tables read from the database.
+type DBCollations []dbCollation
+
+// get will get the character set and the collation of the column with the given colName from the given tableName,
+// or of the table itself when colName is empty. If there is none get() will return an error.
+func (cs DBCollations) get(colName string, tableName string) (dbCollation, error) {
+	for i := range cs {
+		if cs[i].Col == colName && cs[i].Table == tableName {
+			return cs[i], nil
+		}
+	}
+	return dbCollation{}, errors.Errorf("there is no collation for column %s in table %s.", colName, tableName)
+}
+
+// dbSequence holds a sequence or an auto increment counter as it is read from the database. CurrentValue is the
+// last value given by the sequence, and a MaxValue of 0 means that the sequence can give any value its column holds.
+type dbSequence struct {
+	Schema       string
+	Name         string
+	Kind         string
+	Table        string
+	Col          string
+	DataType     string
+	Increment    int64
+	CurrentValue int64
+	MaxValue     uint64
+}
+
+// DBSequences is a collection of sequences read from the database.
+type DBSequences []dbSequence
+
+// dbTableStats holds the statistics of a table as they are read from the database.
+type dbTableStats struct {
+	Table       string
+	Rows        int64
+	TableSize   int64
+	IndexSize   int64
+	LastVacuum  string
+	LastAnalyze string
+}
+
+// DBTablesStats is a collection of statistics of tables read from the database.
+type DBTablesStats []dbTableStats
+
+// descriptionComment holds the comment written in the database for a stored table or column together with its
+// stored description. The Column and the ColumnID are empty for the comments of tables.
+type descriptionComment struct {
+	Table       string `json:"table"`
+	Column      string `json:"column"`
+	ColumnID    string `json:"column_id"`
+	Description string `json:"description"`
+	Comment     string `json:"comment"`
+}
+
+// tableChanges holds the table metadata of a table that has changed and it carries the changes as a message.
+type tableChanges struct {
+	table          `json:"metadata"`
+	ChangesMessage string `json:"changes_message"`
+}
+
+// routineChanges holds the metadata of a routine that has changed and it carries the changes as a message.
+type routineChanges struct {
+	routine        `json:"metadata"`
+	ChangesMessage string `json:"changes_message"`
+}
+
+// dataTypeChanges holds the metadata of a user defined type that has changed and it carries the changes as a message.
+type dataTypeChanges struct {
+	dataType       `json:"metadata"`
+	ChangesMessage string `json:"changes_message"`
+}
+
 // columnChanges holds the column metadata of a column that has changed and it carries the changes as a message.
 type columnChanges struct {
 	colMetadata    `json:"metadata"`
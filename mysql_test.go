@@ -1,24 +1,22 @@
 package main
 
 import (
+	"context"
 	"database/sql"
+	"math"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"sort"
+	"strings"
 	"testing"
 )
 
-func Test_getTableNames_helper_func_for_mysql_db(t *testing.T) {
-	originalDB := DB
-	DB = mysqlTestDb
-	defer func(original *sql.DB) {
-		DB = original
-	}(originalDB)
-
+func Test_catalog_table_names_for_mysql_db(t *testing.T) {
+	skipWithoutMysql(t)
 	conf := createMysqlConf()
 
-	tables, err := getTableNames(conf)
-	if err != nil {
-		t.Fatalf("we shouldn't get an error from getTableNames")
-	}
+	tables := readTestCatalog(t, mysqlTestDb, conf).Tables
 
 	expectedTables := []string{"order", "product", "order_line"}
 
@@ -36,19 +34,12 @@ func Test_getTableNames_helper_func_for_mysql_db(t *testing.T) {
 	}
 }
 
-func Test_getTableColumns_helpers_func_for_mysql_db(t *testing.T) {
-	originalDB := DB
-	DB = mysqlTestDb
-	defer func(original *sql.DB) {
-		DB = original
-	}(originalDB)
-
+func Test_catalog_table_columns_for_mysql_db(t *testing.T) {
+	skipWithoutMysql(t)
 	conf := createMysqlConf()
 
-	tables, err := getTableNames(conf)
-	if err != nil {
-		t.Fatalf("we shouldn't get an error from getTableNames")
-	}
+	cat := readTestCatalog(t, mysqlTestDb, conf)
+	tables := cat.Tables
 
 	// A map of tables (keys) and its columns (values as list of columns)
 	expectations := map[string][]string{
@@ -58,14 +49,11 @@ func Test_getTableColumns_helpers_func_for_mysql_db(t *testing.T) {
 	}
 
 	for i := range tables {
-		cols, err := getTableColumns(tables[i], conf)
-		if err != nil {
-			t.Fatalf("we shouldn't get an error from getTableColumns")
-		}
+		cols := cat.tableColumns(tables[i])
 		for _, e := range expectations[tables[i]] {
 			exists := false
 			for _, col := range cols {
-				if col.Name() == e {
+				if col.Name == e {
 					exists = true
 					break
 				}
@@ -77,19 +65,11 @@ func Test_getTableColumns_helpers_func_for_mysql_db(t *testing.T) {
 	}
 }
 
-func Test_getPrimaryKeys_for_mysql_db(t *testing.T) {
-	originalDB := DB
-	DB = mysqlTestDb
-	defer func(original *sql.DB) {
-		DB = original
-	}(originalDB)
-
+func Test_catalog_primary_keys_for_mysql_db(t *testing.T) {
+	skipWithoutMysql(t)
 	conf := createMysqlConf()
 
-	pks, err := getPrimaryKeys(conf)
-	if err != nil {
-		t.Fatalf("we shouldn't get an error from getPrimaryKeys; got %s", err)
-	}
+	pks := readTestCatalog(t, mysqlTestDb, conf).PrimaryKeys
 
 	if len(pks) != 3 {
 		t.Fatalf("expected 3 primary keys got %d", len(pks))
@@ -124,19 +104,11 @@ func Test_getPrimaryKeys_for_mysql_db(t *testing.T) {
 	}
 }
 
-func Test_getForeignKeys_helper_func_for_mysql_db(t *testing.T) {
-	originalDB := DB
-	DB = mysqlTestDb
-	defer func(original *sql.DB) {
-		DB = original
-	}(originalDB)
-
+func Test_catalog_foreign_keys_for_mysql_db(t *testing.T) {
+	skipWithoutMysql(t)
 	conf := createMysqlConf()
 
-	fks, err := getForeignKeys(conf)
-	if err != nil {
-		t.Errorf("we shouldn't get an error from getForeignKeys; got %s", err)
-	}
+	fks := readTestCatalog(t, mysqlTestDb, conf).ForeignKeys
 
 	if len(fks) != 2 {
 		t.Errorf("we expected 2 foreign keys got %d", len(fks))
@@ -149,12 +121,16 @@ func Test_getForeignKeys_helper_func_for_mysql_db(t *testing.T) {
 			Col:         "order_id",
 			DeleteRule:  "RESTRICT",
 			UpdateRule:  "NO ACTION",
+			TargetCol:   "id",
+			Name:        "order_line_order_id_fk",
 		}, {
 			Table:       "order_line",
 			TargetTable: "product",
 			Col:         "product_id",
 			DeleteRule:  "RESTRICT",
 			UpdateRule:  "NO ACTION",
+			TargetCol:   "id",
+			Name:        "order_line_product_id_fk",
 		},
 	}
 
@@ -174,19 +150,11 @@ func Test_getForeignKeys_helper_func_for_mysql_db(t *testing.T) {
 	}
 }
 
-func Test_getColsAndEnums_helper_func_for_mysql_db(t *testing.T) {
-	originalDB := DB
-	DB = mysqlTestDb
-	defer func(original *sql.DB) {
-		DB = original
-	}(originalDB)
-
+func Test_catalog_enums_for_mysql_db(t *testing.T) {
+	skipWithoutMysql(t)
 	conf := createMysqlConf()
 
-	enums, err := getColsAndEnums(conf)
-	if err != nil {
-		t.Fatalf("we shouldn't get an error when calling getColsAndEnums; got %s", err)
-	}
+	enums := readTestCatalog(t, mysqlTestDb, conf).Enums
 
 	if len(enums) != 1 {
 		t.Errorf("expected to have 1 enum only; got %d", len(enums))
@@ -204,19 +172,11 @@ func Test_getColsAndEnums_helper_func_for_mysql_db(t *testing.T) {
 	}
 }
 
-func Test_getUniqueCols_helper_func_for_mysql_db(t *testing.T) {
-	originalDB := DB
-	DB = mysqlTestDb
-	defer func(original *sql.DB) {
-		DB = original
-	}(originalDB)
-
+func Test_catalog_unique_columns_for_mysql_db(t *testing.T) {
+	skipWithoutMysql(t)
 	conf := createMysqlConf()
 
-	uniqueColumns, err := getUniqueCols(conf)
-	if err != nil {
-		t.Fatalf("we shouldn't get an error when calling getUniqueCols; got %s", err)
-	}
+	uniqueColumns := readTestCatalog(t, mysqlTestDb, conf).Uniques
 
 	if len(uniqueColumns) != 4 {
 		t.Errorf("we expected 4 unique columns got %d", len(uniqueColumns))
@@ -252,24 +212,627 @@ func Test_getUniqueCols_helper_func_for_mysql_db(t *testing.T) {
 	}
 }
 
-func Test_databaseMetaDataSetup_AND_some_repository_methods_for_mysql_db(t *testing.T) {
-	originalDB := DB
-	DB = mysqlTestDb
-	defer func(original *sql.DB) {
-		DB = original
-	}(originalDB)
+func Test_catalog_defaults_for_mysql_db(t *testing.T) {
+	skipWithoutMysql(t)
+	conf := createMysqlConf()
+
+	defaults := readTestCatalog(t, mysqlTestDb, conf).Defaults
+
+	def, err := defaults.get("id", "order")
+	if err != nil {
+		t.Fatalf("expected column id in table order to have a default; got %s", err)
+	}
+	if def.Extra != "auto_increment" {
+		t.Errorf("expected extra of column id in table order to be (auto_increment); got (%s)", def.Extra)
+	}
+}
+
+func Test_catalog_views_for_mysql_db(t *testing.T) {
+	skipWithoutMysql(t)
+	conf := createMysqlConf()
+
+	cat := readTestCatalog(t, mysqlTestDb, conf)
+
+	if !cat.hasTable("product_name") {
+		t.Fatalf("expected view product_name in the catalog")
+	}
+	view := cat.table("product_name")
+	if view.Kind != viewKind {
+		t.Errorf("expected product_name to be a %s; got %s", viewKind, view.Kind)
+	}
+	if !strings.Contains(strings.ToLower(view.Definition), "product") {
+		t.Errorf("expected the definition of product_name to select from product; got (%s)", view.Definition)
+	}
+	cols := cat.tableColumns("product_name")
+	if len(cols) != 2 || cols[0].Name != "id" || cols[1].Name != "name" {
+		t.Errorf("expected view product_name to have the columns id and name; got %+v", cols)
+	}
+}
+
+func Test_catalog_checks_for_mysql_db(t *testing.T) {
+	skipWithoutMysql(t)
+	conf := createMysqlConf()
+
+	_, err := mysqlTestDb.Exec(`
+		CREATE TABLE payment 
+		  ( 
+			 amount   DECIMAL(10, 2) NOT NULL, 
+			 refunded DECIMAL(10, 2) NOT NULL, 
+			 CONSTRAINT payment_amount_check CHECK (amount >= 0), 
+			 CONSTRAINT payment_refunded_check CHECK (refunded <= amount) 
+		  );
+	`)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the table payment; got %s", err)
+	}
+	defer func() {
+		if _, err := mysqlTestDb.Exec("DROP TABLE payment;"); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	checks := readTestCatalog(t, mysqlTestDb, conf).table("payment").Checks
+
+	if len(checks) != 2 {
+		t.Fatalf("expected 2 check constraints in table payment; got %d", len(checks))
+	}
+	if checks[0].Name != "payment_amount_check" || !strings.Contains(checks[0].Clause, "`amount` >= 0") ||
+		!reflect.DeepEqual(checks[0].Columns, []string{"amount"}) {
+		t.Errorf("expected check constraint payment_amount_check on column amount; got %+v", checks[0])
+	}
+	if checks[1].Name != "payment_refunded_check" || !strings.Contains(checks[1].Clause, "`refunded` <= `amount`") ||
+		!reflect.DeepEqual(checks[1].Columns, []string{"amount", "refunded"}) {
+		t.Errorf("expected check constraint payment_refunded_check on columns amount and refunded; got %+v", checks[1])
+	}
+}
+
+func Test_catalog_partitions_for_mysql_db(t *testing.T) {
+	skipWithoutMysql(t)
+	conf := createMysqlConf()
+
+	_, err := mysqlTestDb.Exec(`
+		CREATE TABLE sale 
+		  ( 
+			 id   INT NOT NULL, 
+			 sold DATE NOT NULL 
+		  ) 
+		PARTITION BY RANGE (YEAR(sold)) ( 
+			PARTITION p2019 VALUES LESS THAN (2020), 
+			PARTITION pmax VALUES LESS THAN MAXVALUE 
+		);
+	`)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the table sale; got %s", err)
+	}
+	defer func() {
+		if _, err := mysqlTestDb.Exec("DROP TABLE sale;"); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	sale := readTestCatalog(t, mysqlTestDb, conf).table("sale")
 
+	expectedPartitions := []tablePartition{
+		{Name: "p2019", Bound: "VALUES LESS THAN (2020)"},
+		{Name: "pmax", Bound: "VALUES LESS THAN (MAXVALUE)"},
+	}
+	if sale.PartitionKey != "RANGE (year(`sold`))" || !reflect.DeepEqual(sale.Partitions, expectedPartitions) {
+		t.Errorf("expected the table sale partitioned by RANGE (year(`sold`)) into %+v; got %q %+v",
+			expectedPartitions, sale.PartitionKey, sale.Partitions)
+	}
+}
+
+func Test_catalog_collations_for_mysql_db(t *testing.T) {
+	skipWithoutMysql(t)
+	conf := createMysqlConf()
+
+	_, err := mysqlTestDb.Exec(`
+		CREATE TABLE customer 
+		  ( 
+			 name VARCHAR(200) NOT NULL, 
+			 code CHAR(3) CHARACTER SET latin1 COLLATE latin1_bin 
+		  ) 
+		DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+	`)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the table customer; got %s", err)
+	}
+	defer func() {
+		if _, err := mysqlTestDb.Exec("DROP TABLE customer;"); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	cat := readTestCatalog(t, mysqlTestDb, conf)
+
+	if customer := cat.table("customer"); customer.Charset != "utf8mb4" || customer.Collation != "utf8mb4_bin" {
+		t.Errorf("expected the table customer in utf8mb4 (utf8mb4_bin); got %s (%s)", customer.Charset,
+			customer.Collation)
+	}
+	code, err := cat.Collations.get("code", "customer")
+	if err != nil || code.Charset != "latin1" || code.Collation != "latin1_bin" {
+		t.Errorf("expected the column code in latin1 (latin1_bin); got %+v", code)
+	}
+	name, err := cat.Collations.get("name", "customer")
+	if err != nil || name.Charset != "utf8mb4" || name.Collation != "utf8mb4_bin" {
+		t.Errorf("expected the column name in utf8mb4 (utf8mb4_bin); got %+v", name)
+	}
+}
+
+func Test_catalog_routines_for_mysql_db(t *testing.T) {
+	skipWithoutMysql(t)
+	conf := createMysqlConf()
+
+	_, err := mysqlTestDb.Exec(`
+		CREATE FUNCTION order_total(order_id BIGINT) RETURNS DECIMAL(10, 2) DETERMINISTIC RETURN 0;
+
+		CREATE TRIGGER product_name_trim BEFORE INSERT ON product FOR EACH ROW SET NEW.name = TRIM(NEW.name);
+	`)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the routines; got %s", err)
+	}
+	defer func() {
+		if _, err := mysqlTestDb.Exec("DROP TRIGGER product_name_trim; DROP FUNCTION order_total;"); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	routines := readTestCatalog(t, mysqlTestDb, conf).routines()
+
+	if len(routines) != 2 {
+		t.Fatalf("expected 2 routines; got %d", len(routines))
+	}
+	function := routines[0]
+	if function.ID != "order_total" || function.Kind != functionKind || function.Arguments != "order_id bigint" ||
+		function.ReturnType != "decimal(10,2)" || function.Language != "sql" || function.BodyHash == "" {
+		t.Errorf("expected the function order_total; got %+v", function)
+	}
+	trigger := routines[1]
+	if trigger.ID != "product_name_trim ON product" || trigger.Kind != triggerKind || trigger.Table != "product" ||
+		trigger.Event != "BEFORE INSERT FOR EACH ROW" || trigger.Language != "sql" {
+		t.Errorf("expected the trigger product_name_trim on table product; got %+v", trigger)
+	}
+}
+
+func Test_catalog_sequences_for_mysql_db(t *testing.T) {
+	skipWithoutMysql(t)
+	conf := createMysqlConf()
+
+	sequences := readTestCatalog(t, mysqlTestDb, conf).sequences()
+
+	var counter sequence
+	for _, s := range sequences {
+		if s.ID == "order" {
+			counter = s
+		}
+	}
+	if counter.Kind != autoIncrementKind || counter.Table != "order" || counter.Column != "id" ||
+		counter.DataType != "bigint unsigned" || counter.MaxValue != math.MaxUint64 || counter.Warning {
+		t.Errorf("expected the auto increment counter of table order; got %+v", counter)
+	}
+}
+
+func Test_catalog_stats_for_mysql_db(t *testing.T) {
+	skipWithoutMysql(t)
+	conf := createMysqlConf()
+
+	cat := readTestCatalog(t, mysqlTestDb, conf)
+
+	// Views do not have any statistics, and an empty InnoDB table still has its first page.
+	if len(cat.Stats) == 0 || len(cat.Stats) >= len(cat.Tables) {
+		t.Fatalf("expected the statistics of the tables but not of the views; got %+v", cat.Stats)
+	}
+	for _, s := range cat.Stats {
+		if s.Table == "order" && s.TableSize == 0 {
+			t.Errorf("expected the table order to have a size; got %+v", s)
+		}
+	}
+}
+
+func Test_queryTableColumns_reads_the_decimal_size_for_mysql_db(t *testing.T) {
+	skipWithoutMysql(t)
+	cols, err := queryTableColumns(mysqlTestDb, "SELECT CAST(1.5 AS DECIMAL(12, 2)) AS price, 1.5e0 AS amount;")
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from queryTableColumns; got %s", err)
+	}
+
+	if cols[0].Precision != 12 || cols[0].Scale != 2 {
+		t.Errorf("expected DECIMAL(12, 2) to have precision 12 and scale 2; got %d and %d", cols[0].Precision, cols[0].Scale)
+	}
+	if cols[1].Precision != 0 || cols[1].Scale != 0 {
+		t.Errorf("expected column without a declared precision to have precision and scale 0; got %d and %d",
+			cols[1].Precision, cols[1].Scale)
+	}
+}
+
+func Test_ProfileColumn_for_mysql_db(t *testing.T) {
+	skipWithoutMysql(t)
+	conf := createMysqlConf()
+	introspector, err := newMysqlIntrospector(mysqlTestDb, conf)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the introspector; got %s", err)
+	}
+	p := introspector.(Profiler)
+
+	_, err = mysqlTestDb.Exec(`
+		INSERT INTO product (id, name, counting_option) VALUES (1, 'apple', 'unit'), (2, 'pear', 'unit'),
+			(3, 'banana', 'decimal');
+	`)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when inserting the products; got %s", err)
+	}
+	defer func() {
+		if _, err := mysqlTestDb.Exec("DELETE FROM product;"); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	cat := readTestCatalog(t, mysqlTestDb, conf)
+	expected := map[string]columnProfile{
+		"id": {SampledRows: 2, DistinctCount: 2, Min: "1", Max: "2", TopValues: []valueCount{{"1", 1}, {"2", 1}}},
+		"name": {SampledRows: 2, DistinctCount: 2, Min: "apple", Max: "pear", AvgLength: 4.5,
+			TopValues: []valueCount{{"apple", 1}, {"pear", 1}}},
+	}
+	for name, want := range expected {
+		col, err := cat.column(name, "product")
+		if err != nil {
+			t.Fatal(err)
+		}
+		// mysql cannot sample a table, so the first rows of the table are read.
+		profile, err := p.ProfileColumn(context.Background(), cat, "product", col, 2)
+		if err != nil {
+			t.Fatalf("we shouldn't get an error from ProfileColumn; got %s", err)
+		}
+		if !reflect.DeepEqual(profile, want) {
+			t.Errorf("expected the profile of the column %s to be %+v; got %+v", name, want, profile)
+		}
+	}
+}
+
+func Test_CommentStatements_AND_ExecStatements_for_mysql_db(t *testing.T) {
+	skipWithoutMysql(t)
+	conf := createMysqlConf()
+	introspector, err := newMysqlIntrospector(mysqlTestDb, conf)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the introspector; got %s", err)
+	}
+	writer := introspector.(CommentWriter)
+	cat := readTestCatalog(t, mysqlTestDb, conf)
+
+	statements, err := writer.CommentStatements(cat, []descriptionComment{
+		{Table: "order", Description: "The orders of our clients."},
+		{Table: "product_name", Description: "Views cannot be commented in mysql."},
+		{Table: "order_line", Column: "order_id", Description: "The order's id."},
+	})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from CommentStatements; got %s", err)
+	}
+	if len(statements) != 2 {
+		t.Fatalf("expected 2 statements since views are skipped; got %d", len(statements))
+	}
+	if statements[0] != "ALTER TABLE `order` COMMENT = 'The orders of our clients.'" {
+		t.Errorf("expected statement (ALTER TABLE `order` COMMENT = 'The orders of our clients.'); got (%s)", statements[0])
+	}
+	if !strings.HasPrefix(statements[1], "ALTER TABLE `order_line` MODIFY COLUMN `order_id` bigint") ||
+		!strings.HasSuffix(statements[1], "unsigned NULL COMMENT 'The order''s id.'") {
+		t.Errorf("expected statement to redefine column order_id of order_line with its comment; got (%s)", statements[1])
+	}
+
+	// The comments of the columns are refused, as they would redefine the columns, so nothing is written.
+	showCreateTable := func(tableName string) string {
+		var name, definition string
+		if err := mysqlTestDb.QueryRow("SHOW CREATE TABLE "+mysqlQuoteIdentifier(tableName)).Scan(&name,
+			&definition); err != nil {
+			t.Fatalf("we shouldn't get an error from SHOW CREATE TABLE %s; got %s", tableName, err)
+		}
+		return definition
+	}
+	orderLine := showCreateTable("order_line")
+	if err = writer.ExecStatements(statements); err == nil {
+		t.Errorf("expected ExecStatements to refuse the comment of the column order_id of order_line")
+	}
+	if definition := showCreateTable("order_line"); definition != orderLine {
+		t.Errorf("expected the definition of order_line to be unchanged (%s); got (%s)", orderLine, definition)
+	}
+	cat = readTestCatalog(t, mysqlTestDb, conf)
+	if comment := cat.comment("", "order"); comment != "" {
+		t.Errorf("expected the table order not to be commented when a column comment is refused; got (%s)", comment)
+	}
+
+	err = writer.ExecStatements(statements[:1])
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from ExecStatements; got %s", err)
+	}
+	defer func() {
+		if _, err := mysqlTestDb.Exec("ALTER TABLE `order` COMMENT = '';"); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	cat = readTestCatalog(t, mysqlTestDb, conf)
+	if comment := cat.comment("", "order"); comment != "The orders of our clients." {
+		t.Errorf("expected table order to have the comment (The orders of our clients.); got (%s)", comment)
+	}
+}
+
+func Test_getColumnChanges_reports_a_moved_column_for_mysql_db(t *testing.T) {
+	skipWithoutMysql(t)
+	conf := createMysqlConf()
+	conf.ForceDelete = true // for testing we force delete of the data in the database.
+
+	_, err := mysqlTestDb.Exec("CREATE TABLE shelf (id INT PRIMARY KEY, label VARCHAR(50) NOT NULL, aisle INT NOT NULL);")
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the table shelf; got %s", err)
+	}
+	defer mysqlTestDb.Exec("DROP TABLE IF EXISTS shelf;")
+
+	storage, err := newJsonStorageIn(t.TempDir())
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from newJsonStorageIn; got %s", err)
+	}
+	introspector, err := newMysqlIntrospector(mysqlTestDb, conf)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the introspector; got %s", err)
+	}
+	if err = setupInitialMetadata(storage, conf, introspector); err != nil {
+		t.Fatalf("we shouldn't get an error from setupInitialMetadata; got %s", err)
+	}
+
+	if _, err = mysqlTestDb.Exec("ALTER TABLE shelf MODIFY COLUMN aisle INT NOT NULL AFTER id;"); err != nil {
+		t.Fatalf("we shouldn't get an error when moving the column aisle; got %s", err)
+	}
+
+	changes, err := getColumnChanges(storage, readTestCatalog(t, mysqlTestDb, conf))
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from getColumnChanges; got %s", err)
+	}
+	if len(changes) != 1 || changes[0].Name != "aisle" || changes[0].ChangesMessage != "column moved from position 3 to 2." {
+		t.Errorf("expected only the column aisle of shelf to be reported as moved from position 3 to 2; got %+v", changes)
+	}
+
+	rec := httptest.NewRecorder()
+	syncDatabase(storage, introspector)(rec, httptest.NewRequest(http.MethodPost, "/sync-db", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected the database to be synced; got status %d", rec.Code)
+	}
+
+	columns, err := storage.GetColumns()
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from GetColumns; got %s", err)
+	}
+	var names []string
+	for _, col := range columns.getAllColumnsFromTable("shelf") {
+		names = append(names, col.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"id", "aisle", "label"}) {
+		t.Errorf("expected the columns of shelf in the order [id aisle label] after the sync; got %v", names)
+	}
+}
+
+// The queries of mariadb are only run when the mysql testing server is a mariadb server, e.g. with
+// docker run -e MARIADB_ROOT_PASSWORD=secret -p 3306:3306 mariadb.
+func Test_Catalog_runs_the_queries_of_the_older_versions_for_mysql_db(t *testing.T) {
+	skipWithoutMysql(t)
+	conf := createMysqlConf()
+
+	_, err := mysqlTestDb.Exec(`
+		CREATE TABLE ticket (id INT PRIMARY KEY, seat INT NOT NULL, CONSTRAINT ticket_seat_check CHECK (seat > 0));
+	`)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the table ticket; got %s", err)
+	}
+	defer func() {
+		if _, err := mysqlTestDb.Exec("DROP TABLE ticket;"); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	introspector, err := newMysqlIntrospector(mysqlTestDb, conf)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the introspector; got %s", err)
+	}
+	in := introspector.(*mysqlIntrospector)
+	current, err := in.Catalog()
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when reading the catalog of %+v; got %s", in.server, err)
+	}
+	if in.server.hasCheckConstraints() && !current.Checks.exists("ticket_seat_check", "ticket") {
+		t.Errorf("expected the check constraint ticket_seat_check of ticket in the catalog of %+v; got %+v",
+			in.server, current.Checks)
+	}
+
+	// The servers below pick every query set of the introspector, which must read the same catalog as the
+	// queries of the testing server, but for the metadata the older servers do not have.
+	servers := []mysqlServer{
+		{major: 5, minor: 7, patch: 44},
+		{major: 8, minor: 0, patch: 12},
+		{major: 8, minor: 0, patch: 16},
+	}
+	if in.server.mariadb {
+		servers = []mysqlServer{
+			{mariadb: true, major: 10, minor: 0, patch: 4},
+			{mariadb: true, major: 10, minor: 2, patch: 21},
+			{mariadb: true, major: 10, minor: 3, patch: 10},
+		}
+	}
+	sortEnums := func(enums ColumnsAndEnums) ColumnsAndEnums {
+		sorted := append(ColumnsAndEnums(nil), enums...)
+		sort.Slice(sorted, func(i, j int) bool {
+			if sorted[i].Table != sorted[j].Table {
+				return sorted[i].Table < sorted[j].Table
+			}
+			return sorted[i].Col < sorted[j].Col
+		})
+		return sorted
+	}
+	for _, server := range servers {
+		if !in.server.atLeast(server.major, server.minor, server.patch) {
+			continue
+		}
+		older := *in
+		older.server = server
+		cat, err := older.Catalog()
+		if err != nil {
+			t.Errorf("we shouldn't get an error when reading the catalog of %+v; got %s", server, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(sortEnums(cat.Enums), sortEnums(current.Enums)) {
+			t.Errorf("expected the enums %+v from the queries of %+v; got %+v", current.Enums, server, cat.Enums)
+		}
+
+		indexes := append(IndexColumns(nil), current.Indexes...)
+		if !server.hasIndexExpressions() {
+			for i := range indexes {
+				indexes[i].Expression = ""
+			}
+		}
+		if !reflect.DeepEqual(cat.Indexes, indexes) {
+			t.Errorf("expected the indexes %+v from the queries of %+v; got %+v", indexes, server, cat.Indexes)
+		}
+
+		if server.hasCheckConstraints() && !reflect.DeepEqual(cat.Checks, current.Checks) {
+			t.Errorf("expected the checks %+v from the queries of %+v; got %+v", current.Checks, server, cat.Checks)
+		}
+		if !server.hasCheckConstraints() && len(cat.Checks) != 0 {
+			t.Errorf("expected no checks from the queries of %+v; got %+v", server, cat.Checks)
+		}
+	}
+}
+
+func Test_mysqlModifiesColumn(t *testing.T) {
+	tests := []struct {
+		statement string
+		expected  bool
+	}{
+		{"ALTER TABLE `order` COMMENT = 'The orders.'", false},
+		{"ALTER TABLE `order` COMMENT = ' MODIFY COLUMN '", false},
+		{"ALTER TABLE `order_line` MODIFY COLUMN `order_id` bigint unsigned NULL COMMENT 'The id.'", true},
+		{"ALTER TABLE `odd`` MODIFY COLUMN ` COMMENT = 'The odd table.'", false},
+		{"ALTER TABLE `odd`` COMMENT = ` MODIFY COLUMN `id` int NOT NULL COMMENT 'The id.'", true},
+	}
+	for _, tt := range tests {
+		if got := mysqlModifiesColumn(tt.statement); got != tt.expected {
+			t.Errorf("expected mysqlModifiesColumn(%s) to be %t; got %t", tt.statement, tt.expected, got)
+		}
+	}
+}
+
+func Test_mysqlColumnDefinition(t *testing.T) {
+	null := sql.NullString{}
+	tests := []struct {
+		colType    string
+		nullable   bool
+		def        sql.NullString
+		extra      string
+		generation string
+		charset    sql.NullString
+		collation  sql.NullString
+		expected   string
+	}{
+		{"bigint unsigned", false, null, "auto_increment", "", null, null, "bigint unsigned NOT NULL auto_increment"},
+		{"varchar(20)", false, sql.NullString{String: "unit", Valid: true}, "", "",
+			sql.NullString{String: "utf8mb4", Valid: true}, sql.NullString{String: "utf8mb4_bin", Valid: true},
+			"varchar(20) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL DEFAULT 'unit'"},
+		{"timestamp", true, sql.NullString{String: "CURRENT_TIMESTAMP", Valid: true},
+			"DEFAULT_GENERATED on update CURRENT_TIMESTAMP", "", null, null,
+			"timestamp NULL DEFAULT CURRENT_TIMESTAMP on update CURRENT_TIMESTAMP"},
+		{"json", true, sql.NullString{String: "json_array()", Valid: true}, "DEFAULT_GENERATED", "", null, null,
+			"json NULL DEFAULT (json_array())"},
+		{"int", true, null, "STORED GENERATED", "(`a` + 1)", null, null,
+			"int GENERATED ALWAYS AS ((`a` + 1)) STORED NULL"},
+	}
+
+	for _, tt := range tests {
+		got := mysqlColumnDefinition(tt.colType, tt.nullable, tt.def, tt.extra, tt.generation, tt.charset, tt.collation,
+			true)
+		if got != tt.expected {
+			t.Errorf("expected column definition (%s); got (%s)", tt.expected, got)
+		}
+	}
+}
+
+func Test_mysqlQuoteLiteral(t *testing.T) {
+	value := `it's C:\temp`
+	if got, expected := mysqlQuoteLiteral(value, true), `'it''s C:\\temp'`; got != expected {
+		t.Errorf("expected literal (%s) when backslashes are escapes; got (%s)", expected, got)
+	}
+	// With the sql_mode NO_BACKSLASH_ESCAPES a backslash is a literal character.
+	if got, expected := mysqlQuoteLiteral(value, false), `'it''s C:\temp'`; got != expected {
+		t.Errorf("expected literal (%s) with NO_BACKSLASH_ESCAPES; got (%s)", expected, got)
+	}
+}
+
+func Test_parseMysqlVersion(t *testing.T) {
+	tests := []struct {
+		version          string
+		expected         mysqlServer
+		regexpReplace    bool
+		indexExpressions bool
+		checkConstraints bool
+	}{
+		{"8.0.36", mysqlServer{major: 8, patch: 36}, true, true, true},
+		{"8.0.12", mysqlServer{major: 8, patch: 12}, true, false, false},
+		{"5.7.44-log", mysqlServer{major: 5, minor: 7, patch: 44}, false, false, false},
+		{"10.6.16-MariaDB-1:10.6.16+maria~ubu2004", mysqlServer{mariadb: true, major: 10, minor: 6, patch: 16},
+			true, false, true},
+		{"5.5.5-10.2.44-MariaDB", mysqlServer{mariadb: true, major: 10, minor: 2, patch: 44}, true, false, true},
+		{"10.3.9-MariaDB", mysqlServer{mariadb: true, major: 10, minor: 3, patch: 9}, true, false, false},
+	}
+
+	for _, tt := range tests {
+		server := parseMysqlVersion(tt.version)
+		if server != tt.expected {
+			t.Errorf("expected server %+v for version %s; got %+v", tt.expected, tt.version, server)
+		}
+		if server.hasRegexpReplace() != tt.regexpReplace {
+			t.Errorf("expected REGEXP_REPLACE support %v for version %s", tt.regexpReplace, tt.version)
+		}
+		if server.hasIndexExpressions() != tt.indexExpressions {
+			t.Errorf("expected index expressions support %v for version %s", tt.indexExpressions, tt.version)
+		}
+		if server.hasCheckConstraints() != tt.checkConstraints {
+			t.Errorf("expected check constraints support %v for version %s", tt.checkConstraints, tt.version)
+		}
+	}
+}
+
+func Test_mysqlEnumValues(t *testing.T) {
+	tests := []struct {
+		columnType string
+		expected   []string
+	}{
+		{"enum('unit','decimal')", []string{"unit", "decimal"}},
+		{"enum('it''s','a (b)','')", []string{"it's", "a (b)", ""}},
+		{"int", []string{}},
+	}
+
+	for _, tt := range tests {
+		got := mysqlEnumValues(tt.columnType)
+		if !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("expected enum values %q for %s; got %q", tt.expected, tt.columnType, got)
+		}
+	}
+}
+
+func Test_databaseMetaDataSetup_AND_some_repository_methods_for_mysql_db(t *testing.T) {
+	skipWithoutMysql(t)
 	conf := createMysqlConf()
 	conf.ForceDelete = true // for testing we force delete of the data in the database.
 
-	storage, err := NewJsonStorage()
+	storage, err := newJsonStorageIn(t.TempDir())
 	if err != nil {
-		t.Fatalf("we shouldn't get an error from NewJsonStorage; got %s", err)
+		t.Fatalf("we shouldn't get an error from newJsonStorageIn; got %s", err)
 	}
 
 	// Test setup.
 
-	err = setupInitialMetadata(storage, conf)
+	introspector, err := introspectors[conf.DatabaseDriver].newIntrospector(mysqlTestDb, conf)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the introspector; got %s", err)
+	}
+
+	err = setupInitialMetadata(storage, conf, introspector)
 	if err != nil {
 		t.Fatalf("we shouldn't get an error from databaseMetaDataSetup; got %s", err)
 	}
@@ -281,23 +844,60 @@ func Test_databaseMetaDataSetup_AND_some_repository_methods_for_mysql_db(t *test
 		t.Fatalf("we shouldn't get an error from GetTables; got %s", err)
 	}
 
-	if tables.count() != 3 {
-		t.Fatalf("expected to have 3 tables got %d", tables.count())
+	if tables.count() != 4 {
+		t.Fatalf("expected to have 4 tables got %d", tables.count())
 	}
 
 	expectedTables := []table{
 		{
 			ID:          "order",
 			Name:        "order",
+			Kind:        tableKind,
 			Description: "",
+			PrimaryKey:  &keyConstraint{Name: "PRIMARY", Columns: []string{"id"}},
+			ForeignKeys: []keyConstraint{},
+			Indexes:     []tableIndex{testIndex("PRIMARY", true, "id"), testIndex("id", true, "id")},
+			Checks:      []checkConstraint{},
 		}, {
 			ID:          "product",
 			Name:        "product",
-			Description: "",
+			Kind:        tableKind,
+			Description: "The products we sell.",
+			PrimaryKey:  &keyConstraint{Name: "PRIMARY", Columns: []string{"id"}},
+			ForeignKeys: []keyConstraint{},
+			Indexes: []tableIndex{testIndex("PRIMARY", true, "id"), testIndex("product_id_uindex", true, "id"),
+				testIndex("product_name_uindex", true, "name")},
+			Checks: []checkConstraint{},
 		}, {
 			ID:          "order_line",
 			Name:        "order_line",
+			Kind:        tableKind,
 			Description: "",
+			PrimaryKey:  &keyConstraint{Name: "PRIMARY", Columns: []string{"id"}},
+			ForeignKeys: []keyConstraint{
+				{
+					Name:          "order_line_order_id_fk",
+					Columns:       []string{"order_id"},
+					TargetTable:   "order",
+					TargetColumns: []string{"id"},
+					DeleteRule:    "RESTRICT",
+					UpdateRule:    "NO ACTION",
+				}, {
+					Name:          "order_line_product_id_fk",
+					Columns:       []string{"product_id"},
+					TargetTable:   "product",
+					TargetColumns: []string{"id"},
+					DeleteRule:    "RESTRICT",
+					UpdateRule:    "NO ACTION",
+				},
+			},
+			Indexes: []tableIndex{
+				testIndex("PRIMARY", true, "id"),
+				testIndex("order_line_id_uindex", true, "id"),
+				testIndex("order_line_order_id_fk", false, "order_id"),
+				testIndex("order_line_product_id_fk", false, "product_id"),
+			},
+			Checks: []checkConstraint{},
 		},
 	}
 
@@ -307,7 +907,7 @@ func Test_databaseMetaDataSetup_AND_some_repository_methods_for_mysql_db(t *test
 			if tb.ID == e.ID {
 				exists = true
 				if !reflect.DeepEqual(tb, e) {
-					t.Errorf("expected table data to be %+v; got %+v instead", e, t)
+					t.Errorf("expected table data to be %+v; got %+v instead", e, tb)
 				}
 				break
 			}
@@ -343,8 +943,16 @@ func Test_databaseMetaDataSetup_AND_some_repository_methods_for_mysql_db(t *test
 		t.Fatalf("we shouldn't get an error from GetColumns; got %s", err)
 	}
 
-	if len(columns) != 7 {
-		t.Fatalf("expected 7 columns got %d", len(columns))
+	if len(columns) != 9 {
+		t.Fatalf("expected 9 columns got %d", len(columns))
+	}
+
+	orderIDCol, err := columns.getByColNameAndTableName("order_id", "order_line")
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from getByColNameAndTableName; got %s", err)
+	}
+	if orderIDCol.TargetColFK != "id" {
+		t.Errorf("expected column order_id to reference the column (id); got (%s)", orderIDCol.TargetColFK)
 	}
 
 	productNameCol, err := columns.getByColNameAndTableName("name", "product")
@@ -367,4 +975,28 @@ func Test_databaseMetaDataSetup_AND_some_repository_methods_for_mysql_db(t *test
 		t.Errorf("expected description to be (%s) in column %s in table %s got %s", "I have a nice name.",
 			productNameCol.Name, productNameCol.TBName, productNameCol.Description)
 	}
+
+	// The comments of the database should never overwrite the descriptions, but they should be imported into
+	// the empty ones and conflicts should be reported.
+	err = storage.UpdateAddTableDescription("product", "")
+	if err != nil {
+		t.Fatalf("we shouldn't get any error from UpdateAddTableDescription; got %s", err)
+	}
+	newDescriptions, conflicts, err := getDescriptionComments(storage, readTestCatalog(t, mysqlTestDb, conf))
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from getDescriptionComments; got %s", err)
+	}
+	expectedNewDescriptions := []descriptionComment{
+		{Table: "product", Comment: "The products we sell."},
+	}
+	if !reflect.DeepEqual(newDescriptions, expectedNewDescriptions) {
+		t.Errorf("expected new descriptions %+v; got %+v", expectedNewDescriptions, newDescriptions)
+	}
+	expectedConflicts := []descriptionComment{
+		{Table: "product", Column: "name", ColumnID: productNameCol.ID, Description: "I have a nice name.",
+			Comment: "The name of the product."},
+	}
+	if !reflect.DeepEqual(conflicts, expectedConflicts) {
+		t.Errorf("expected description conflicts %+v; got %+v", expectedConflicts, conflicts)
+	}
 }
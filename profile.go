@@ -0,0 +1,251 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/pkg/errors"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// profileTopValues is the number of most common values kept in the profile of a column.
+	profileTopValues = 5
+
+	// profileValueMaxLength is the number of characters kept of the values shown in the profile of a column,
+	// so the profiles of long texts do not bloat the repository.
+	profileValueMaxLength = 100
+)
+
+// Profiler samples the rows of the tables of a database to profile the values of their columns. Introspectors of
+// database engines that can be profiled implement Profiler.
+type Profiler interface {
+	// ProfileColumn profiles the given column of the table with the given id reading a sample of at most
+	// sampleRows rows of the table. ProfileColumn gives up as soon as ctx is done.
+	ProfileColumn(ctx context.Context, cat *catalog, tableID string, col column, sampleRows int64) (columnProfile, error)
+}
+
+// profiler returns the given Introspector as a Profiler or an error if the database behind the Introspector
+// cannot be profiled.
+func profiler(introspector Introspector) (Profiler, error) {
+	if _, ok := introspector.(*dumpIntrospector); ok {
+		return nil, fmt.Errorf("only a live database can be profiled, a dump file does not have any data")
+	}
+	p, ok := introspector.(Profiler)
+	if !ok {
+		return nil, fmt.Errorf("the database driver does not support profiling")
+	}
+	return p, nil
+}
+
+// profileOptions holds the limits of a run of the profiling job.
+type profileOptions struct {
+	// Budget is the time after which the job stops profiling columns.
+	Budget time.Duration
+
+	// SampleRows is the maximum number of rows of a table read to profile each of its columns.
+	SampleRows int64
+}
+
+// profileReport tells which columns were profiled by a run of the profiling job and which were left for the
+// next run because the time budget ran out. Columns are given as table.column.
+type profileReport struct {
+	Profiled []string `json:"profiled"`
+	Skipped  []string `json:"skipped"`
+}
+
+// profileTables profiles the columns of the tables opted in for profiling and stores their profiles in the given
+// Repository. The columns never profiled go first, followed by the ones with the oldest profiles, so the columns
+// skipped when the time budget runs out are the first ones profiled in the next run.
+func profileTables(repo Repository, introspector Introspector, opts profileOptions) (profileReport, error) {
+	report := profileReport{Profiled: make([]string, 0), Skipped: make([]string, 0)}
+
+	p, err := profiler(introspector)
+	if err != nil {
+		return report, err
+	}
+
+	cat, err := introspector.Catalog()
+	if err != nil {
+		return report, err
+	}
+
+	storedTables, err := repo.GetTables()
+	if err != nil {
+		return report, err
+	}
+
+	storedColumns, err := repo.GetColumns()
+	if err != nil {
+		return report, err
+	}
+
+	profiling := make(map[string]bool)
+	for _, t := range storedTables {
+		profiling[t.ID] = t.Profiling && cat.hasTable(t.ID)
+	}
+
+	candidates := make(ColumnsMetadata, 0)
+	for _, col := range storedColumns {
+		if profiling[col.TBName] {
+			candidates = append(candidates, col)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Profile == nil || candidates[j].Profile == nil {
+			return candidates[i].Profile == nil && candidates[j].Profile != nil
+		}
+		return candidates[i].Profile.Time.Before(candidates[j].Profile.Time)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), opts.Budget)
+	defer cancel()
+
+	for _, stored := range candidates {
+		name := stored.TBName + "." + stored.Name
+		col, err := cat.column(stored.Name, stored.TBName)
+		if err != nil {
+			// The column has been dropped since the last sync.
+			continue
+		}
+		if ctx.Err() != nil {
+			report.Skipped = append(report.Skipped, name)
+			continue
+		}
+		profile, err := p.ProfileColumn(ctx, cat, stored.TBName, col, opts.SampleRows)
+		if err != nil && ctx.Err() != nil {
+			report.Skipped = append(report.Skipped, name)
+			continue
+		} else if err != nil {
+			return report, errors.Wrapf(err, "cannot profile the column %s", name)
+		}
+		profile.Time = time.Now().UTC()
+		if err := repo.UpdateColumnProfile(stored.ID, profile); err != nil {
+			return report, err
+		}
+		report.Profiled = append(report.Profiled, name)
+	}
+
+	return report, nil
+}
+
+// printProfileReport writes the given report of the profiling job to w.
+func printProfileReport(w io.Writer, report profileReport) error {
+	_, err := fmt.Fprintf(w, "%d column(s) profiled.\n", len(report.Profiled))
+	if err != nil {
+		return err
+	}
+	if len(report.Skipped) > 0 {
+		_, err = fmt.Fprintf(w, "%d column(s) left for the next run because the time budget ran out: %s\n",
+			len(report.Skipped), strings.Join(report.Skipped, ", "))
+	}
+	return err
+}
+
+// profileOrderedGoTypes holds the go types, besides the integers, of the columns whose values are ordered as
+// numbers or times, so their minimum and maximum are not taken from their text.
+var profileOrderedGoTypes = map[string]bool{
+	"float32":         true,
+	"float64":         true,
+	"time.Time":       true,
+	"sql.NullInt16":   true,
+	"sql.NullInt32":   true,
+	"sql.NullInt64":   true,
+	"sql.NullFloat64": true,
+	"sql.NullTime":    true,
+	"mysql.NullTime":  true,
+}
+
+// profileOrdered checks whether the values of the given column are ordered as numbers or times.
+func profileOrdered(col column) bool {
+	if _, ok := integerMaxValues[col.GoType]; ok {
+		return true
+	}
+	dbType := strings.ToUpper(col.DBType)
+	return profileOrderedGoTypes[col.GoType] || strings.Contains(dbType, "DECIMAL") ||
+		strings.Contains(dbType, "NUMERIC")
+}
+
+// sqlProfileDialect tells how the sampled aggregate queries of the profiling job are written for a sql database
+// engine.
+type sqlProfileDialect struct {
+	// text is the format of the expression that casts a value to text.
+	text string
+
+	// length is the format of the expression that returns the length in characters of a text.
+	length string
+}
+
+// profileSqlColumn profiles the column col, quoted as quotedCol, reading at most sampleRows rows from the given
+// from clause. The values of the columns that are not numbers nor times are profiled as text, so any type of
+// column can be compared and grouped.
+func profileSqlColumn(ctx context.Context, db *sql.DB, d sqlProfileDialect, from string, col column,
+	quotedCol string, sampleRows int64) (columnProfile, error) {
+	var profile columnProfile
+
+	value, avgLength := quotedCol, "NULL"
+	if !profileOrdered(col) {
+		value = fmt.Sprintf(d.text, quotedCol)
+		avgLength = "AVG(" + fmt.Sprintf(d.length, "v") + ")"
+	}
+	sample := fmt.Sprintf("(SELECT %s AS v FROM %s LIMIT %d) AS s", value, from, sampleRows)
+
+	var nonNulls int64
+	var min, max sql.NullString
+	var avg sql.NullFloat64
+	q := fmt.Sprintf("SELECT COUNT(*), COUNT(v), COUNT(DISTINCT v), %s, %s, %s FROM %s",
+		fmt.Sprintf(d.text, "MIN(v)"), fmt.Sprintf(d.text, "MAX(v)"), avgLength, sample)
+	err := db.QueryRowContext(ctx, q).Scan(&profile.SampledRows, &nonNulls, &profile.DistinctCount, &min, &max, &avg)
+	if err != nil {
+		return profile, err
+	}
+	if profile.SampledRows > 0 {
+		profile.NullFraction = roundProfileValue(float64(profile.SampledRows-nonNulls)/float64(profile.SampledRows), 4)
+	}
+	profile.Min = truncateProfileValue(min.String)
+	profile.Max = truncateProfileValue(max.String)
+	profile.AvgLength = roundProfileValue(avg.Float64, 2)
+
+	profile.TopValues = make([]valueCount, 0, profileTopValues)
+	q = fmt.Sprintf("SELECT %s, COUNT(*) FROM %s WHERE v IS NOT NULL GROUP BY v ORDER BY 2 DESC, 1 LIMIT %d",
+		fmt.Sprintf(d.text, "v"), sample, profileTopValues)
+	rows, err := db.QueryContext(ctx, q)
+	if err != nil {
+		return profile, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var vc valueCount
+		if err := rows.Scan(&vc.Value, &vc.Count); err != nil {
+			return profile, err
+		}
+		vc.Value = truncateProfileValue(vc.Value)
+		profile.TopValues = append(profile.TopValues, vc)
+	}
+
+	if err := rows.Err(); err != nil {
+		return profile, err
+	}
+
+	return profile, nil
+}
+
+// roundProfileValue rounds the given value to the given number of decimals.
+func roundProfileValue(value float64, decimals int) float64 {
+	pow := math.Pow(10, float64(decimals))
+	return math.Round(value*pow) / pow
+}
+
+// truncateProfileValue cuts the given value down to profileValueMaxLength characters.
+func truncateProfileValue(value string) string {
+	if utf8.RuneCountInString(value) <= profileValueMaxLength {
+		return value
+	}
+	return string([]rune(value)[:profileValueMaxLength]) + "..."
+}
@@ -1,26 +1,22 @@
 package main
 
 import (
-	"database/sql"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"strings"
 	"testing"
 )
 
-func Test_getTableNames_helper_func_for_psql_db(t *testing.T) {
-	originalDB := DB
-	DB = psqlTestDb
-	defer func(original *sql.DB) {
-		DB = original
-	}(originalDB)
-
+func Test_catalog_table_names_for_psql_db(t *testing.T) {
+	skipWithoutPsql(t)
 	conf := createPsqlConf()
 
-	tables, err := getTableNames(conf)
-	if err != nil {
-		t.Fatalf("we shouldn't get an error from getTableNames")
-	}
+	tables := readTestCatalog(t, psqlTestDb, conf).Tables
 
-	expectedTables := []string{"order", "product", "order_line"}
+	expectedTables := []string{"public.order", "public.product", "public.order_line"}
 
 	for _, e := range expectedTables {
 		exists := false
@@ -36,36 +32,143 @@ func Test_getTableNames_helper_func_for_psql_db(t *testing.T) {
 	}
 }
 
-func Test_getTableColumns_helpers_func_for_psql_db(t *testing.T) {
-	originalDB := DB
-	DB = psqlTestDb
-	defer func(original *sql.DB) {
-		DB = original
-	}(originalDB)
+func Test_catalog_for_psql_db_with_several_schemas(t *testing.T) {
+	skipWithoutPsql(t)
+	conf := createPsqlConf()
+	conf.DatabaseSchema = "public, billing"
+
+	cat := readTestCatalog(t, psqlTestDb, conf)
+
+	expectedTables := []string{"public.order", "public.product", "public.order_line", "public.product_name",
+		"public.order_line_count", "billing.order", "billing.order_line"}
+	if len(cat.Tables) != len(expectedTables) {
+		t.Errorf("expected %d tables; got %v", len(expectedTables), cat.Tables)
+	}
+	for _, e := range expectedTables {
+		if !cat.hasTable(e) {
+			t.Errorf("expected table %s.", e)
+		}
+	}
+
+	expectedTable := table{
+		ID:         "billing.order",
+		Schema:     "billing",
+		Name:       "order",
+		Kind:       tableKind,
+		PrimaryKey: &keyConstraint{Name: "billing_order_pk", Columns: []string{"id"}},
+		ForeignKeys: []keyConstraint{
+			{
+				Name:          "billing_order_order_id_fk",
+				Columns:       []string{"order_id"},
+				TargetTable:   "public.order",
+				TargetColumns: []string{"id"},
+				DeleteRule:    "NO ACTION",
+				UpdateRule:    "NO ACTION",
+			},
+		},
+	}
+	if tb := cat.table("billing.order"); !reflect.DeepEqual(tb, expectedTable) {
+		t.Errorf("expected table %+v; got %+v", expectedTable, tb)
+	}
+
+	if !cat.PrimaryKeys.exists("id", "billing.order") {
+		t.Errorf("expected primary key id in table billing.order")
+	}
+
+	fk, err := cat.ForeignKeys.get("order_id", "billing.order")
+	if err != nil {
+		t.Fatalf("expected foreign key order_id in table billing.order; got %s", err)
+	}
+	if fk.TargetTable != "public.order" {
+		t.Errorf("expected foreign key to target table public.order; got %s", fk.TargetTable)
+	}
+
+	// billing.order_line has a foreign key and an enum with the same names as the ones of public.order_line.
+	fk, err = cat.ForeignKeys.get("order_id", "billing.order_line")
+	if err != nil {
+		t.Fatalf("expected foreign key order_id in table billing.order_line; got %s", err)
+	}
+	if fk.TargetTable != "billing.order" || fk.DeleteRule != "CASCADE" {
+		t.Errorf("unexpected foreign key %+v", fk)
+	}
+	fk, err = cat.ForeignKeys.get("order_id", "public.order_line")
+	if err != nil {
+		t.Fatalf("expected foreign key order_id in table public.order_line; got %s", err)
+	}
+	if fk.TargetTable != "public.order" || fk.DeleteRule != "RESTRICT" {
+		t.Errorf("unexpected foreign key %+v", fk)
+	}
+
+	enum, err := cat.Enums.get("counting_option", "billing.order_line")
+	if err != nil {
+		t.Fatalf("expected enum column counting_option in table billing.order_line; got %s", err)
+	}
+	if enum.EnumValues != "box,pallet" {
+		t.Errorf("expected enum values (box,pallet); got (%s)", enum.EnumValues)
+	}
+
+	conf.DatabaseSchema = allSchemas
+	cat = readTestCatalog(t, psqlTestDb, conf)
+	for _, e := range expectedTables {
+		if !cat.hasTable(e) {
+			t.Errorf("expected table %s when reading all the schemas.", e)
+		}
+	}
+}
 
+func Test_setupInitialMetadata_accepts_added_schemas_for_psql_db(t *testing.T) {
 	conf := createPsqlConf()
+	storage, err := newJsonStorageIn(t.TempDir())
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from newJsonStorageIn; got %s", err)
+	}
+	err = storage.AddDatabaseInfo(databaseInfo{Name: conf.DatabaseName, User: conf.DatabaseUser,
+		Host: conf.DatabaseHost, Port: conf.DatabasePort, Password: conf.DatabasePassword,
+		Driver: conf.DatabaseDriver, Schema: "public"})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from AddDatabaseInfo; got %s", err)
+	}
 
-	tables, err := getTableNames(conf)
+	// An added schema is stored without touching the stored data dictionary, its tables are synced as new tables.
+	conf.DatabaseSchema = "public, billing"
+	if err = setupInitialMetadata(storage, conf, nil); err != nil {
+		t.Fatalf("expected an added schema to be compatible with the stored data dictionary; got %s", err)
+	}
+	info, err := storage.GetDatabaseInfo()
 	if err != nil {
-		t.Fatalf("we shouldn't get an error from getTableNames")
+		t.Fatalf("we shouldn't get an error from GetDatabaseInfo; got %s", err)
+	}
+	if info.Schema != "public,billing" {
+		t.Errorf("expected the stored schemas to be (public,billing); got (%s)", info.Schema)
+	}
+
+	// A removed schema needs to be confirmed with -force_delete.
+	conf.DatabaseSchema = "billing"
+	if err = setupInitialMetadata(storage, conf, nil); err == nil || !strings.Contains(err.Error(), "public") {
+		t.Errorf("expected the removed schema public to be reported as a difference; got %v", err)
 	}
+}
+
+func Test_catalog_table_columns_for_psql_db(t *testing.T) {
+	skipWithoutPsql(t)
+	conf := createPsqlConf()
+
+	cat := readTestCatalog(t, psqlTestDb, conf)
+	tables := cat.Tables
 
 	// A map of tables (keys) and its columns (values as list of columns)
 	expectations := map[string][]string{
-		"order":      {"id"},
-		"product":    {"id", "name", "counting_option"},
-		"order_line": {"id", "order_id"},
+		"public.order":      {"id"},
+		"public.product":    {"id", "name", "counting_option"},
+		"public.order_line": {"id", "order_id"},
 	}
 
 	for i := range tables {
-		cols, err := getTableColumns(tables[i], conf)
-		if err != nil {
-			t.Fatalf("we shouldn't get an error from getTableColumns")
-		}
+		cols := cat.tableColumns(tables[i])
 		for _, e := range expectations[tables[i]] {
 			exists := false
 			for _, col := range cols {
-				if col.Name() == e {
+				if col.Name == e {
 					exists = true
 					break
 				}
@@ -77,19 +180,11 @@ func Test_getTableColumns_helpers_func_for_psql_db(t *testing.T) {
 	}
 }
 
-func Test_getPrimaryKeys_for_psql_db(t *testing.T) {
-	originalDB := DB
-	DB = psqlTestDb
-	defer func(original *sql.DB) {
-		DB = original
-	}(originalDB)
-
+func Test_catalog_primary_keys_for_psql_db(t *testing.T) {
+	skipWithoutPsql(t)
 	conf := createPsqlConf()
 
-	pks, err := getPrimaryKeys(conf)
-	if err != nil {
-		t.Fatalf("we shouldn't get an error from getPrimaryKeys; got %s", err)
-	}
+	pks := readTestCatalog(t, psqlTestDb, conf).PrimaryKeys
 
 	if len(pks) != 3 {
 		t.Fatalf("expected 3 primary keys got %d", len(pks))
@@ -97,15 +192,15 @@ func Test_getPrimaryKeys_for_psql_db(t *testing.T) {
 
 	expectedPrimaryKeys := []primaryKey{
 		{
-			Table: "order",
+			Table: "public.order",
 			Col:   "id",
 		},
 		{
-			Table: "product",
+			Table: "public.product",
 			Col:   "id",
 		},
 		{
-			Table: "order_line",
+			Table: "public.order_line",
 			Col:   "id",
 		},
 	}
@@ -124,19 +219,11 @@ func Test_getPrimaryKeys_for_psql_db(t *testing.T) {
 	}
 }
 
-func Test_getForeignKeys_helper_func_for_psql_db(t *testing.T) {
-	originalDB := DB
-	DB = psqlTestDb
-	defer func(original *sql.DB) {
-		DB = original
-	}(originalDB)
-
+func Test_catalog_foreign_keys_for_psql_db(t *testing.T) {
+	skipWithoutPsql(t)
 	conf := createPsqlConf()
 
-	fks, err := getForeignKeys(conf)
-	if err != nil {
-		t.Errorf("we shouldn't get an error from getForeignKeys; got %s", err)
-	}
+	fks := readTestCatalog(t, psqlTestDb, conf).ForeignKeys
 
 	if len(fks) != 2 {
 		t.Errorf("we expected 2 foreign keys got %d", len(fks))
@@ -144,17 +231,21 @@ func Test_getForeignKeys_helper_func_for_psql_db(t *testing.T) {
 
 	expectedForeignKeys := []foreignKey{
 		{
-			Table:       "order_line",
-			TargetTable: "order",
+			Table:       "public.order_line",
+			TargetTable: "public.order",
 			Col:         "order_id",
 			DeleteRule:  "RESTRICT",
 			UpdateRule:  "NO ACTION",
+			TargetCol:   "id",
+			Name:        "order_line_order_id_fk",
 		}, {
-			Table:       "order_line",
-			TargetTable: "product",
+			Table:       "public.order_line",
+			TargetTable: "public.product",
 			Col:         "product_id",
 			DeleteRule:  "RESTRICT",
 			UpdateRule:  "NO ACTION",
+			TargetCol:   "id",
+			Name:        "order_line_product_id_fk",
 		},
 	}
 
@@ -174,26 +265,18 @@ func Test_getForeignKeys_helper_func_for_psql_db(t *testing.T) {
 	}
 }
 
-func Test_getColsAndEnums_helper_func_for_psql_db(t *testing.T) {
-	originalDB := DB
-	DB = psqlTestDb
-	defer func(original *sql.DB) {
-		DB = original
-	}(originalDB)
-
+func Test_catalog_enums_for_psql_db(t *testing.T) {
+	skipWithoutPsql(t)
 	conf := createPsqlConf()
 
-	enums, err := getColsAndEnums(conf)
-	if err != nil {
-		t.Fatalf("we shouldn't get an error when calling getColsAndEnums; got %s", err)
-	}
+	enums := readTestCatalog(t, psqlTestDb, conf).Enums
 
 	if len(enums) != 1 {
 		t.Errorf("expected to have 1 enum only; got %d", len(enums))
 	}
 
 	expectedEnum := colAndEnum{
-		Table:      "product",
+		Table:      "public.product",
 		Col:        "counting_option",
 		EnumName:   "counting_option",
 		EnumValues: "unit,decimal",
@@ -204,19 +287,11 @@ func Test_getColsAndEnums_helper_func_for_psql_db(t *testing.T) {
 	}
 }
 
-func Test_getUniqueCols_helper_func_for_psql_db(t *testing.T) {
-	originalDB := DB
-	DB = psqlTestDb
-	defer func(original *sql.DB) {
-		DB = original
-	}(originalDB)
-
+func Test_catalog_unique_columns_for_psql_db(t *testing.T) {
+	skipWithoutPsql(t)
 	conf := createPsqlConf()
 
-	uniqueColumns, err := getUniqueCols(conf)
-	if err != nil {
-		t.Fatalf("we shouldn't get an error when calling getUniqueCols; got %s", err)
-	}
+	uniqueColumns := readTestCatalog(t, psqlTestDb, conf).Uniques
 
 	if len(uniqueColumns) != 4 {
 		t.Errorf("we expected 4 unique columns got %d", len(uniqueColumns))
@@ -224,16 +299,16 @@ func Test_getUniqueCols_helper_func_for_psql_db(t *testing.T) {
 
 	expectedUniqueColumns := []uniqueCol{
 		{
-			Table: "order_line",
+			Table: "public.order_line",
 			Col:   "id",
 		}, {
-			Table: "order",
+			Table: "public.order",
 			Col:   "id",
 		}, {
-			Table: "product",
+			Table: "public.product",
 			Col:   "id",
 		}, {
-			Table: "product",
+			Table: "public.product",
 			Col:   "name",
 		},
 	}
@@ -252,24 +327,574 @@ func Test_getUniqueCols_helper_func_for_psql_db(t *testing.T) {
 	}
 }
 
-func Test_databaseMetaDataSetup_AND_some_repository_methods_for_psql_db(t *testing.T) {
-	originalDB := DB
-	DB = psqlTestDb
-	defer func(original *sql.DB) {
-		DB = original
-	}(originalDB)
+func Test_catalog_defaults_for_psql_db(t *testing.T) {
+	skipWithoutPsql(t)
+	conf := createPsqlConf()
+
+	defaults := readTestCatalog(t, psqlTestDb, conf).Defaults
+
+	def, err := defaults.get("id", "public.order")
+	if err != nil {
+		t.Fatalf("expected column id in table public.order to have a default; got %s", err)
+	}
+	if def.Default != "nextval('order_id_seq'::regclass)" {
+		t.Errorf("expected default of column id in table public.order to be (nextval('order_id_seq'::regclass)); got (%s)", def.Default)
+	}
+}
+
+func Test_catalog_views_for_psql_db(t *testing.T) {
+	skipWithoutPsql(t)
+	conf := createPsqlConf()
+
+	cat := readTestCatalog(t, psqlTestDb, conf)
+
+	if !cat.hasTable("public.product_name") {
+		t.Fatalf("expected view public.product_name in the catalog")
+	}
+	view := cat.table("public.product_name")
+	if view.Kind != viewKind {
+		t.Errorf("expected public.product_name to be a %s; got %s", viewKind, view.Kind)
+	}
+	if !strings.Contains(strings.ToLower(view.Definition), "product") {
+		t.Errorf("expected the definition of public.product_name to select from product; got (%s)", view.Definition)
+	}
+	cols := cat.tableColumns("public.product_name")
+	if len(cols) != 2 || cols[0].Name != "id" || cols[1].Name != "name" {
+		t.Errorf("expected view public.product_name to have the columns id and name; got %+v", cols)
+	}
+
+	mv := cat.table("public.order_line_count")
+	if mv.Kind != materializedViewKind {
+		t.Errorf("expected order_line_count to be a %s; got %s", materializedViewKind, mv.Kind)
+	}
+	mvCols := cat.tableColumns("public.order_line_count")
+	if len(mvCols) != 2 || mvCols[0].Name != "order_id" || mvCols[1].Name != "lines" {
+		t.Errorf("expected materialized view order_line_count to have the columns order_id and lines; got %+v", mvCols)
+	}
+}
+
+func Test_catalog_checks_for_psql_db(t *testing.T) {
+	skipWithoutPsql(t)
+	conf := createPsqlConf()
+
+	_, err := psqlTestDb.Exec(`
+		CREATE TABLE payment 
+		  ( 
+			 amount   NUMERIC(10, 2) NOT NULL CONSTRAINT payment_amount_check CHECK (amount >= 0), 
+			 refunded NUMERIC(10, 2) NOT NULL, 
+			 CONSTRAINT payment_refunded_check CHECK (refunded <= amount) 
+		  );
+	`)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the table payment; got %s", err)
+	}
+	defer func() {
+		if _, err := psqlTestDb.Exec("DROP TABLE payment;"); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	checks := readTestCatalog(t, psqlTestDb, conf).table("public.payment").Checks
+
+	if len(checks) != 2 {
+		t.Fatalf("expected 2 check constraints in table public.payment; got %d", len(checks))
+	}
+	if checks[0].Name != "payment_amount_check" || !strings.Contains(checks[0].Clause, "amount >= ") ||
+		!reflect.DeepEqual(checks[0].Columns, []string{"amount"}) {
+		t.Errorf("expected check constraint payment_amount_check on column amount; got %+v", checks[0])
+	}
+	if checks[1].Name != "payment_refunded_check" || checks[1].Clause != "refunded <= amount" ||
+		!reflect.DeepEqual(checks[1].Columns, []string{"amount", "refunded"}) {
+		t.Errorf("expected check constraint payment_refunded_check on columns amount and refunded; got %+v", checks[1])
+	}
+}
+
+func Test_catalog_partitions_AND_inheritance_for_psql_db(t *testing.T) {
+	skipWithoutPsql(t)
+	conf := createPsqlConf()
+
+	_, err := psqlTestDb.Exec(`
+		CREATE TABLE events (id INTEGER NOT NULL, created_at DATE NOT NULL) PARTITION BY RANGE (created_at);
+		CREATE TABLE events_2020_01 PARTITION OF events FOR VALUES FROM ('2020-01-01') TO ('2020-02-01');
+		CREATE TABLE city (name TEXT NOT NULL);
+		CREATE TABLE capital (state CHAR(2) NOT NULL) INHERITS (city);
+	`)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the tables; got %s", err)
+	}
+	defer func() {
+		_, err := psqlTestDb.Exec(`
+			DROP TABLE events;
+			DROP TABLE capital;
+			DROP TABLE city;
+		`)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	cat := readTestCatalog(t, psqlTestDb, conf)
+
+	if cat.hasTable("public.events_2020_01") {
+		t.Errorf("expected the partition events_2020_01 to be documented within its parent table only")
+	}
+	events := cat.table("public.events")
+	expectedPartitions := []tablePartition{
+		{Name: "public.events_2020_01", Bound: "FOR VALUES FROM ('2020-01-01') TO ('2020-02-01')"},
+	}
+	if events.PartitionKey != "RANGE (created_at)" || !reflect.DeepEqual(events.Partitions, expectedPartitions) {
+		t.Errorf("expected the table events partitioned by RANGE (created_at) into %+v; got %q %+v",
+			expectedPartitions, events.PartitionKey, events.Partitions)
+	}
+	if capital := cat.table("public.capital"); !reflect.DeepEqual(capital.Inherits, []string{"public.city"}) {
+		t.Errorf("expected the table capital to inherit from public.city; got %v", capital.Inherits)
+	}
+
+	// a new partition is not a change of its table.
+	_, err = psqlTestDb.Exec(`
+		CREATE TABLE events_2020_02 PARTITION OF events FOR VALUES FROM ('2020-02-01') TO ('2020-03-01');
+	`)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating a new partition; got %s", err)
+	}
+	newEvents := readTestCatalog(t, psqlTestDb, conf).table("public.events")
+	if len(newEvents.Partitions) != 2 {
+		t.Errorf("expected 2 partitions of the table events; got %+v", newEvents.Partitions)
+	}
+	if equal, msg := compareTableMetadata(events, newEvents); !equal {
+		t.Errorf("expected a new partition not to be a change of the table events; got %s", msg)
+	}
+}
+
+func Test_checkDatabaseChanges_ignores_a_new_partition_for_psql_db(t *testing.T) {
+	skipWithoutPsql(t)
+	conf := createPsqlConf()
+
+	_, err := psqlTestDb.Exec(`
+		CREATE TABLE reading (id INTEGER NOT NULL, taken_at DATE NOT NULL, 
+			value NUMERIC NOT NULL CHECK (value >= 0)) PARTITION BY RANGE (taken_at);
+		CREATE INDEX reading_taken_at_idx ON reading (taken_at);
+		COMMENT ON TABLE reading IS 'the readings of the sensors';
+		CREATE TABLE reading_2020_01 PARTITION OF reading FOR VALUES FROM ('2020-01-01') TO ('2020-02-01');
+
+		CREATE FUNCTION reading_touch() RETURNS trigger LANGUAGE plpgsql AS $$
+		BEGIN
+			RETURN NEW;
+		END;
+		$$;
+
+		CREATE TRIGGER reading_touch AFTER INSERT ON reading FOR EACH ROW EXECUTE PROCEDURE reading_touch();
+	`)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the table reading; got %s", err)
+	}
+	defer func() {
+		_, err := psqlTestDb.Exec(`
+			DROP TABLE reading;
+			DROP FUNCTION reading_touch();
+		`)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	storage, err := newJsonStorageIn(t.TempDir())
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from newJsonStorageIn; got %s", err)
+	}
+	introspector, err := newPsqlIntrospector(psqlTestDb, conf)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the introspector; got %s", err)
+	}
+	if err = setupInitialMetadata(storage, conf, introspector); err != nil {
+		t.Fatalf("we shouldn't get an error from setupInitialMetadata; got %s", err)
+	}
+
+	_, err = psqlTestDb.Exec(`
+		CREATE TABLE reading_2020_02 PARTITION OF reading FOR VALUES FROM ('2020-02-01') TO ('2020-03-01');
+	`)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating a new partition; got %s", err)
+	}
+
+	rec := httptest.NewRecorder()
+	checkDatabaseChanges(storage, introspector)(rec, httptest.NewRequest(http.MethodGet, "/check-changes", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected the status %d from checkDatabaseChanges; got %d", http.StatusOK, rec.Code)
+	}
+
+	changes := make(map[string]json.RawMessage)
+	if err = json.Unmarshal(rec.Body.Bytes(), &changes); err != nil {
+		t.Fatalf("we shouldn't get an error when decoding the changes; got %s", err)
+	}
+	for kind, change := range changes {
+		if s := string(change); s != "null" && s != "[]" {
+			t.Errorf("expected no %s after creating a new partition; got %s", kind, s)
+		}
+	}
+}
+
+func Test_catalog_routines_for_psql_db(t *testing.T) {
+	skipWithoutPsql(t)
+	conf := createPsqlConf()
+
+	_, err := psqlTestDb.Exec(`
+		CREATE FUNCTION product_name_trim() RETURNS trigger LANGUAGE plpgsql AS $$
+		BEGIN
+			NEW.name := trim(NEW.name);
+			RETURN NEW;
+		END;
+		$$;
+
+		CREATE TRIGGER product_name_trim BEFORE INSERT OR UPDATE ON product
+			FOR EACH ROW EXECUTE PROCEDURE product_name_trim();
+
+		CREATE PROCEDURE archive_order(order_id INTEGER, reason TEXT DEFAULT 'done') LANGUAGE sql AS $$
+			DELETE FROM order_line WHERE order_line.order_id = $1;
+		$$;
+	`)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the routines; got %s", err)
+	}
+	defer func() {
+		_, err := psqlTestDb.Exec(`
+			DROP TRIGGER product_name_trim ON product;
+			DROP FUNCTION product_name_trim();
+			DROP PROCEDURE archive_order;
+		`)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	routines := readTestCatalog(t, psqlTestDb, conf).routines()
+
+	if len(routines) != 3 {
+		t.Fatalf("expected 3 routines; got %d", len(routines))
+	}
+	procedure := routines[0]
+	if procedure.ID != "public.archive_order(order_id integer, reason text)" || procedure.Kind != procedureKind ||
+		procedure.Arguments != "order_id integer, reason text DEFAULT 'done'::text" || procedure.ReturnType != "" ||
+		procedure.Language != "sql" {
+		t.Errorf("expected the procedure archive_order; got %+v", procedure)
+	}
+	function := routines[1]
+	if function.ID != "public.product_name_trim" || function.Kind != functionKind || function.Arguments != "" ||
+		function.ReturnType != "trigger" || function.Language != "plpgsql" || function.BodyHash == "" {
+		t.Errorf("expected the function product_name_trim; got %+v", function)
+	}
+	trigger := routines[2]
+	if trigger.ID != "public.product_name_trim ON public.product" || trigger.Kind != triggerKind ||
+		trigger.Table != "public.product" || trigger.Event != "BEFORE INSERT OR UPDATE FOR EACH ROW" ||
+		trigger.Language != "plpgsql" {
+		t.Errorf("expected the trigger product_name_trim on table public.product; got %+v", trigger)
+	}
+}
+
+func Test_catalog_user_defined_types_for_psql_db(t *testing.T) {
+	skipWithoutPsql(t)
+	conf := createPsqlConf()
+
+	_, err := psqlTestDb.Exec(`
+		CREATE DOMAIN email AS VARCHAR(200) NOT NULL CONSTRAINT email_check CHECK (VALUE LIKE '%@%');
+		CREATE TYPE address AS (street TEXT, zip INTEGER);
+		CREATE TYPE floatrange AS RANGE (SUBTYPE = FLOAT8);
+		CREATE TABLE customer (
+			id INTEGER PRIMARY KEY,
+			email email,
+			address address,
+			tags TEXT[],
+			active_during TSTZRANGE,
+			weight floatrange
+		);
+	`)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the types; got %s", err)
+	}
+	defer func() {
+		_, err := psqlTestDb.Exec(`
+			DROP TABLE customer;
+			DROP DOMAIN email;
+			DROP TYPE address;
+			DROP TYPE floatrange;
+		`)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	cat := readTestCatalog(t, psqlTestDb, conf)
+
+	types := cat.dataTypes()
+	domain, err := types.get("public.email")
+	if err != nil || domain.Kind != domainTypeKind || domain.BaseType != "character varying(200)" ||
+		!domain.NotNull || len(domain.Checks) != 1 || domain.Checks[0].Name != "email_check" {
+		t.Errorf("expected the domain email; got %+v", domain)
+	}
+	composite, err := types.get("public.address")
+	expectedAttributes := []typeAttribute{{Name: "street", Type: "text"}, {Name: "zip", Type: "integer"}}
+	if err != nil || composite.Kind != compositeTypeKind || !reflect.DeepEqual(composite.Attributes, expectedAttributes) {
+		t.Errorf("expected the composite type address; got %+v", composite)
+	}
+	rangeType, err := types.get("public.floatrange")
+	if err != nil || rangeType.Kind != rangeTypeKind || rangeType.BaseType != "double precision" {
+		t.Errorf("expected the range type floatrange; got %+v", rangeType)
+	}
+
+	expectedColTypes := ColumnsTypes{
+		{Table: "public.customer", Col: "email", Kind: domainTypeKind, UserType: "public.email",
+			BaseType: "character varying(200)"},
+		{Table: "public.customer", Col: "address", Kind: compositeTypeKind, UserType: "public.address"},
+		{Table: "public.customer", Col: "tags", Kind: arrayTypeKind, BaseType: "text"},
+		{Table: "public.customer", Col: "active_during", Kind: rangeTypeKind, BaseType: "timestamp with time zone"},
+		{Table: "public.customer", Col: "weight", Kind: rangeTypeKind, UserType: "public.floatrange",
+			BaseType: "double precision"},
+	}
+	colTypes := make(ColumnsTypes, 0)
+	for _, ct := range cat.ColTypes {
+		if ct.Table == "public.customer" {
+			colTypes = append(colTypes, ct)
+		}
+	}
+	if !reflect.DeepEqual(colTypes, expectedColTypes) {
+		t.Errorf("expected column types %+v; got %+v", expectedColTypes, colTypes)
+	}
+}
+
+func Test_catalog_sequences_for_psql_db(t *testing.T) {
+	skipWithoutPsql(t)
+	conf := createPsqlConf()
+
+	// The serial column order_line.id is an integer, so it is exhausted long before its bigint sequence.
+	_, err := psqlTestDb.Exec(`
+		ALTER SEQUENCE order_line_id_seq AS bigint;
+		SELECT setval('order_line_id_seq', 1932735282);
+	`)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when moving the sequence order_line_id_seq; got %s", err)
+	}
+	defer func() {
+		_, err := psqlTestDb.Exec(`
+			SELECT setval('order_line_id_seq', 1, false);
+			ALTER SEQUENCE order_line_id_seq AS integer;
+		`)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	sequences := readTestCatalog(t, psqlTestDb, conf).sequences()
+
+	var seq sequence
+	for _, s := range sequences {
+		if s.ID == "public.order_line_id_seq" {
+			seq = s
+		}
+	}
+	expected := sequence{ID: "public.order_line_id_seq", Schema: "public", Name: "order_line_id_seq",
+		Kind: sequenceKind, Table: "public.order_line", Column: "id", DataType: "bigint", Increment: 1,
+		CurrentValue: 1932735282, MaxValue: 2147483647, Usage: 90, Warning: true}
+	if seq != expected {
+		t.Errorf("expected sequence %+v; got %+v", expected, seq)
+	}
+}
+
+func Test_catalog_stats_for_psql_db(t *testing.T) {
+	skipWithoutPsql(t)
+	conf := createPsqlConf()
+
+	cat := readTestCatalog(t, psqlTestDb, conf)
+
+	// Plain views do not have any statistics, and an empty table still has the pages of its indexes.
+	if len(cat.Stats) == 0 || len(cat.Stats) >= len(cat.Tables) {
+		t.Fatalf("expected the statistics of the tables but not of the views; got %+v", cat.Stats)
+	}
+	for _, s := range cat.Stats {
+		if s.Table == "public.order" && s.IndexSize == 0 {
+			t.Errorf("expected the table public.order to have a size; got %+v", s)
+		}
+	}
+}
+
+func Test_queryTableColumns_reads_the_decimal_size_for_psql_db(t *testing.T) {
+	skipWithoutPsql(t)
+	cols, err := queryTableColumns(psqlTestDb, "SELECT CAST(1.5 AS NUMERIC(12, 2)) AS price, CAST(1.5 AS NUMERIC) AS amount;")
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from queryTableColumns; got %s", err)
+	}
+
+	if cols[0].Precision != 12 || cols[0].Scale != 2 {
+		t.Errorf("expected NUMERIC(12, 2) to have precision 12 and scale 2; got %d and %d", cols[0].Precision, cols[0].Scale)
+	}
+	if cols[1].Precision != 0 || cols[1].Scale != 0 {
+		t.Errorf("expected column without a declared precision to have precision and scale 0; got %d and %d",
+			cols[1].Precision, cols[1].Scale)
+	}
+}
+
+func Test_ProfileColumn_for_psql_db(t *testing.T) {
+	skipWithoutPsql(t)
+	conf := createPsqlConf()
+	introspector, err := newPsqlIntrospector(psqlTestDb, conf)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the introspector; got %s", err)
+	}
+	p := introspector.(Profiler)
+
+	_, err = psqlTestDb.Exec(`
+		INSERT INTO product (name, counting_option) VALUES ('apple', 'unit'), ('pear', 'unit'), ('banana', 'decimal');
+	`)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when inserting the products; got %s", err)
+	}
+	defer func() {
+		if _, err := psqlTestDb.Exec("TRUNCATE product RESTART IDENTITY CASCADE;"); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	// Enum columns are profiled through their text.
+	cat := readTestCatalog(t, psqlTestDb, conf)
+	col, err := cat.column("counting_option", "public.product")
+	if err != nil {
+		t.Fatal(err)
+	}
+	profile, err := p.ProfileColumn(context.Background(), cat, "public.product", col, 100)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from ProfileColumn; got %s", err)
+	}
+	expected := columnProfile{SampledRows: 3, DistinctCount: 2, Min: "decimal", Max: "unit", AvgLength: 5,
+		TopValues: []valueCount{{"unit", 2}, {"decimal", 1}}}
+	if !reflect.DeepEqual(profile, expected) {
+		t.Errorf("expected the profile %+v; got %+v", expected, profile)
+	}
+
+	// Tables estimated to be larger than the sample are read with TABLESAMPLE.
+	cat.Stats = DBTablesStats{{Table: "public.product", Rows: 1000}}
+	col, err = cat.column("id", "public.product")
+	if err != nil {
+		t.Fatal(err)
+	}
+	profile, err = p.ProfileColumn(context.Background(), cat, "public.product", col, 10)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from ProfileColumn with TABLESAMPLE; got %s", err)
+	}
+	if profile.SampledRows > 3 {
+		t.Errorf("expected at most 3 sampled rows; got %d", profile.SampledRows)
+	}
+}
+
+func Test_CommentStatements_AND_ExecStatements_for_psql_db(t *testing.T) {
+	skipWithoutPsql(t)
+	conf := createPsqlConf()
+	introspector, err := newPsqlIntrospector(psqlTestDb, conf)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the introspector; got %s", err)
+	}
+	writer := introspector.(CommentWriter)
+	cat := readTestCatalog(t, psqlTestDb, conf)
+
+	statements, err := writer.CommentStatements(cat, []descriptionComment{
+		{Table: "public.order", Description: "The orders of our clients."},
+		{Table: "public.order_line_count", Description: "Lines per order."},
+		{Table: "public.order_line", Column: "order_id", Description: "The order's id."},
+	})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from CommentStatements; got %s", err)
+	}
+	expected := []string{
+		`COMMENT ON TABLE "public"."order" IS 'The orders of our clients.'`,
+		`COMMENT ON MATERIALIZED VIEW "public"."order_line_count" IS 'Lines per order.'`,
+		`COMMENT ON COLUMN "public"."order_line"."order_id" IS 'The order''s id.'`,
+	}
+	if len(statements) != len(expected) {
+		t.Fatalf("expected %d statements; got %d", len(expected), len(statements))
+	}
+	for i := range expected {
+		if statements[i] != expected[i] {
+			t.Errorf("expected statement (%s); got (%s)", expected[i], statements[i])
+		}
+	}
+
+	err = writer.ExecStatements(statements[2:])
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from ExecStatements; got %s", err)
+	}
+	defer func() {
+		if _, err := psqlTestDb.Exec("COMMENT ON COLUMN order_line.order_id IS NULL;"); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	cat = readTestCatalog(t, psqlTestDb, conf)
+	if comment := cat.comment("order_id", "public.order_line"); comment != "The order's id." {
+		t.Errorf("expected column order_id of public.order_line to have the comment (The order's id.); got (%s)", comment)
+	}
+}
+
+func Test_getColumnChanges_reports_a_retargeted_foreign_key_for_psql_db(t *testing.T) {
+	skipWithoutPsql(t)
+	conf := createPsqlConf()
+	conf.ForceDelete = true // for testing we force delete of the data in the database.
+
+	_, err := psqlTestDb.Exec(`
+		CREATE TABLE coupon (id INTEGER PRIMARY KEY, code INTEGER NOT NULL UNIQUE);
+		CREATE TABLE coupon_use (id INTEGER PRIMARY KEY,
+			coupon INTEGER CONSTRAINT coupon_use_coupon_fk REFERENCES coupon (id));
+	`)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the tables coupon and coupon_use; got %s", err)
+	}
+	defer psqlTestDb.Exec("DROP TABLE IF EXISTS coupon_use, coupon;")
+
+	storage, err := newJsonStorageIn(t.TempDir())
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from newJsonStorageIn; got %s", err)
+	}
+	introspector, err := newPsqlIntrospector(psqlTestDb, conf)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the introspector; got %s", err)
+	}
+	if err = setupInitialMetadata(storage, conf, introspector); err != nil {
+		t.Fatalf("we shouldn't get an error from setupInitialMetadata; got %s", err)
+	}
+
+	_, err = psqlTestDb.Exec(`
+		ALTER TABLE coupon_use DROP CONSTRAINT coupon_use_coupon_fk;
+		ALTER TABLE coupon_use ADD CONSTRAINT coupon_use_coupon_fk FOREIGN KEY (coupon) REFERENCES coupon (code);
+	`)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when retargeting the foreign key coupon_use_coupon_fk; got %s", err)
+	}
 
+	changes, err := getColumnChanges(storage, readTestCatalog(t, psqlTestDb, conf))
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from getColumnChanges; got %s", err)
+	}
+	if len(changes) != 1 || changes[0].Name != "coupon" || !strings.Contains(changes[0].ChangesMessage,
+		"targeting a different column before it was (id) and now it is (code)") {
+		t.Errorf("expected the column coupon of coupon_use to be reported as retargeted; got %+v", changes)
+	}
+}
+
+func Test_databaseMetaDataSetup_AND_some_repository_methods_for_psql_db(t *testing.T) {
+	skipWithoutPsql(t)
 	conf := createPsqlConf()
 	conf.ForceDelete = true // for testing we force delete of the data in the database.
 
-	storage, err := NewJsonStorage()
+	storage, err := newJsonStorageIn(t.TempDir())
 	if err != nil {
-		t.Fatalf("we shouldn't get an error from NewJsonStorage; got %s", err)
+		t.Fatalf("we shouldn't get an error from newJsonStorageIn; got %s", err)
 	}
 
 	// Test setup.
 
-	err = setupInitialMetadata(storage, conf)
+	introspector, err := introspectors[conf.DatabaseDriver].newIntrospector(psqlTestDb, conf)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the introspector; got %s", err)
+	}
+
+	err = setupInitialMetadata(storage, conf, introspector)
 	if err != nil {
 		t.Fatalf("we shouldn't get an error from databaseMetaDataSetup; got %s", err)
 	}
@@ -281,23 +906,67 @@ func Test_databaseMetaDataSetup_AND_some_repository_methods_for_psql_db(t *testi
 		t.Fatalf("we shouldn't get an error from GetTables; got %s", err)
 	}
 
-	if tables.count() != 3 {
-		t.Fatalf("expected to have 3 tables got %d", tables.count())
+	if tables.count() != 5 {
+		t.Fatalf("expected to have 5 tables got %d", tables.count())
 	}
 
 	expectedTables := []table{
 		{
-			ID:          "order",
+			ID:          "public.order",
+			Schema:      "public",
 			Name:        "order",
+			Kind:        tableKind,
 			Description: "",
+			PrimaryKey:  &keyConstraint{Name: "order_pk", Columns: []string{"id"}},
+			ForeignKeys: []keyConstraint{},
+			Indexes:     []tableIndex{testIndex("order_id_uindex", true, "id"), testIndex("order_pk", true, "id")},
+			Checks:      []checkConstraint{},
 		}, {
-			ID:          "product",
+			ID:          "public.product",
+			Schema:      "public",
 			Name:        "product",
-			Description: "",
+			Kind:        tableKind,
+			Description: "The products we sell.",
+			PrimaryKey:  &keyConstraint{Name: "product_pk", Columns: []string{"id"}},
+			ForeignKeys: []keyConstraint{},
+			Indexes: []tableIndex{
+				testIndex("product_id_uindex", true, "id"),
+				{Name: "product_lower_name_index", Keys: []indexKey{{Expression: "lower(name::text)"}}, Method: "btree"},
+				testIndex("product_name_uindex", true, "name"),
+				testIndex("product_pk", true, "id"),
+			},
+			Checks: []checkConstraint{},
 		}, {
-			ID:          "order_line",
+			ID:          "public.order_line",
+			Schema:      "public",
 			Name:        "order_line",
+			Kind:        tableKind,
 			Description: "",
+			PrimaryKey:  &keyConstraint{Name: "order_line_pk", Columns: []string{"id"}},
+			ForeignKeys: []keyConstraint{
+				{
+					Name:          "order_line_order_id_fk",
+					Columns:       []string{"order_id"},
+					TargetTable:   "public.order",
+					TargetColumns: []string{"id"},
+					DeleteRule:    "RESTRICT",
+					UpdateRule:    "NO ACTION",
+				}, {
+					Name:          "order_line_product_id_fk",
+					Columns:       []string{"product_id"},
+					TargetTable:   "public.product",
+					TargetColumns: []string{"id"},
+					DeleteRule:    "RESTRICT",
+					UpdateRule:    "NO ACTION",
+				},
+			},
+			Indexes: []tableIndex{
+				testIndex("order_line_id_uindex", true, "id"),
+				testIndex("order_line_pk", true, "id"),
+				{Name: "order_line_product_id_index", Keys: []indexKey{{Column: "product_id"}}, Method: "hash",
+					Predicate: "product_id > 0"},
+			},
+			Checks: []checkConstraint{},
 		},
 	}
 
@@ -307,7 +976,7 @@ func Test_databaseMetaDataSetup_AND_some_repository_methods_for_psql_db(t *testi
 			if tb.ID == e.ID {
 				exists = true
 				if !reflect.DeepEqual(tb, e) {
-					t.Errorf("expected table data to be %+v; got %+v instead", e, t)
+					t.Errorf("expected table data to be %+v; got %+v instead", e, tb)
 				}
 				break
 			}
@@ -325,14 +994,14 @@ func Test_databaseMetaDataSetup_AND_some_repository_methods_for_psql_db(t *testi
 		t.Errorf("database info (%+v) differs from current conf (%+v)", databaseInfo, conf)
 	}
 
-	orderTable, _ := tables.get("order")
+	orderTable, _ := tables.get("public.order")
 	err = storage.UpdateAddTableDescription(orderTable.ID, "I am a cool table.")
 	if err != nil {
 		t.Fatalf("we shouldn't get any error from UpdateAddTableDescription; got %s", err)
 	}
 
 	tables, _ = storage.GetTables()
-	updatedTable, _ := tables.get("order")
+	updatedTable, _ := tables.get("public.order")
 	if updatedTable.Description != "I am a cool table." {
 		t.Errorf("expected tables description to be (%s); got %s instead", "I am a cool table.",
 			updatedTable.Description)
@@ -343,11 +1012,19 @@ func Test_databaseMetaDataSetup_AND_some_repository_methods_for_psql_db(t *testi
 		t.Fatalf("we shouldn't get an error from GetColumns; got %s", err)
 	}
 
-	if len(columns) != 7 {
-		t.Fatalf("expected 7 columns got %d", len(columns))
+	if len(columns) != 11 {
+		t.Fatalf("expected 11 columns got %d", len(columns))
 	}
 
-	productNameCol, err := columns.getByColNameAndTableName("name", "product")
+	orderIDCol, err := columns.getByColNameAndTableName("order_id", "public.order_line")
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from getByColNameAndTableName; got %s", err)
+	}
+	if orderIDCol.TargetColFK != "id" {
+		t.Errorf("expected column order_id to reference the column (id); got (%s)", orderIDCol.TargetColFK)
+	}
+
+	productNameCol, err := columns.getByColNameAndTableName("name", "public.product")
 	if err != nil {
 		t.Fatalf("we shouldn't get an error from getByColNameAndTableName; got %s", err)
 	}
@@ -362,9 +1039,33 @@ func Test_databaseMetaDataSetup_AND_some_repository_methods_for_psql_db(t *testi
 		t.Fatalf("we shouldn't get an error from GetColumns; got %s", err)
 	}
 
-	productNameCol, _ = columns.getByColNameAndTableName("name", "product")
+	productNameCol, _ = columns.getByColNameAndTableName("name", "public.product")
 	if productNameCol.Description != "I have a nice name." {
 		t.Errorf("expected description to be (%s) in column %s in table %s got %s", "I have a nice name.",
 			productNameCol.Name, productNameCol.TBName, productNameCol.Description)
 	}
+
+	// The comments of the database should never overwrite the descriptions, but they should be imported into
+	// the empty ones and conflicts should be reported.
+	err = storage.UpdateAddTableDescription("public.product", "")
+	if err != nil {
+		t.Fatalf("we shouldn't get any error from UpdateAddTableDescription; got %s", err)
+	}
+	newDescriptions, conflicts, err := getDescriptionComments(storage, readTestCatalog(t, psqlTestDb, conf))
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from getDescriptionComments; got %s", err)
+	}
+	expectedNewDescriptions := []descriptionComment{
+		{Table: "public.product", Comment: "The products we sell."},
+	}
+	if !reflect.DeepEqual(newDescriptions, expectedNewDescriptions) {
+		t.Errorf("expected new descriptions %+v; got %+v", expectedNewDescriptions, newDescriptions)
+	}
+	expectedConflicts := []descriptionComment{
+		{Table: "public.product", Column: "name", ColumnID: productNameCol.ID, Description: "I have a nice name.",
+			Comment: "The name of the product."},
+	}
+	if !reflect.DeepEqual(conflicts, expectedConflicts) {
+		t.Errorf("expected description conflicts %+v; got %+v", expectedConflicts, conflicts)
+	}
 }
@@ -1 +1 @@
-"use strict";var _createClass=function(){function e(e,t){for(var n=0;n<t.length;n++){var a=t[n];a.enumerable=a.enumerable||!1,a.configurable=!0,"value"in a&&(a.writable=!0),Object.defineProperty(e,a.key,a)}}return function(t,n,a){return n&&e(t.prototype,n),a&&e(t,a),t}}();function _classCallCheck(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function _possibleConstructorReturn(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function _inherits(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}var e=React.createElement,DatabaseInfo=function(e){function t(e){_classCallCheck(this,t);var n=_possibleConstructorReturn(this,(t.__proto__||Object.getPrototypeOf(t)).call(this,e));return n.syncDatabase=function(){var e=window.location.protocol+"//"+window.location.host+"/sync-db";n.setState({syncIndicator:!0}),fetch(e,{method:"POST"}).then((function(e){if(n.setState({syncIndicator:!1}),200===e.status)return alert("The database has been synced successfully."),void(window.location.href="/");e.text().then((function(e){alert("An error occurred, your database might not be synced completely. Please run again the sync function: \n"+e)}))})).catch((function(e){console.log(e),this.setState({syncIndicator:!1}),alert("An error occurred, your database might not be synced completely. Please run again the sync function: \n"+e)}))},n.checkDatabaseChanges=function(){var e=window.location.protocol+"//"+window.location.host+"/check-changes";n.setState({checkIndicator:!0}),fetch(e,{method:"GET"}).then((function(e){n.setState({checkIndicator:!1}),200===e.status?e.json().then((function(e){var t=e.new_tables,a=e.deleted_tables,o=e.column_changes,l=e.deleted_columns,s=e.new_columns;if(0!==t.length||0!==a.length||0!==o.length||0!==l.length||0!==s.length){var r="Before syncing the database scroll down and check all changes detected by godic, if you want to proceed with the synchronization press OK. When existing columns are updated godic will remove the previous descriptions saved, so godic will force you to update the columns's descriptions.\n\n";if(t.length>0){r+="\nThere are new tables created:\n";for(var c=0;c<t.length;c++)r+="- "+t[c]+"\n"}if(a.length>0){r+="\nSome tables have been deleted:\n";for(var i=0;i<a.length;i++)r+="- "+a[i]+"\n"}if(o.length>0){r+="\nThere has been some changes in existing columns:\n";for(var u=0;u<o.length;u++)r+="- column ("+o[u].metadata.name+") in table ("+o[u].metadata.table_name+") suffered the following changes:\n"+o[u].changes_message+"\n"}if(l.length>0){r+="\nSome columns have been deleted:\n";for(var d=0;d<l.length;d++)r+="- column ("+l[d].name+") in table ("+l[d].table+")\n"}if(s.length>0){r+="\nThere are some new columns in existing tables:\n";for(var h=0;h<s.length;h++)r+="- new column ("+s[h].name+") in table ("+s[h].table+")\n"}confirm(r)&&n.syncDatabase()}else alert("Database does not have any changes. It is up-to-date.")})):e.text().then((function(e){alert("An error occurred: "+e)}))})).catch((function(e){this.setState({checkIndicator:!1}),console.log(e)}))},n.state={info:data.DatabaseInfo,syncIndicator:!1,checkIndicator:!1},n.syncDatabase=n.syncDatabase.bind(n),n.checkDatabaseChanges=n.checkDatabaseChanges.bind(n),n}return _inherits(t,React.Component),_createClass(t,[{key:"render",value:function(){var e=this.state.syncIndicator,t=this.state.checkIndicator,n=void 0;return n=e?React.createElement(SyncIndicator,{text:"Syncing database, please wait"}):t?React.createElement(SyncIndicator,{text:"Checking database changes, please wait"}):null,React.createElement("div",null,n,React.createElement("button",{style:{width:60,cursor:"pointer",marginBottom:20},type:"button",onClick:this.checkDatabaseChanges},"Sync"),React.createElement("p",{style:styles.p},React.createElement("strong",null,"Database name: "),this.state.info.name),React.createElement("p",{style:styles.p},React.createElement("strong",null,"Database user: "),this.state.info.user),React.createElement("p",{style:styles.p},React.createElement("strong",null,"Database host: "),this.state.info.host),React.createElement("p",{style:styles.p},React.createElement("strong",null,"Database driver: "),this.state.info.driver),React.createElement("p",{style:styles.p},React.createElement("strong",null,"Database schema: "),this.state.info.schema),React.createElement("p",{style:styles.p},React.createElement("strong",null,"Database port: "),this.state.info.port))}}]),t}(),SyncIndicator=function(e){function t(e){_classCallCheck(this,t);var n=_possibleConstructorReturn(this,(t.__proto__||Object.getPrototypeOf(t)).call(this,e));_initialiseProps.call(n);var a=n.props.text;return n.state={text:a},n.changeText=n.changeText.bind(n),n}return _inherits(t,React.Component),_createClass(t,[{key:"componentDidMount",value:function(){this._isMounted=!0,setInterval(this.changeText,500)}},{key:"componentWillUnmount",value:function(){this._isMounted=!1}},{key:"render",value:function(){return React.createElement("h1",null,this.state.text)}}]),t}(),_initialiseProps=function(){var e=this;this._isMounted=!1,this.changeText=function(){var t=e.state.text,n=t.indexOf(".");if(-1===n)t+=".";else{var a=t.slice(n,t.length);1===a.length||2===a.length?t+=".":t=t.substr(0,n)}e._isMounted&&e.setState({text:t})}},TablesData=function(e){function t(e){_classCallCheck(this,t);var n=_possibleConstructorReturn(this,(t.__proto__||Object.getPrototypeOf(t)).call(this,e));return n.updateTableDictionary=function(e){var t=e.target.getAttribute("data-table-idx"),a=e.target.getAttribute("data-table-name"),o=window.location.protocol+"//"+window.location.host+"/update",l=n.state.tables[t],s=l.columns,r=[];if(confirm("Are you sure you want to update the dictionary of table "+a+"?")){for(var c=0;c<s.length;c++){var i={};i.col_id=s[c].id,i.description=s[c].description,r.push(i)}fetch(o,{method:"POST",body:JSON.stringify({table_id:l.id,table_description:l.description,columns_data:r})}).then((function(e){200===e.status?alert("table "+a+" has been updated successfully."):e.text().then((function(e){alert("An error occurred: "+e)}))})).catch((function(e){console.log(e)}))}},n.onChangeTableDesc=function(e){var t=e.target.getAttribute("data-table-idx"),a=n.state.tables;a[t].description=e.target.value,n.setState({tables:a})},n.onChangeColumnDesc=function(e){var t=e.target.getAttribute("data-table-idx"),a=e.target.getAttribute("data-col-idx"),o=n.state.tables;o[t].columns[a].description=e.target.value,n.setState({tables:o})},n.state={tables:[]},n.onChangeColumnDesc=n.onChangeColumnDesc.bind(n),n.onChangeTableDesc=n.onChangeTableDesc.bind(n),n}return _inherits(t,React.Component),_createClass(t,[{key:"componentDidMount",value:function(){for(var e=data.Tables,t=data.Columns,n=0;n<e.length;n++){for(var a=[],o=0;o<t.length;o++)t[o].table_name===e[n].name&&a.push(t[o]);for(var l=!1,s=[],r=0;r<a.length;r++)!0===a[r].is_primary_key?l=r:!0===a[r].is_foreign_key&&s.push(r);if("number"==typeof l){var c=a.splice(l,1)[0];a.splice(0,0,c)}for(var i=0;i<s.length;i++){var u=a.splice(s[i],1)[0];a.splice(1,0,u)}for(var d=0;d<a.length;d++)a[d].has_enum&&(a[d].db_type="ENUM("+a[d].enum_values.join()+")");e[n].columns=a}this.setState({tables:e})}},{key:"rendeTables",value:function(){var e=this;return this.state.tables.map((function(t,n){return React.createElement(Table,{key:n,tableIdx:n,tableName:t.name,tableID:t.id,tableDescription:t.description,tableColumns:t.columns,onChangeColumnDesc:e.onChangeColumnDesc,onChangeTableDesc:e.onChangeTableDesc,onClickSave:e.updateTableDictionary})}))}},{key:"render",value:function(){return React.createElement("div",null,this.rendeTables(),React.createElement(TopBtn,null))}}]),t}(),Table=function(e){function t(){var e,n,a;_classCallCheck(this,t);for(var o=arguments.length,l=Array(o),s=0;s<o;s++)l[s]=arguments[s];return n=a=_possibleConstructorReturn(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(l))),a.renderColumns=function(){return a.props.tableColumns.map((function(e,t){var n="";e.is_primary_key?n="PK":e.is_foreign_key&&(n="FK");var o=e.db_type;"VARCHAR"===e.db_type.toUpperCase()&&(o=o+"("+e.length+")");var l=!0===e.nullable?"YES":"NO",s=!0===e.is_unique?"YES":"NO";return React.createElement("tr",{key:t},React.createElement("td",{style:styles.table},n),React.createElement("td",{style:styles.table},e.name),React.createElement("td",{style:styles.table},o),React.createElement("td",{style:styles.table},l),React.createElement("td",{style:styles.table},s),React.createElement("td",{"data-table":e.table_name,"data-column-id":e.id,style:styles.table},React.createElement("textarea",{"data-table-idx":a.props.tableIdx,"data-table":e.table_name,"data-col-id":e.id,"data-col-idx":t,onChange:a.props.onChangeColumnDesc,rows:"5",cols:"50",value:e.description})))}))},_possibleConstructorReturn(a,n)}return _inherits(t,React.Component),_createClass(t,[{key:"render",value:function(){return React.createElement("div",{style:{marginTop:50}},React.createElement("p",{style:styles.p},React.createElement("strong",null,"Table: "),this.props.tableName),React.createElement("p",{style:styles.p},React.createElement("strong",null,"Description:")),React.createElement("div",{style:{display:"flex"}},React.createElement("textarea",{"data-table-idx":this.props.tableIdx,onChange:this.props.onChangeTableDesc,rows:"4",cols:"80",value:this.props.tableDescription}),React.createElement("button",{style:{width:60,cursor:"pointer"},type:"button","data-table-name":this.props.tableName,"data-table-idx":this.props.tableIdx,onClick:this.props.onClickSave},"save")),React.createElement("table",{style:styles.table},React.createElement("thead",null,React.createElement("tr",null,React.createElement("th",{style:styles.table},"Key"),React.createElement("th",{style:styles.table},"Attribute"),React.createElement("th",{style:styles.table},"Data Type"),React.createElement("th",{style:styles.table},"Nullable"),React.createElement("th",{style:styles.table},"Unique"),React.createElement("th",{style:styles.table},"Description"))),React.createElement("tbody",null,this.renderColumns())))}}]),t}(),TopBtn=function(e){function t(e){_classCallCheck(this,t);var n=_possibleConstructorReturn(this,(t.__proto__||Object.getPrototypeOf(t)).call(this,e));return n.state={btn_display_style:"none",btn_opacity:.4},n.handleScroll=n.handleScroll.bind(n),n}return _inherits(t,React.Component),_createClass(t,[{key:"componentDidMount",value:function(){window.addEventListener("scroll",this.handleScroll)}},{key:"componentWillUnmount",value:function(){window.removeEventListener("scroll",this.handleScroll)}},{key:"handleScroll",value:function(){var e=document.body.scrollTop,t=document.documentElement.scrollTop;e>20||t>20?this.setState({btn_display_style:"block"}):this.setState({btn_display_style:"None"})}},{key:"render",value:function(){var e=this,t={};return t.top_btn={display:this.state.btn_display_style,position:"fixed",bottom:20,right:30,zIndex:99,fontSize:18,border:"none",outline:"none",color:"grey",cursor:"pointer",padding:15,borderRadius:4,opacity:this.state.btn_opacity},React.createElement("div",null,React.createElement("button",{style:t.top_btn,id:"myBtn",onClick:function(){document.documentElement.scrollTop=0},onMouseOver:function(){return e.setState({btn_opacity:.8})},onMouseOut:function(){return e.setState({btn_opacity:.4})}},"Go to top"))}}]),t}(),styles={p:{margin:0},table:{border:"1px solid black"},saveBtn:{color:"white",padding:"15px 32px",textAlign:"center",fontSize:16,cursor:"pointer",backgroundColor:"#008CBA",marginLeft:3,outline:"none"}},domContainer=document.querySelector("#databaseInfo"),domContainerTwo=document.querySelector("#tablesData");ReactDOM.render(e(DatabaseInfo),domContainer),ReactDOM.render(e(TablesData),domContainerTwo);
\ No newline at end of file
+"use strict";var _createClass=function(){function e(e,t){for(var n=0;n<t.length;n++){var a=t[n];a.enumerable=a.enumerable||!1,a.configurable=!0,"value"in a&&(a.writable=!0),Object.defineProperty(e,a.key,a)}}return function(t,n,a){return n&&e(t.prototype,n),a&&e(t,a),t}}();function _classCallCheck(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function _possibleConstructorReturn(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function _inherits(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}function schemaOfTable(e){for(var t=data.Tables,n=0;n<t.length;n++)if(t[n].id===e)return t[n].schema||"";return""}function groupBySchema(e,t,n){for(var a={},o=[],l=0;l<e.length;l++){var s=t(e[l])||"";s in a||(a[s]=[],o.push(s)),a[s].push(e[l])}o.sort();for(var r="",c=0;c<o.length;c++){""!==o[c]&&(r+="schema ("+o[c]+"):\n");for(var i=0;i<a[o[c]].length;i++)r+=n(a[o[c]][i])}return r}function formatBytes(e){for(var t=["B","kB","MB","GB","TB"],n=0;e>=1024&&n<t.length-1;)e/=1024,n++;return(0===n?e:e.toFixed(1))+" "+t[n]}var e=React.createElement,DatabaseInfo=function(e){function t(e){_classCallCheck(this,t);var n=_possibleConstructorReturn(this,(t.__proto__||Object.getPrototypeOf(t)).call(this,e));return n.syncDatabase=function(){var e=window.location.protocol+"//"+window.location.host+"/sync-db";n.setState({syncIndicator:!0}),fetch(e,{method:"POST"}).then((function(e){if(n.setState({syncIndicator:!1}),200===e.status)return alert("The database has been synced successfully."),void(window.location.href="/");e.text().then((function(e){alert("An error occurred, your database might not be synced completely. Please run again the sync function: \n"+e)}))})).catch((function(e){console.log(e),this.setState({syncIndicator:!1}),alert("An error occurred, your database might not be synced completely. Please run again the sync function: \n"+e)}))},n.checkDatabaseChanges=function(){var e=window.location.protocol+"//"+window.location.host+"/check-changes";n.setState({checkIndicator:!0}),fetch(e,{method:"GET"}).then((function(e){n.setState({checkIndicator:!1}),200===e.status?e.json().then((function(e){var t=e.new_tables,a=e.deleted_tables,c=e.table_changes,o=e.column_changes,l=e.deleted_columns,s=e.new_columns,d=e.new_descriptions,u=e.description_conflicts,i=e.new_routines,m=e.deleted_routines,h=e.routine_changes,y=e.new_types,v=e.deleted_types,g=e.type_changes;if(0!==t.length||0!==a.length||0!==c.length||0!==o.length||0!==l.length||0!==s.length||0!==d.length||0!==u.length||0!==i.length||0!==m.length||0!==h.length||0!==y.length||0!==v.length||0!==g.length){var r="Before syncing the database scroll down and check all changes detected by godic, if you want to proceed with the synchronization press OK. When existing columns are updated godic will keep the descriptions saved, so review them in case they do not match the changes anymore.\n\n";t.length>0&&(r+="\nThere are new tables created:\n",r+=groupBySchema(t,(function(e){return e.schema}),(function(e){return"- "+e.name+"\n"}))),a.length>0&&(r+="\nSome tables have been deleted:\n",r+=groupBySchema(a,(function(e){return e.schema}),(function(e){return"- "+e.name+"\n"}))),c.length>0&&(r+="\nThere has been some changes in the keys of existing tables:\n",r+=groupBySchema(c,(function(e){return e.metadata.schema}),(function(e){return"- table ("+e.metadata.name+") suffered the following changes:\n"+e.changes_message+"\n"}))),o.length>0&&(r+="\nThere has been some changes in existing columns:\n",r+=groupBySchema(o,(function(e){return schemaOfTable(e.metadata.table_name)}),(function(e){return"- column ("+e.metadata.name+") in table ("+e.metadata.table_name+") suffered the following changes:\n"+e.changes_message+"\n"}))),l.length>0&&(r+="\nSome columns have been deleted:\n",r+=groupBySchema(l,(function(e){return schemaOfTable(e.table)}),(function(e){return"- column ("+e.name+") in table ("+e.table+")\n"}))),s.length>0&&(r+="\nThere are some new columns in existing tables:\n",r+=groupBySchema(s,(function(e){return schemaOfTable(e.table)}),(function(e){return"- new column ("+e.name+") in table ("+e.table+")\n"}))),d.length>0&&(r+="\nSome comments of the database will be imported as descriptions:\n",r+=groupBySchema(d,(function(e){return schemaOfTable(e.table)}),(function(e){return(e.column?"- column ("+e.column+") in table ("+e.table+")":"- table ("+e.table+")")+": "+e.comment+"\n"}))),u.length>0&&(r+="\nSome descriptions do not match the comments of the database, the descriptions will be kept:\n",r+=groupBySchema(u,(function(e){return schemaOfTable(e.table)}),(function(e){return(e.column?"- column ("+e.column+") in table ("+e.table+")":"- table ("+e.table+")")+":\n  description: "+e.description+"\n  comment: "+e.comment+"\n"}))),i.length>0&&(r+="\nThere are new functions, procedures or triggers:\n",r+=groupBySchema(i,(function(e){return e.schema}),(function(e){return"- "+e.kind+" ("+e.name+")\n"}))),m.length>0&&(r+="\nSome functions, procedures or triggers have been deleted:\n",r+=groupBySchema(m,(function(e){return e.schema}),(function(e){return"- "+e.kind+" ("+e.name+")\n"}))),h.length>0&&(r+="\nThere has been some changes in existing functions, procedures or triggers:\n",r+=groupBySchema(h,(function(e){return e.metadata.schema}),(function(e){return"- "+e.metadata.kind+" ("+e.metadata.name+") suffered the following changes:\n"+e.changes_message+"\n"}))),y.length>0&&(r+="\nThere are new user defined types:\n",r+=groupBySchema(y,(function(e){return e.schema}),(function(e){return"- "+e.kind+" ("+e.name+")\n"}))),v.length>0&&(r+="\nSome user defined types have been deleted:\n",r+=groupBySchema(v,(function(e){return e.schema}),(function(e){return"- "+e.kind+" ("+e.name+")\n"}))),g.length>0&&(r+="\nThere has been some changes in existing user defined types:\n",r+=groupBySchema(g,(function(e){return e.metadata.schema}),(function(e){return"- "+e.metadata.kind+" ("+e.metadata.name+") suffered the following changes:\n"+e.changes_message+"\n"}))),confirm(r)&&n.syncDatabase()}else confirm("Database does not have any changes. It is up-to-date.\n\nDo you want to sync it anyway to record the current statistics of the tables?")&&n.syncDatabase()})):e.text().then((function(e){alert("An error occurred: "+e)}))})).catch((function(e){this.setState({checkIndicator:!1}),console.log(e)}))},n.profileDatabase=function(){var e=window.location.protocol+"//"+window.location.host+"/profile";n.setState({profileIndicator:!0}),fetch(e,{method:"POST"}).then((function(e){if(n.setState({profileIndicator:!1}),200===e.status)return void e.json().then((function(e){var t=e.profiled.length+" column(s) profiled.";e.skipped.length>0&&(t+="\n"+e.skipped.length+" column(s) left for the next run because the time budget ran out: "+e.skipped.join(", ")),alert(t),window.location.href="/"}));e.text().then((function(e){alert("An error occurred while profiling the tables: \n"+e)}))})).catch((function(e){console.log(e),this.setState({profileIndicator:!1})}))},n.state={info:data.DatabaseInfo,syncIndicator:!1,checkIndicator:!1,profileIndicator:!1},n.syncDatabase=n.syncDatabase.bind(n),n.checkDatabaseChanges=n.checkDatabaseChanges.bind(n),n.profileDatabase=n.profileDatabase.bind(n),n}return _inherits(t,React.Component),_createClass(t,[{key:"render",value:function(){var e=this.state.syncIndicator,t=this.state.checkIndicator,a=this.state.profileIndicator,n=void 0;return n=e?React.createElement(SyncIndicator,{text:"Syncing database, please wait"}):t?React.createElement(SyncIndicator,{text:"Checking database changes, please wait"}):a?React.createElement(SyncIndicator,{text:"Profiling tables, please wait"}):null,React.createElement("div",null,n,React.createElement("button",{style:{width:60,cursor:"pointer",marginBottom:20},type:"button",onClick:this.checkDatabaseChanges},"Sync"),React.createElement("button",{style:{width:60,cursor:"pointer",marginBottom:20,marginLeft:10},type:"button",onClick:this.profileDatabase},"Profile"),React.createElement("p",{style:styles.p},React.createElement("strong",null,"Database name: "),this.state.info.name),React.createElement("p",{style:styles.p},React.createElement("strong",null,"Database user: "),this.state.info.user),React.createElement("p",{style:styles.p},React.createElement("strong",null,"Database host: "),this.state.info.host),React.createElement("p",{style:styles.p},React.createElement("strong",null,"Database driver: "),this.state.info.driver),React.createElement("p",{style:styles.p},React.createElement("strong",null,"Database schema: "),this.state.info.schema),React.createElement("p",{style:styles.p},React.createElement("strong",null,"Database port: "),this.state.info.port))}}]),t}(),SyncIndicator=function(e){function t(e){_classCallCheck(this,t);var n=_possibleConstructorReturn(this,(t.__proto__||Object.getPrototypeOf(t)).call(this,e));_initialiseProps.call(n);var a=n.props.text;return n.state={text:a},n.changeText=n.changeText.bind(n),n}return _inherits(t,React.Component),_createClass(t,[{key:"componentDidMount",value:function(){this._isMounted=!0,setInterval(this.changeText,500)}},{key:"componentWillUnmount",value:function(){this._isMounted=!1}},{key:"render",value:function(){return React.createElement("h1",null,this.state.text)}}]),t}(),_initialiseProps=function(){var e=this;this._isMounted=!1,this.changeText=function(){var t=e.state.text,n=t.indexOf(".");if(-1===n)t+=".";else{var a=t.slice(n,t.length);1===a.length||2===a.length?t+=".":t=t.substr(0,n)}e._isMounted&&e.setState({text:t})}},TablesData=function(e){function t(e){_classCallCheck(this,t);var n=_possibleConstructorReturn(this,(t.__proto__||Object.getPrototypeOf(t)).call(this,e));return n.updateTableDictionary=function(e){var t=e.target.getAttribute("data-table-idx"),a=e.target.getAttribute("data-table-name"),o=window.location.protocol+"//"+window.location.host+"/update",l=n.state.tables[t],s=l.columns,r=[];if(confirm("Are you sure you want to update the dictionary of table "+a+"?")){for(var c=0;c<s.length;c++){var i={};i.col_id=s[c].id,i.description=s[c].description,r.push(i)}fetch(o,{method:"POST",body:JSON.stringify({table_id:l.id,table_description:l.description,columns_data:r})}).then((function(e){200===e.status?alert("table "+a+" has been updated successfully."):e.text().then((function(e){alert("An error occurred: "+e)}))})).catch((function(e){console.log(e)}))}},n.updateTableProfiling=function(e){var t=e.target.getAttribute("data-table-idx"),a=e.target.checked,o=window.location.protocol+"//"+window.location.host+"/update-profiling",l=n.state.tables;fetch(o,{method:"POST",body:JSON.stringify({table_id:l[t].id,profiling:a})}).then((function(e){200===e.status?(l[t].profiling=a,n.setState({tables:l})):e.text().then((function(e){alert("An error occurred: "+e)}))})).catch((function(e){console.log(e)}))},n.onChangeTableDesc=function(e){var t=e.target.getAttribute("data-table-idx"),a=n.state.tables;a[t].description=e.target.value,n.setState({tables:a})},n.onChangeColumnDesc=function(e){var t=e.target.getAttribute("data-table-idx"),a=e.target.getAttribute("data-col-idx"),o=n.state.tables;o[t].columns[a].description=e.target.value,n.setState({tables:o})},n.state={tables:[]},n.onChangeColumnDesc=n.onChangeColumnDesc.bind(n),n.onChangeTableDesc=n.onChangeTableDesc.bind(n),n}return _inherits(t,React.Component),_createClass(t,[{key:"componentDidMount",value:function(){var e=data.Tables,t=data.Columns,p=data.Stats||[];e.sort((function(e,t){return(e.schema||"").localeCompare(t.schema||"")||e.name.localeCompare(t.name)}));for(var n=0;n<e.length;n++){for(var a=[],o=0;o<t.length;o++)t[o].table_name===e[n].id&&a.push(t[o]);a.sort((function(e,t){return(e.position||1/0)-(t.position||1/0)||e.name.localeCompare(t.name)}));for(var d=0;d<a.length;d++)a[d].has_enum&&(a[d].db_type="ENUM("+a[d].enum_values.join()+")");e[n].columns=a,e[n].stats=(p.find((function(t){return t.table===e[n].id}))||{samples:[]}).samples}this.setState({tables:e})}},{key:"rendeTables",value:function(){var e=this,a=this.state.tables;return a.map((function(t,n){return React.createElement(Table,{key:n,tableIdx:n,schemaHeader:t.schema&&(0===n||a[n-1].schema!==t.schema)?t.schema:"",tableName:t.name,tableID:t.id,tableKind:t.kind||"table",tableDefinition:t.definition,tableDescription:t.description,tablePrimaryKey:t.primary_key,tableForeignKeys:t.foreign_keys||[],tableIndexes:t.indexes||[],tableChecks:t.checks||[],tablePartitionKey:t.partition_key,tablePartitions:t.partitions||[],tableInherits:t.inherits||[],tableCharset:t.charset,tableCollation:t.collation,tableColumns:t.columns,tableStats:t.stats,tableProfiling:t.profiling||!1,onChangeColumnDesc:e.onChangeColumnDesc,onChangeTableDesc:e.onChangeTableDesc,onClickSave:e.updateTableDictionary,onChangeProfiling:e.updateTableProfiling})}))}},{key:"render",value:function(){return React.createElement("div",null,this.rendeTables(),React.createElement(RoutinesData,null),React.createElement(TypesData,null),React.createElement(SequencesData,null),React.createElement(TopBtn,null))}}]),t}(),Table=function(e){function t(){var e,n,a;_classCallCheck(this,t);for(var o=arguments.length,l=Array(o),s=0;s<o;s++)l[s]=arguments[s];return n=a=_possibleConstructorReturn(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(l))),a.renderKeys=function(){var e=[],t=a.props.tablePrimaryKey;return t&&e.push(React.createElement("p",{key:"pk",style:styles.p},React.createElement("strong",null,"Primary key: "),t.name," (",t.columns.join(", "),")")),a.props.tableForeignKeys.forEach((function(t,n){e.push(React.createElement("p",{key:"fk"+n,style:styles.p},React.createElement("strong",null,"Foreign key: "),t.name," (",t.columns.join(", "),") references ",t.target_table," (",(t.target_columns||[]).join(", "),") ON DELETE ",t.delete_rule," ON UPDATE ",t.update_rule))})),a.props.tableIndexes.forEach((function(t,n){e.push(React.createElement("p",{key:"idx"+n,style:styles.p},React.createElement("strong",null,t.unique?"Unique index: ":"Index: "),t.name," using ",t.method," (",t.keys.map((function(e){return e.column||"("+e.expression+")"})).join(", "),")",t.predicate?" WHERE "+t.predicate:""))})),a.props.tableChecks.forEach((function(t,n){e.push(React.createElement("p",{key:"chk"+n,style:styles.p},React.createElement("strong",null,"Check: "),t.name," CHECK (",t.clause,")"))})),a.props.tableCollation&&e.push(React.createElement("p",{key:"collation",style:styles.p},React.createElement("strong",null,"Character set: "),a.props.tableCharset,", ",React.createElement("strong",null,"collation: "),a.props.tableCollation)),a.props.tableInherits.length>0&&e.push(React.createElement("p",{key:"inherits",style:styles.p},React.createElement("strong",null,"Inherits from: "),a.props.tableInherits.join(", "))),a.props.tablePartitionKey&&e.push(React.createElement("p",{key:"partition-key",style:styles.p},React.createElement("strong",null,"Partitioned by: "),a.props.tablePartitionKey)),a.props.tablePartitions.forEach((function(t,n){e.push(React.createElement("p",{key:"part"+n,style:styles.p},React.createElement("strong",null,"Partition: "),t.name," ",t.bound))})),e},a.renderStats=function(){var e=a.props.tableStats;if(0===e.length)return null;var t=e[0],n=e[e.length-1],o=function(e,t,n){var a=t-e,o=e>0?" ("+(a>=0?"+":"")+(100*a/e).toFixed(1)+"%)":"";return(a>=0?"+":"-")+n(Math.abs(a))+o},l=function(e){return new Date(e).toLocaleString()};return React.createElement("div",null,React.createElement("p",{style:styles.p},React.createElement("strong",null,"Statistics: "),"~",n.rows," rows, table size ",formatBytes(n.table_size),", indexes size ",formatBytes(n.index_size)," (synced on ",l(n.time),")"),n.last_vacuum||n.last_analyze?React.createElement("p",{style:styles.p},React.createElement("strong",null,"Last vacuum: "),n.last_vacuum?l(n.last_vacuum):"never",",",React.createElement("strong",null," last analyze: "),n.last_analyze?l(n.last_analyze):"never"):null,e.length>1?React.createElement("details",null,React.createElement("summary",null,React.createElement("strong",null,"Growth since ",l(t.time),": "),o(t.rows,n.rows,(function(e){return e}))," rows, table size ",o(t.table_size,n.table_size,formatBytes),", indexes size ",o(t.index_size,n.index_size,formatBytes)),React.createElement("table",{style:styles.table},React.createElement("thead",null,React.createElement("tr",null,React.createElement("th",{style:styles.table},"Synced on"),React.createElement("th",{style:styles.table},"Rows"),React.createElement("th",{style:styles.table},"Table size"),React.createElement("th",{style:styles.table},"Indexes size"))),React.createElement("tbody",null,e.map((function(e,t){return React.createElement("tr",{key:t},React.createElement("td",{style:styles.table},l(e.time)),React.createElement("td",{style:styles.table},e.rows),React.createElement("td",{style:styles.table},formatBytes(e.table_size)),React.createElement("td",{style:styles.table},formatBytes(e.index_size)))}))))):null)},a.renderProfile=function(e){if(!e)return null;var t=(e.top_values||[]).map((function(e){return e.value+" ("+e.count+")"})).join(", ");return React.createElement("div",{title:"Profiled on "+new Date(e.time).toLocaleString()+" from "+e.sampled_rows+" sampled rows"},"nulls ",(100*e.null_fraction).toFixed(1),"%, ",e.distinct_count," distinct",React.createElement("br",null),"min ",e.min,", max ",e.max,React.createElement("br",null),e.avg_length?React.createElement("span",null,"avg length ",e.avg_length,React.createElement("br",null)):null,t?"top: "+t:null)},a.renderColumns=function(){return a.props.tableColumns.map((function(e,t){var n="";e.is_primary_key?n="PK":e.is_foreign_key&&(n="FK");var o=e.db_type;"VARCHAR"===e.db_type.toUpperCase()&&(o=o+"("+e.length+")"),"domain"===e.type_kind?(o=e.user_type+" (domain over "+e.base_type+")",e.domain_checks&&(o+=", "+e.domain_checks.join(", "))):"composite"===e.type_kind?o=e.user_type+" (composite)":"range"===e.type_kind?o=(e.user_type||o)+" (range of "+e.base_type+")":"array"===e.type_kind&&(o=e.base_type+"[]"),e.collation&&"default"!==e.collation&&(o+=" ("+[e.charset,e.collation].filter(Boolean).join(", ")+")"),a.props.tableChecks.filter((function(t){return(t.columns||[]).includes(e.name)})).forEach((function(t){o+=", CHECK ("+t.clause+")"}));var l=!0===e.nullable?"YES":"NO",s=!0===e.is_unique?"YES":"NO",r=[e.default,e.identity?"identity ("+e.identity+")":"",e.generation_expression?"generated as "+e.generation_expression:"",e.extra].filter(Boolean).join(", ");return React.createElement("tr",{key:t},React.createElement("td",{style:styles.table},n),React.createElement("td",{style:styles.table},e.name),React.createElement("td",{style:styles.table},o),React.createElement("td",{style:styles.table},l),React.createElement("td",{style:styles.table},s),React.createElement("td",{style:styles.table},r),React.createElement("td",{style:styles.table},a.renderProfile(e.profile)),React.createElement("td",{"data-table":e.table_name,"data-column-id":e.id,style:styles.table},React.createElement("textarea",{"data-table-idx":a.props.tableIdx,"data-table":e.table_name,"data-col-id":e.id,"data-col-idx":t,onChange:a.props.onChangeColumnDesc,rows:"5",cols:"50",value:e.description})))}))},_possibleConstructorReturn(a,n)}return _inherits(t,React.Component),_createClass(t,[{key:"render",value:function(){var e=this.props.tableKind.charAt(0).toUpperCase()+this.props.tableKind.slice(1);return React.createElement("div",{style:{marginTop:50}},this.props.schemaHeader?React.createElement("h2",null,"Schema: ",this.props.schemaHeader):null,React.createElement("p",{style:styles.p},React.createElement("strong",null,e,": "),this.props.tableName),React.createElement("p",{style:styles.p},React.createElement("strong",null,"Description:")),React.createElement("div",{style:{display:"flex"}},React.createElement("textarea",{"data-table-idx":this.props.tableIdx,onChange:this.props.onChangeTableDesc,rows:"4",cols:"80",value:this.props.tableDescription}),React.createElement("button",{style:{width:60,cursor:"pointer"},type:"button","data-table-name":this.props.tableName,"data-table-idx":this.props.tableIdx,onClick:this.props.onClickSave},"save")),React.createElement("p",{style:styles.p},React.createElement("label",null,React.createElement("input",{type:"checkbox","data-table-idx":this.props.tableIdx,checked:this.props.tableProfiling,onChange:this.props.onChangeProfiling})," Profile the data of this ",this.props.tableKind)),this.renderKeys(),this.renderStats(),this.props.tableDefinition?React.createElement("pre",{style:styles.p},this.props.tableDefinition):null,React.createElement("table",{style:styles.table},React.createElement("thead",null,React.createElement("tr",null,React.createElement("th",{style:styles.table},"Key"),React.createElement("th",{style:styles.table},"Attribute"),React.createElement("th",{style:styles.table},"Data Type"),React.createElement("th",{style:styles.table},"Nullable"),React.createElement("th",{style:styles.table},"Unique"),React.createElement("th",{style:styles.table},"Default"),React.createElement("th",{style:styles.table},"Profile"),React.createElement("th",{style:styles.table},"Description"))),React.createElement("tbody",null,this.renderColumns())))}}]),t}(),RoutinesData=function(e){function t(e){_classCallCheck(this,t);var n=_possibleConstructorReturn(this,(t.__proto__||Object.getPrototypeOf(t)).call(this,e));return n.updateRoutineDictionary=function(e){var t=e.target.getAttribute("data-routine-idx"),a=window.location.protocol+"//"+window.location.host+"/update-routine",o=n.state.routines[t];confirm("Are you sure you want to update the dictionary of "+o.kind+" "+o.name+"?")&&fetch(a,{method:"POST",body:JSON.stringify({routine_id:o.id,description:o.description})}).then((function(e){200===e.status?alert(o.kind+" "+o.name+" has been updated successfully."):e.text().then((function(e){alert("An error occurred: "+e)}))})).catch((function(e){console.log(e)}))},n.onChangeRoutineDesc=function(e){var t=e.target.getAttribute("data-routine-idx"),a=n.state.routines;a[t].description=e.target.value,n.setState({routines:a})},n.state={routines:[]},n.onChangeRoutineDesc=n.onChangeRoutineDesc.bind(n),n}return _inherits(t,React.Component),_createClass(t,[{key:"componentDidMount",value:function(){var e=data.Routines||[];e.sort((function(e,t){return(e.schema||"").localeCompare(t.schema||"")||("trigger"===e.kind)-("trigger"===t.kind)||e.name.localeCompare(t.name)})),this.setState({routines:e})}},{key:"render",value:function(){var e=this;return this.state.routines.map((function(t,n){var a=t.kind.charAt(0).toUpperCase()+t.kind.slice(1),o=(t.schema?t.schema+".":"")+t.name;return"trigger"!==t.kind&&(o+="("+t.arguments+")"),React.createElement("div",{key:n,style:{marginTop:50}},React.createElement("p",{style:styles.p},React.createElement("strong",null,a+": "),o),React.createElement("p",{style:styles.p},React.createElement("strong",null,"Description:")),React.createElement("div",{style:{display:"flex"}},React.createElement("textarea",{"data-routine-idx":n,onChange:e.onChangeRoutineDesc,rows:"4",cols:"80",value:t.description}),React.createElement("button",{style:{width:60,cursor:"pointer"},type:"button","data-routine-idx":n,onClick:e.updateRoutineDictionary},"save")),t.return_type?React.createElement("p",{style:styles.p},React.createElement("strong",null,"Returns: "),t.return_type):null,t.table?React.createElement("p",{style:styles.p},React.createElement("strong",null,"Fires: "),t.event," on ",t.table):null,React.createElement("p",{style:styles.p},React.createElement("strong",null,"Language: "),t.language))}))}}]),t}(),TypesData=function(e){function t(e){_classCallCheck(this,t);var n=_possibleConstructorReturn(this,(t.__proto__||Object.getPrototypeOf(t)).call(this,e));return n.updateTypeDictionary=function(e){var t=e.target.getAttribute("data-type-idx"),a=window.location.protocol+"//"+window.location.host+"/update-type",o=n.state.types[t];confirm("Are you sure you want to update the dictionary of "+o.kind+" type "+o.name+"?")&&fetch(a,{method:"POST",body:JSON.stringify({type_id:o.id,description:o.description})}).then((function(e){200===e.status?alert(o.kind+" type "+o.name+" has been updated successfully."):e.text().then((function(e){alert("An error occurred: "+e)}))})).catch((function(e){console.log(e)}))},n.onChangeTypeDesc=function(e){var t=e.target.getAttribute("data-type-idx"),a=n.state.types;a[t].description=e.target.value,n.setState({types:a})},n.state={types:[]},n.onChangeTypeDesc=n.onChangeTypeDesc.bind(n),n}return _inherits(t,React.Component),_createClass(t,[{key:"componentDidMount",value:function(){var e=data.Types||[];e.sort((function(e,t){return e.schema.localeCompare(t.schema)||e.name.localeCompare(t.name)})),this.setState({types:e})}},{key:"render",value:function(){var e=this;return this.state.types.map((function(t,n){var a=t.kind.charAt(0).toUpperCase()+t.kind.slice(1),o="";return o="enum"===t.kind?(t.values||[]).join(", "):"composite"===t.kind?(t.attributes||[]).map((function(e){return e.name+" "+e.type})).join(", "):[t.base_type,t.not_null?"NOT NULL":"",t.default?"DEFAULT "+t.default:""].concat((t.checks||[]).map((function(e){return e.name+" CHECK ("+e.clause+")"}))).filter(Boolean).join(", "),React.createElement("div",{key:n,style:{marginTop:50}},React.createElement("p",{style:styles.p},React.createElement("strong",null,a+" type: "),t.schema+"."+t.name),React.createElement("p",{style:styles.p},React.createElement("strong",null,"Description:")),React.createElement("div",{style:{display:"flex"}},React.createElement("textarea",{"data-type-idx":n,onChange:e.onChangeTypeDesc,rows:"4",cols:"80",value:t.description}),React.createElement("button",{style:{width:60,cursor:"pointer"},type:"button","data-type-idx":n,onClick:e.updateTypeDictionary},"save")),React.createElement("p",{style:styles.p},React.createElement("strong",null,"Definition: "),o))}))}}]),t}(),SequencesData=function(e){function t(e){_classCallCheck(this,t);var n=_possibleConstructorReturn(this,(t.__proto__||Object.getPrototypeOf(t)).call(this,e));return n.state={sequences:[]},n}return _inherits(t,React.Component),_createClass(t,[{key:"componentDidMount",value:function(){var e=this,t=window.location.protocol+"//"+window.location.host+"/sequences";fetch(t,{method:"GET"}).then((function(t){200===t.status&&t.json().then((function(t){e.setState({sequences:t.sequences})}))})).catch((function(e){console.log(e)}))}},{key:"render",value:function(){return this.state.sequences.map((function(e,t){var n="auto_increment"===e.kind?"Auto increment":"Sequence",a=e.warning?{margin:0,color:"red",fontWeight:"bold"}:styles.p;return React.createElement("div",{key:t,style:{marginTop:50}},React.createElement("p",{style:styles.p},React.createElement("strong",null,n+": "),e.id," (",e.data_type,")"),e.table?React.createElement("p",{style:styles.p},React.createElement("strong",null,"Feeds: "),e.table,".",e.column):null,React.createElement("p",{style:styles.p},React.createElement("strong",null,"Increment: "),e.increment),React.createElement("p",{style:a},React.createElement("strong",null,"Current value: "),e.current_value," of ",e.max_value," (",e.usage,"% used)"))}))}}]),t}(),TopBtn=function(e){function t(e){_classCallCheck(this,t);var n=_possibleConstructorReturn(this,(t.__proto__||Object.getPrototypeOf(t)).call(this,e));return n.state={btn_display_style:"none",btn_opacity:.4},n.handleScroll=n.handleScroll.bind(n),n}return _inherits(t,React.Component),_createClass(t,[{key:"componentDidMount",value:function(){window.addEventListener("scroll",this.handleScroll)}},{key:"componentWillUnmount",value:function(){window.removeEventListener("scroll",this.handleScroll)}},{key:"handleScroll",value:function(){var e=document.body.scrollTop,t=document.documentElement.scrollTop;e>20||t>20?this.setState({btn_display_style:"block"}):this.setState({btn_display_style:"None"})}},{key:"render",value:function(){var e=this,t={};return t.top_btn={display:this.state.btn_display_style,position:"fixed",bottom:20,right:30,zIndex:99,fontSize:18,border:"none",outline:"none",color:"grey",cursor:"pointer",padding:15,borderRadius:4,opacity:this.state.btn_opacity},React.createElement("div",null,React.createElement("button",{style:t.top_btn,id:"myBtn",onClick:function(){document.documentElement.scrollTop=0},onMouseOver:function(){return e.setState({btn_opacity:.8})},onMouseOut:function(){return e.setState({btn_opacity:.4})}},"Go to top"))}}]),t}(),styles={p:{margin:0},table:{border:"1px solid black"},saveBtn:{color:"white",padding:"15px 32px",textAlign:"center",fontSize:16,cursor:"pointer",backgroundColor:"#008CBA",marginLeft:3,outline:"none"}},domContainer=document.querySelector("#databaseInfo"),domContainerTwo=document.querySelector("#tablesData");ReactDOM.render(e(DatabaseInfo),domContainer),ReactDOM.render(e(TablesData),domContainerTwo);
\ No newline at end of file
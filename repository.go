@@ -8,10 +8,23 @@ type Repository interface {
 	GetDatabaseInfo() (databaseInfo, error)
 	GetTables() (Tables, error)
 	GetColumns() (ColumnsMetadata, error)
+	GetRoutines() (Routines, error)
+	GetDataTypes() (DataTypes, error)
+	GetTablesStats() (TablesStats, error)
 	UpdateAddTableDescription(tableID string, description string) error
 	UpdateAddColumnDescription(columnID string, description string) error
+	UpdateAddRoutineDescription(routineID string, description string) error
+	UpdateAddDataTypeDescription(typeID string, description string) error
+	UpdateColMetadata(col colMetadata) error
+	UpdateTableMetadata(t table) error
+	UpdateRoutineMetadata(r routine) error
+	UpdateDataTypeMetadata(t dataType) error
+	UpdateTableProfiling(tableID string, profiling bool) error
+	UpdateColumnProfile(columnID string, profile columnProfile) error
 	RemoveTable(tableID string) error
 	RemoveColMetadata(colID string) error
+	RemoveRoutine(routineID string) error
+	RemoveDataType(typeID string) error
 	Setup
 }
 
@@ -19,6 +32,9 @@ type Setup interface {
 	AddDatabaseInfo(databaseInfo) error
 	AddTable(table) error
 	AddColMetaData(tableName string, col colMetadata) error
+	AddRoutine(r routine) error
+	AddDataType(t dataType) error
+	AddTableStats(tableID string, stats tableStats) error
 	RemoveEverything() error
 	IsDatabaseMetaDataAdded(databaseName string) (bool, error)
 }
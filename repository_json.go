@@ -2,10 +2,11 @@ package main
 
 import (
 	"encoding/json"
+	"fmt"
 	scribble "github.com/nanobox-io/golang-scribble"
 	"github.com/pkg/errors"
 	"os"
-	"strconv"
+	"strings"
 )
 
 const (
@@ -18,26 +19,198 @@ const (
 	// collectionColumn identifier for the JSON collection of columns.
 	collectionColumn = "columns"
 
+	// collectionRoutine identifier for the JSON collection of functions, stored procedures and triggers.
+	collectionRoutine = "routines"
+
+	// collectionType identifier for the JSON collection of user defined types.
+	collectionType = "types"
+
+	// collectionStats identifier for the JSON collection of the time series of statistics of the tables.
+	collectionStats = "stats"
+
 	// db identifier for the database info.
 	db = "db"
 )
 
 // jsonStorage stores the data in json files.
 type jsonStorage struct {
-	db *scribble.Driver
+	db  *scribble.Driver
+	dir string
 }
 
-// NewJsonStorage returns a json storage.
+// NewJsonStorage returns a json storage that stores its files in the data directory dir.
 func NewJsonStorage() (*jsonStorage, error) {
+	return newJsonStorageIn(dir)
+}
+
+// newJsonStorageIn returns a json storage that stores its files in the given directory.
+func newJsonStorageIn(path string) (*jsonStorage, error) {
 	var err error
-	s := new(jsonStorage)
-	s.db, err = scribble.New(dir, nil)
+	s := &jsonStorage{dir: path}
+	s.db, err = scribble.New(path, nil)
+	if err != nil {
+		return nil, err
+	}
+	err = s.migrateTableIDs()
+	if err != nil {
+		return nil, err
+	}
+	err = s.migrateTableFiles()
+	if err != nil {
+		return nil, err
+	}
+	err = s.migrateColumnIDs()
 	if err != nil {
 		return nil, err
 	}
 	return s, nil
 }
 
+// columnID returns the id of the column with the given colName in the given tableName.
+// The id only depends on the identity of the column, so the same column keeps its id across syncs.
+func columnID(tableName string, colName string) string {
+	return escapeResource(tableName) + "." + escapeResource(colName)
+}
+
+// escapeResource escapes the given name so it can be safely used as part of the name of a json file.
+// Every byte other than an ascii letter, a digit, "_" or "-" is written as %XX, so two different names
+// never get the same escaped value and the "." used as separator by columnID never appears in it.
+func escapeResource(name string) string {
+	var b strings.Builder
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-' {
+			b.WriteByte(c)
+			continue
+		}
+		_, _ = fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+// migrateTableIDs namespaces the stored postgres tables by their schema.
+// Previous versions of godic read a single postgres schema, so the stored tables only had their name as id.
+func (s *jsonStorage) migrateTableIDs() error {
+	dbInfo, err := s.GetDatabaseInfo()
+	if err != nil {
+		if err == ErrNoDatabaseMetaDataStored {
+			return nil
+		}
+		return err
+	}
+	if dbInfo.Driver != "postgres" {
+		return nil
+	}
+
+	tables, err := s.GetTables()
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	columns, err := s.GetColumns()
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	for _, t := range tables {
+		if t.Schema != "" {
+			continue
+		}
+		oldID := t.ID
+		t.Schema = dbInfo.Schema
+		t.ID = tableID(t.Schema, t.Name)
+		err = s.db.Write(collectionTable, escapeResource(t.ID), t)
+		if err != nil {
+			return errors.Errorf("got error while trying to migrate the id of table %s; %s", t.Name, err)
+		}
+
+		// The columns are moved to their new id afterwards by migrateColumnIDs.
+		for _, c := range columns.getAllColumnsFromTable(oldID) {
+			c.TBName = t.ID
+			if c.IsForeignKey {
+				c.TargetTableFK = tableID(dbInfo.Schema, c.TargetTableFK)
+			}
+			err = s.db.Write(collectionColumn, c.ID, c)
+			if err != nil {
+				return errors.Errorf("got error while trying to migrate the table of column %s in table %s; %s",
+					c.Name, t.Name, err)
+			}
+		}
+
+		err = s.db.Delete(collectionTable, oldID)
+		if err != nil {
+			return errors.Errorf("got error while trying to migrate the id of table %s; %s", t.Name, err)
+		}
+	}
+	return nil
+}
+
+// migrateTableFiles moves the stored tables to the files named after their escaped id, see escapeResource.
+// The tables used to be written to files named after their id as it is, which could point out of the collection.
+func (s *jsonStorage) migrateTableFiles() error {
+	tables, err := s.GetTables()
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	for _, t := range tables {
+		if escapeResource(t.ID) == t.ID {
+			continue
+		}
+		var stored table
+		err = s.db.Read(collectionTable, t.ID, &stored)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		err = s.db.Write(collectionTable, escapeResource(t.ID), t)
+		if err != nil {
+			return errors.Errorf("got error while trying to migrate the file of table %s; %s", t.ID, err)
+		}
+		err = s.db.Delete(collectionTable, t.ID)
+		if err != nil {
+			return errors.Errorf("got error while trying to migrate the file of table %s; %s", t.ID, err)
+		}
+	}
+	return nil
+}
+
+// migrateColumnIDs moves the stored columns whose id was not built with columnID to their stable id.
+// Previous versions of godic built the ids of the columns with a counter, which changed on every sync.
+func (s *jsonStorage) migrateColumnIDs() error {
+	columns, err := s.GetColumns()
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	for _, c := range columns {
+		oldID := c.ID
+		c.ID = columnID(c.TBName, c.Name)
+		if oldID == c.ID {
+			continue
+		}
+		err = s.db.Write(collectionColumn, c.ID, c)
+		if err != nil {
+			return errors.Errorf("got error while trying to migrate the id of column %s in table %s; %s",
+				c.Name, c.TBName, err)
+		}
+		err = s.db.Delete(collectionColumn, oldID)
+		if err != nil {
+			return errors.Errorf("got error while trying to migrate the id of column %s in table %s; %s",
+				c.Name, c.TBName, err)
+		}
+	}
+	return nil
+}
+
 func (s *jsonStorage) AddDatabaseInfo(dbInfo databaseInfo) error {
 	err := s.db.Write(db, "1", dbInfo)
 	if err != nil {
@@ -62,8 +235,8 @@ func (s *jsonStorage) IsDatabaseMetaDataAdded(dbName string) (bool, error) {
 }
 
 func (s *jsonStorage) AddTable(t table) error {
-	t.ID = t.Name
-	err := s.db.Write(collectionTable, t.Name, t)
+	t.ID = tableID(t.Schema, t.Name)
+	err := s.db.Write(collectionTable, escapeResource(t.ID), t)
 	if err != nil {
 		return errors.Errorf("got error while trying to add table %s in storage; %s", t.Name, err)
 	}
@@ -71,20 +244,44 @@ func (s *jsonStorage) AddTable(t table) error {
 }
 
 func (s *jsonStorage) AddColMetaData(tableName string, col colMetadata) error {
-	ss, err := s.db.ReadAll(collectionColumn)
-	if err != nil && !os.IsNotExist(err) {
+	col.ID = columnID(tableName, col.Name)
+	err := s.db.Write(collectionColumn, col.ID, col)
+	if err != nil {
 		return errors.Errorf("got error while trying to add column meta data of column %s in table %s; %s",
 			col.Name, tableName, err)
 	}
 
-	resource := tableName + "_" + col.Name + "_" + strconv.Itoa(len(ss)+1)
-	col.ID = resource
-	err = s.db.Write(collectionColumn, resource, col)
+	return nil
+}
+
+func (s *jsonStorage) AddRoutine(r routine) error {
+	err := s.db.Write(collectionRoutine, escapeResource(r.ID), r)
 	if err != nil {
-		return errors.Errorf("got error while trying to add column meta data of column %s in table %s; %s",
-			col.Name, tableName, err)
+		return errors.Errorf("got error while trying to add %s %s in storage; %s", r.Kind, r.Name, err)
 	}
+	return nil
+}
+
+func (s *jsonStorage) AddDataType(t dataType) error {
+	err := s.db.Write(collectionType, escapeResource(t.ID), t)
+	if err != nil {
+		return errors.Errorf("got error while trying to add %s type %s in storage; %s", t.Kind, t.Name, err)
+	}
+	return nil
+}
 
+// AddTableStats appends the given stats to the time series of statistics of the table with the given tableID.
+func (s *jsonStorage) AddTableStats(tableID string, stats tableStats) error {
+	series := tableStatsSeries{Table: tableID, Samples: make([]tableStats, 0)}
+	err := s.db.Read(collectionStats, escapeResource(tableID), &series)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	series.Samples = append(series.Samples, stats)
+	err = s.db.Write(collectionStats, escapeResource(tableID), series)
+	if err != nil {
+		return errors.Errorf("got error while trying to add the statistics of table %s in storage; %s", tableID, err)
+	}
 	return nil
 }
 
@@ -118,7 +315,7 @@ func (s *jsonStorage) GetDatabaseInfo() (databaseInfo, error) {
 }
 
 func (s *jsonStorage) RemoveEverything() error {
-	err := os.RemoveAll(dir)
+	err := os.RemoveAll(s.dir)
 	if err != nil {
 		return err
 	}
@@ -127,12 +324,40 @@ func (s *jsonStorage) RemoveEverything() error {
 
 func (s *jsonStorage) UpdateAddTableDescription(tableID string, description string) error {
 	var t table
-	err := s.db.Read(collectionTable, tableID, &t)
+	err := s.db.Read(collectionTable, escapeResource(tableID), &t)
 	if err != nil {
 		return err
 	}
 	t.Description = description
-	err = s.db.Write(collectionTable, tableID, t)
+	err = s.db.Write(collectionTable, escapeResource(tableID), t)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *jsonStorage) UpdateAddRoutineDescription(routineID string, description string) error {
+	var r routine
+	err := s.db.Read(collectionRoutine, escapeResource(routineID), &r)
+	if err != nil {
+		return err
+	}
+	r.Description = description
+	err = s.db.Write(collectionRoutine, escapeResource(routineID), r)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *jsonStorage) UpdateAddDataTypeDescription(typeID string, description string) error {
+	var t dataType
+	err := s.db.Read(collectionType, escapeResource(typeID), &t)
+	if err != nil {
+		return err
+	}
+	t.Description = description
+	err = s.db.Write(collectionType, escapeResource(typeID), t)
 	if err != nil {
 		return err
 	}
@@ -153,6 +378,86 @@ func (s *jsonStorage) UpdateAddColumnDescription(columnID string, description st
 	return nil
 }
 
+// UpdateColMetadata replaces the structural metadata of the stored column with the same id as the given col.
+// Only the structural metadata is copied onto the stored column, so the data authored by the users, like the
+// description of the column, and the last profile of the column are preserved.
+func (s *jsonStorage) UpdateColMetadata(col colMetadata) error {
+	var c colMetadata
+	err := s.db.Read(collectionColumn, col.ID, &c)
+	if err != nil {
+		return err
+	}
+	c.setStructure(col)
+	err = s.db.Write(collectionColumn, col.ID, c)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateTableMetadata replaces the structural metadata, like the keys, of the stored table with the same id as
+// the given table. The description of the table and whether it is opted in for profiling are preserved.
+func (s *jsonStorage) UpdateTableMetadata(t table) error {
+	var stored table
+	err := s.db.Read(collectionTable, escapeResource(t.ID), &stored)
+	if err != nil {
+		return err
+	}
+	t.Description = stored.Description
+	t.Profiling = stored.Profiling
+	err = s.db.Write(collectionTable, escapeResource(t.ID), t)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateRoutineMetadata replaces the definition of the stored routine with the same id as the given routine.
+// The description of the routine is preserved.
+func (s *jsonStorage) UpdateRoutineMetadata(r routine) error {
+	var stored routine
+	err := s.db.Read(collectionRoutine, escapeResource(r.ID), &stored)
+	if err != nil {
+		return err
+	}
+	r.Description = stored.Description
+	err = s.db.Write(collectionRoutine, escapeResource(r.ID), r)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateTableProfiling opts the stored table with the given tableID in or out of the profiling job.
+func (s *jsonStorage) UpdateTableProfiling(tableID string, profiling bool) error {
+	var t table
+	err := s.db.Read(collectionTable, escapeResource(tableID), &t)
+	if err != nil {
+		return err
+	}
+	t.Profiling = profiling
+	err = s.db.Write(collectionTable, escapeResource(tableID), t)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateColumnProfile replaces the profile of the stored column with the given columnID.
+func (s *jsonStorage) UpdateColumnProfile(columnID string, profile columnProfile) error {
+	var c colMetadata
+	err := s.db.Read(collectionColumn, columnID, &c)
+	if err != nil {
+		return err
+	}
+	c.Profile = &profile
+	err = s.db.Write(collectionColumn, columnID, c)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func (s *jsonStorage) GetColumns() (ColumnsMetadata, error) {
 	columns := make(ColumnsMetadata, 0)
 	list, err := s.db.ReadAll(collectionColumn)
@@ -167,6 +472,7 @@ func (s *jsonStorage) GetColumns() (ColumnsMetadata, error) {
 		}
 		columns = append(columns, c)
 	}
+	columns.sort()
 	return columns, nil
 }
 
@@ -182,7 +488,21 @@ func (s *jsonStorage) RemoveTable(tableID string) error {
 			return err
 		}
 	}
-	err = s.db.Delete(collectionTable, tableID)
+	err = s.db.Delete(collectionTable, escapeResource(tableID))
+	if err != nil {
+		return err
+	}
+
+	// Tables stored by previous versions of godic might not have any statistics.
+	var series tableStatsSeries
+	err = s.db.Read(collectionStats, escapeResource(tableID), &series)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	err = s.db.Delete(collectionStats, escapeResource(tableID))
 	if err != nil {
 		return err
 	}
@@ -196,3 +516,101 @@ func (s *jsonStorage) RemoveColMetadata(colID string) error {
 	}
 	return nil
 }
+
+// UpdateDataTypeMetadata replaces the definition of the stored user defined type with the same id as the given
+// type. The description of the type is preserved.
+func (s *jsonStorage) UpdateDataTypeMetadata(t dataType) error {
+	var stored dataType
+	err := s.db.Read(collectionType, escapeResource(t.ID), &stored)
+	if err != nil {
+		return err
+	}
+	t.Description = stored.Description
+	err = s.db.Write(collectionType, escapeResource(t.ID), t)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetRoutines returns the stored routines. Previous versions of godic did not store any routine, so a missing
+// collection of routines is not an error.
+func (s *jsonStorage) GetRoutines() (Routines, error) {
+	routines := make(Routines, 0)
+	list, err := s.db.ReadAll(collectionRoutine)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return routines, nil
+		}
+		return routines, err
+	}
+	for i := range list {
+		var r routine
+		err := json.Unmarshal([]byte(list[i]), &r)
+		if err != nil {
+			return routines, err
+		}
+		routines = append(routines, r)
+	}
+	return routines, nil
+}
+
+func (s *jsonStorage) RemoveRoutine(routineID string) error {
+	err := s.db.Delete(collectionRoutine, escapeResource(routineID))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetDataTypes returns the stored user defined types. Previous versions of godic did not store any type, so a
+// missing collection of types is not an error.
+func (s *jsonStorage) GetDataTypes() (DataTypes, error) {
+	types := make(DataTypes, 0)
+	list, err := s.db.ReadAll(collectionType)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return types, nil
+		}
+		return types, err
+	}
+	for i := range list {
+		var t dataType
+		err := json.Unmarshal([]byte(list[i]), &t)
+		if err != nil {
+			return types, err
+		}
+		types = append(types, t)
+	}
+	return types, nil
+}
+
+func (s *jsonStorage) RemoveDataType(typeID string) error {
+	err := s.db.Delete(collectionType, escapeResource(typeID))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetTablesStats returns the stored time series of statistics of the tables. Previous versions of godic did not
+// store any statistics, so a missing collection of statistics is not an error.
+func (s *jsonStorage) GetTablesStats() (TablesStats, error) {
+	stats := make(TablesStats, 0)
+	list, err := s.db.ReadAll(collectionStats)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return stats, nil
+		}
+		return stats, err
+	}
+	for i := range list {
+		var series tableStatsSeries
+		err := json.Unmarshal([]byte(list[i]), &series)
+		if err != nil {
+			return stats, err
+		}
+		stats = append(stats, series)
+	}
+	return stats, nil
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func Test_columnID_is_collision_free(t *testing.T) {
+	ids := []string{
+		columnID("a_b", "c"),
+		columnID("a", "b_c"),
+		columnID("a.b", "c"),
+		columnID("a", "b.c"),
+		columnID("a%2E", "b"),
+	}
+	seen := make(map[string]bool)
+	for _, id := range ids {
+		if seen[id] {
+			t.Errorf("expected column ids to be unique; got (%s) twice", id)
+		}
+		seen[id] = true
+	}
+
+	if id := columnID("order", "id"); id != columnID("order", "id") {
+		t.Errorf("expected the id of a column to be stable; got (%s) and (%s)", id, columnID("order", "id"))
+	}
+}
+
+func Test_NewJsonStorage_migrates_old_column_ids(t *testing.T) {
+	path := t.TempDir()
+	storage, err := newJsonStorageIn(path)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from newJsonStorageIn; got %s", err)
+	}
+
+	// Previous versions of godic stored the columns with ids like tableName_colName_N.
+	oldCol := colMetadata{ID: "product_name_2", Name: "name", TBName: "product", Description: "I have a nice name."}
+	err = storage.db.Write(collectionColumn, oldCol.ID, oldCol)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when writing the old column; got %s", err)
+	}
+
+	storage, err = newJsonStorageIn(path)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from newJsonStorageIn; got %s", err)
+	}
+
+	columns, err := storage.GetColumns()
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from GetColumns; got %s", err)
+	}
+	if len(columns) != 1 {
+		t.Fatalf("expected 1 column after the migration; got %d", len(columns))
+	}
+	if columns[0].ID != columnID("product", "name") {
+		t.Errorf("expected column id to be (%s); got (%s)", columnID("product", "name"), columns[0].ID)
+	}
+	if columns[0].Description != oldCol.Description {
+		t.Errorf("expected description to be (%s); got (%s)", oldCol.Description, columns[0].Description)
+	}
+}
+
+func Test_NewJsonStorage_migrates_postgres_tables_to_their_schema(t *testing.T) {
+	path := t.TempDir()
+	storage, err := newJsonStorageIn(path)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from newJsonStorageIn; got %s", err)
+	}
+
+	// Previous versions of godic stored the postgres tables of a single schema without their schema.
+	err = storage.AddDatabaseInfo(databaseInfo{Name: "test_db", Driver: "postgres", Schema: "public"})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from AddDatabaseInfo; got %s", err)
+	}
+	for _, name := range []string{"order", "order_line"} {
+		err = storage.db.Write(collectionTable, name, table{ID: name, Name: name, Description: "cool table"})
+		if err != nil {
+			t.Fatalf("we shouldn't get an error when writing the old table; got %s", err)
+		}
+	}
+	oldCol := colMetadata{ID: "order_line_order_id_1", Name: "order_id", TBName: "order_line", IsForeignKey: true,
+		TargetTableFK: "order"}
+	err = storage.db.Write(collectionColumn, oldCol.ID, oldCol)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when writing the old column; got %s", err)
+	}
+
+	storage, err = newJsonStorageIn(path)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from newJsonStorageIn; got %s", err)
+	}
+
+	tables, err := storage.GetTables()
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from GetTables; got %s", err)
+	}
+	expectedTable := table{ID: "public.order", Schema: "public", Name: "order", Description: "cool table"}
+	if tb, err := tables.get("public.order"); err != nil || !reflect.DeepEqual(tb, expectedTable) {
+		t.Errorf("expected table %+v; got %+v", expectedTable, tb)
+	}
+	if tables.count() != 2 {
+		t.Errorf("expected 2 tables after the migration; got %d", tables.count())
+	}
+
+	columns, err := storage.GetColumns()
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from GetColumns; got %s", err)
+	}
+	col, err := columns.getByColumnID(columnID("public.order_line", "order_id"))
+	if err != nil {
+		t.Fatalf("expected the column to be migrated; got %s", err)
+	}
+	if col.TBName != "public.order_line" || col.TargetTableFK != "public.order" {
+		t.Errorf("expected the column to reference the tables by their schema; got %+v", col)
+	}
+}
+
+func Test_AddTable_writes_the_tables_within_their_collection(t *testing.T) {
+	path := t.TempDir()
+	storage, err := newJsonStorageIn(path)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from newJsonStorageIn; got %s", err)
+	}
+
+	tb := table{Schema: "public", Name: "../../order"}
+	if err = storage.AddTable(tb); err != nil {
+		t.Fatalf("we shouldn't get an error from AddTable; got %s", err)
+	}
+	id := tableID(tb.Schema, tb.Name)
+	if err = storage.AddColMetaData(id, colMetadata{Name: "id", TBName: id}); err != nil {
+		t.Fatalf("we shouldn't get an error from AddColMetaData; got %s", err)
+	}
+	if _, err = os.Stat(filepath.Join(path, collectionTable, escapeResource(id)+".json")); err != nil {
+		t.Errorf("expected the table %s to be written within the collection of tables; got %s", id, err)
+	}
+	if err = storage.UpdateAddTableDescription(id, "cool table"); err != nil {
+		t.Fatalf("we shouldn't get an error from UpdateAddTableDescription; got %s", err)
+	}
+	tables, err := storage.GetTables()
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from GetTables; got %s", err)
+	}
+	if stored, err := tables.get(id); err != nil || stored.Description != "cool table" {
+		t.Errorf("expected the table %s to be described as (cool table); got %+v", id, stored)
+	}
+	if err = storage.RemoveTable(id); err != nil {
+		t.Fatalf("we shouldn't get an error from RemoveTable; got %s", err)
+	}
+	if tables, err = storage.GetTables(); err != nil || tables.count() != 0 {
+		t.Errorf("expected no tables after removing the table %s; got %+v", id, tables)
+	}
+}
+
+func Test_NewJsonStorage_migrates_the_files_of_the_tables(t *testing.T) {
+	path := t.TempDir()
+	storage, err := newJsonStorageIn(path)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from newJsonStorageIn; got %s", err)
+	}
+
+	// The tables used to be written to files named after their id as it is.
+	old := table{ID: "public.order", Schema: "public", Name: "order", Description: "cool table"}
+	if err = storage.db.Write(collectionTable, old.ID, old); err != nil {
+		t.Fatalf("we shouldn't get an error when writing the old table; got %s", err)
+	}
+
+	storage, err = newJsonStorageIn(path)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from newJsonStorageIn; got %s", err)
+	}
+
+	tables, err := storage.GetTables()
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from GetTables; got %s", err)
+	}
+	if len(tables) != 1 || !reflect.DeepEqual(tables[0], old) {
+		t.Errorf("expected only the table %+v after the migration; got %+v", old, tables)
+	}
+	if err = storage.UpdateAddTableDescription(old.ID, "cooler table"); err != nil {
+		t.Errorf("expected the migrated table to be found by its id; got %s", err)
+	}
+}
+
+func Test_UpdateColMetadata_preserves_the_data_authored_by_the_users(t *testing.T) {
+	storage, err := newJsonStorageIn(t.TempDir())
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from newJsonStorageIn; got %s", err)
+	}
+
+	profile := &columnProfile{SampledRows: 10, DistinctCount: 3}
+	stored := colMetadata{Name: "name", TBName: "product", DBType: "VARCHAR", Length: 200,
+		Description: "I have a nice name.", Profile: profile}
+	if err = storage.AddColMetaData("product", stored); err != nil {
+		t.Fatalf("we shouldn't get an error from AddColMetaData; got %s", err)
+	}
+
+	// The metadata read from the database does not have the data authored by the users.
+	current := colMetadata{ID: columnID("product", "name"), Name: "name", Position: 2, TBName: "product",
+		DBType: "VARCHAR", Length: 300, Nullable: true}
+	if err = storage.UpdateColMetadata(current); err != nil {
+		t.Fatalf("we shouldn't get an error from UpdateColMetadata; got %s", err)
+	}
+
+	columns, err := storage.GetColumns()
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from GetColumns; got %s", err)
+	}
+	expected := current
+	expected.Description = stored.Description
+	expected.Profile = profile
+	if len(columns) != 1 || !reflect.DeepEqual(columns[0], expected) {
+		t.Errorf("expected the stored column to be %+v; got %+v", expected, columns)
+	}
+}
@@ -3,9 +3,10 @@ package main
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
-func setupInitialMetadata(storage Repository, conf *Config) error {
+func setupInitialMetadata(storage Repository, conf *Config, introspector Introspector) error {
 	var err error
 
 	metaDataExists, err := storage.IsDatabaseMetaDataAdded(conf.DatabaseName)
@@ -30,6 +31,13 @@ func setupInitialMetadata(storage Repository, conf *Config) error {
 				"(see documentation of this flag).\n"+
 				"Here some of the differences we found:\n%s", msg)
 		}
+		// The tables of the added schemas are reported as new tables by the next check of the database changes.
+		if schema := strings.Join(conf.schemas(), ","); databaseInfo.Schema != schema {
+			databaseInfo.Schema = schema
+			if err := storage.AddDatabaseInfo(databaseInfo); err != nil {
+				return err
+			}
+		}
 		goto DoNothing
 	}
 
@@ -67,7 +75,7 @@ DoForceDelete:
 	}
 	goto DoSetup
 DoSetup:
-	err = databaseMetaDataSetup(storage, conf)
+	err = databaseMetaDataSetup(storage, conf, introspector)
 	if err != nil {
 		return err
 	}
@@ -76,8 +84,8 @@ DoNothing:
 	return nil
 }
 
-// databaseMetaDataSetup stores in repository all the database metadata.
-func databaseMetaDataSetup(storage Repository, conf *Config) error {
+// databaseMetaDataSetup stores in repository all the database metadata read by the given Introspector.
+func databaseMetaDataSetup(storage Repository, conf *Config, introspector Introspector) error {
 	dbInfo := databaseInfo{
 		Name:     conf.DatabaseName,
 		User:     conf.DatabaseUser,
@@ -85,94 +93,32 @@ func databaseMetaDataSetup(storage Repository, conf *Config) error {
 		Port:     conf.DatabasePort,
 		Password: conf.DatabasePassword,
 		Driver:   conf.DatabaseDriver,
-		Schema:   conf.DatabaseSchema,
-	}
-
-	err := storage.AddDatabaseInfo(dbInfo)
-	if err != nil {
-		return err
-	}
-
-	tableNames, err := getTableNames(conf)
-	if err != nil {
-		return err
+		Schema:   strings.Join(conf.schemas(), ","),
 	}
 
-	primaryKeys, err := getPrimaryKeys(conf)
+	cat, err := introspector.Catalog()
 	if err != nil {
 		return err
 	}
 
-	foreignKeys, err := getForeignKeys(conf)
+	err = storage.AddDatabaseInfo(dbInfo)
 	if err != nil {
 		return err
 	}
 
-	enums, err := getColsAndEnums(conf)
-	if err != nil {
-		return err
-	}
-
-	uniques, err := getUniqueCols(conf)
-	if err != nil {
-		return err
-	}
-
-	for i := range tableNames {
-		t := table{Name: tableNames[i]}
-		err = storage.AddTable(t)
-		if err != nil {
-			return err
-		}
-
-		tableColumns, err := getTableColumns(tableNames[i], conf)
+	for i := range cat.Tables {
+		err = storage.AddTable(cat.table(cat.Tables[i]))
 		if err != nil {
-			if removeErr := storage.RemoveEverything(); removeErr != nil {
-				err = fmt.Errorf("we got this error (%s) when trying to do the setup and we couldn't "+
-					"rollback, you might need to use the force_delete flag to maintain consistency", err)
-			}
 			return err
 		}
 
-		for _, col := range tableColumns {
-			colMeta := colMetadata{}
-			colMeta.Name = col.Name()
-			colMeta.DBType = col.DatabaseTypeName()
-			colMeta.Nullable = parseNullableFromCol(col)
-			colMeta.GoType = col.ScanType().String()
-			colMeta.Length = parseLengthFromCol(col)
-			colMeta.TBName = tableNames[i]
-
-			if isPK := primaryKeys.exists(colMeta.Name, tableNames[i]); isPK {
-				colMeta.IsPrimaryKey = true
-			}
-
-			if isFK := foreignKeys.exists(colMeta.Name, tableNames[i]); isFK {
-				fk, err := foreignKeys.get(colMeta.Name, tableNames[i])
-				if err != nil {
-					return err
-				}
-				colMeta.IsForeignKey = true
-				colMeta.TargetTableFK = fk.TargetTable
-				colMeta.DeleteRule = fk.DeleteRule
-				colMeta.UpdateRule = fk.UpdateRule
-			}
-
-			if hasEnum := enums.exists(colMeta.Name, tableNames[i]); hasEnum {
-				enum, err := enums.get(colMeta.Name, tableNames[i])
-				if err != nil {
-					return err
-				}
-				colMeta.HasENUM = true
-				colMeta.ENUMName = enum.EnumName
-				colMeta.ENUMValues = strings.Split(enum.EnumValues, ",")
-			}
-
-			if hasUniqueIndex := uniques.exists(colMeta.Name, tableNames[i]); hasUniqueIndex {
-				colMeta.IsUnique = true
+		for _, col := range cat.tableColumns(cat.Tables[i]) {
+			colMeta, err := columnMetadataBuilder(cat.Tables[i], col, cat)
+			if err != nil {
+				return err
 			}
 
-			err = storage.AddColMetaData(tableNames[i], colMeta)
+			err = storage.AddColMetaData(cat.Tables[i], colMeta)
 			if err != nil {
 				if removeErr := storage.RemoveEverything(); removeErr != nil {
 					err = fmt.Errorf("we got this error (%s) when trying to do the setup and we couldn't "+
@@ -183,5 +129,19 @@ func databaseMetaDataSetup(storage Repository, conf *Config) error {
 		}
 	}
 
-	return nil
+	for _, r := range cat.routines() {
+		err = storage.AddRoutine(r)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, t := range cat.dataTypes() {
+		err = storage.AddDataType(t)
+		if err != nil {
+			return err
+		}
+	}
+
+	return recordTablesStats(storage, cat, time.Now().UTC())
 }
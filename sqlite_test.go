@@ -0,0 +1,1017 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_catalog_table_names_for_sqlite_db(t *testing.T) {
+	conf := createSqliteConf()
+
+	tables := readTestCatalog(t, sqliteTestDb, conf).Tables
+
+	expectedTables := []string{"order", "product", "order_line"}
+
+	for _, e := range expectedTables {
+		exists := false
+		for _, t := range tables {
+			if e == t {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			t.Errorf("expected table name %s.", e)
+		}
+	}
+}
+
+func Test_catalog_table_columns_for_sqlite_db(t *testing.T) {
+	conf := createSqliteConf()
+
+	cat := readTestCatalog(t, sqliteTestDb, conf)
+	tables := cat.Tables
+
+	// A map of tables (keys) and its columns (values as list of columns)
+	expectations := map[string][]string{
+		"order":      {"id"},
+		"product":    {"id", "name", "counting_option"},
+		"order_line": {"id", "order_id"},
+	}
+
+	for i := range tables {
+		cols := cat.tableColumns(tables[i])
+		for _, e := range expectations[tables[i]] {
+			exists := false
+			for _, col := range cols {
+				if col.Name == e {
+					exists = true
+					break
+				}
+			}
+			if !exists {
+				t.Errorf("expected column %s in table %s", e, tables[i])
+			}
+		}
+	}
+}
+
+func Test_catalog_nullability_AND_sizes_of_the_columns_for_sqlite_db(t *testing.T) {
+	conf := createSqliteConf()
+
+	_, err := sqliteTestDb.Exec(`
+		CREATE TABLE contact
+		  (
+			 id       INTEGER PRIMARY KEY,
+			 nickname VARCHAR(20),
+			 email    NVARCHAR(100) NOT NULL,
+			 balance  DECIMAL(12, 2) NOT NULL,
+			 notes    TEXT
+		  );
+	`)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the table contact; got %s", err)
+	}
+	defer func() {
+		if _, err := sqliteTestDb.Exec("DROP TABLE contact;"); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	cols := readTestCatalog(t, sqliteTestDb, conf).tableColumns("contact")
+
+	expected := []column{
+		{Name: "id", Nullable: false},
+		{Name: "nickname", Nullable: true, Length: 20},
+		{Name: "email", Nullable: false, Length: 100},
+		{Name: "balance", Nullable: false, Precision: 12, Scale: 2},
+		{Name: "notes", Nullable: true},
+	}
+	if len(cols) != len(expected) {
+		t.Fatalf("expected %d columns in table contact; got %+v", len(expected), cols)
+	}
+	for i, e := range expected {
+		col := cols[i]
+		if col.Name != e.Name || col.Nullable != e.Nullable || col.Length != e.Length ||
+			col.Precision != e.Precision || col.Scale != e.Scale {
+			t.Errorf("expected column %+v in table contact; got %+v", e, col)
+		}
+	}
+}
+
+func Test_catalog_primary_keys_for_sqlite_db(t *testing.T) {
+	conf := createSqliteConf()
+
+	pks := readTestCatalog(t, sqliteTestDb, conf).PrimaryKeys
+
+	if len(pks) != 3 {
+		t.Fatalf("expected 3 primary keys got %d", len(pks))
+	}
+
+	expectedPrimaryKeys := []primaryKey{
+		{
+			Table: "order",
+			Col:   "id",
+		},
+		{
+			Table: "product",
+			Col:   "id",
+		},
+		{
+			Table: "order_line",
+			Col:   "id",
+		},
+	}
+
+	for _, e := range expectedPrimaryKeys {
+		exists := false
+		for _, pk := range pks {
+			if pk.Table == e.Table && pk.Col == e.Col {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			t.Errorf("expected primary key %s in table %s", e.Col, e.Table)
+		}
+	}
+}
+
+func Test_catalog_foreign_keys_for_sqlite_db(t *testing.T) {
+	conf := createSqliteConf()
+
+	fks := readTestCatalog(t, sqliteTestDb, conf).ForeignKeys
+
+	if len(fks) != 2 {
+		t.Errorf("we expected 2 foreign keys got %d", len(fks))
+	}
+
+	expectedForeignKeys := []foreignKey{
+		{
+			Table:       "order_line",
+			TargetTable: "order",
+			Col:         "order_id",
+			DeleteRule:  "RESTRICT",
+			UpdateRule:  "NO ACTION",
+			TargetCol:   "id",
+			Name:        "order_line_fk_1",
+		}, {
+			Table:       "order_line",
+			TargetTable: "product",
+			Col:         "product_id",
+			DeleteRule:  "RESTRICT",
+			UpdateRule:  "NO ACTION",
+			TargetCol:   "id",
+			Name:        "order_line_fk_0",
+		},
+	}
+
+	for _, e := range expectedForeignKeys {
+		exists := false
+		for _, fk := range fks {
+			if fk.Table == e.Table && fk.Col == e.Col {
+				exists = true
+				if !reflect.DeepEqual(fk, e) {
+					t.Errorf("expected fk (%+v); got %+v", e, fk)
+				}
+			}
+		}
+		if !exists {
+			t.Errorf("expected fk %s in table %s", e.Col, e.Table)
+		}
+	}
+}
+
+func Test_catalog_enums_for_sqlite_db(t *testing.T) {
+	conf := createSqliteConf()
+
+	enums := readTestCatalog(t, sqliteTestDb, conf).Enums
+
+	if len(enums) != 0 {
+		t.Errorf("expected to have no enums since sqlite does not support them; got %d", len(enums))
+	}
+}
+
+func Test_catalog_unique_columns_for_sqlite_db(t *testing.T) {
+	conf := createSqliteConf()
+
+	uniqueColumns := readTestCatalog(t, sqliteTestDb, conf).Uniques
+
+	if len(uniqueColumns) != 4 {
+		t.Errorf("we expected 4 unique columns got %d", len(uniqueColumns))
+	}
+
+	expectedUniqueColumns := []uniqueCol{
+		{
+			Table: "order_line",
+			Col:   "id",
+		}, {
+			Table: "order",
+			Col:   "id",
+		}, {
+			Table: "product",
+			Col:   "id",
+		}, {
+			Table: "product",
+			Col:   "name",
+		},
+	}
+
+	for _, e := range expectedUniqueColumns {
+		exists := false
+		for _, unique := range uniqueColumns {
+			if unique.Table == e.Table && unique.Col == e.Col {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			t.Errorf("expected to have a unique column %s in table %s", e.Col, e.Table)
+		}
+	}
+}
+
+func Test_catalog_defaults_for_sqlite_db(t *testing.T) {
+	conf := createSqliteConf()
+
+	defaults := readTestCatalog(t, sqliteTestDb, conf).Defaults
+
+	def, err := defaults.get("counting_option", "product")
+	if err != nil {
+		t.Fatalf("expected column counting_option in table product to have a default; got %s", err)
+	}
+	if def.Default != "'unit'" {
+		t.Errorf("expected default of column counting_option in table product to be ('unit'); got (%s)", def.Default)
+	}
+}
+
+func Test_catalog_views_for_sqlite_db(t *testing.T) {
+	conf := createSqliteConf()
+
+	cat := readTestCatalog(t, sqliteTestDb, conf)
+
+	if !cat.hasTable("product_name") {
+		t.Fatalf("expected view product_name in the catalog")
+	}
+	view := cat.table("product_name")
+	if view.Kind != viewKind {
+		t.Errorf("expected product_name to be a %s; got %s", viewKind, view.Kind)
+	}
+	if !strings.Contains(strings.ToLower(view.Definition), "product") {
+		t.Errorf("expected the definition of product_name to select from product; got (%s)", view.Definition)
+	}
+	cols := cat.tableColumns("product_name")
+	if len(cols) != 2 || cols[0].Name != "id" || cols[1].Name != "name" {
+		t.Errorf("expected view product_name to have the columns id and name; got %+v", cols)
+	}
+}
+
+func Test_catalog_triggers_AND_routine_changes_for_sqlite_db(t *testing.T) {
+	conf := createSqliteConf()
+	conf.ForceDelete = true // for testing we force delete of the data in the database.
+
+	createTrigger := func(body string) {
+		_, err := sqliteTestDb.Exec(`
+			CREATE TRIGGER product_name_trim AFTER UPDATE OF name ON product FOR EACH ROW
+			BEGIN
+				` + body + `
+			END;
+		`)
+		if err != nil {
+			t.Fatalf("we shouldn't get an error when creating the trigger product_name_trim; got %s", err)
+		}
+	}
+	dropTrigger := func() {
+		if _, err := sqliteTestDb.Exec("DROP TRIGGER IF EXISTS product_name_trim;"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	createTrigger("UPDATE product SET name = trim(new.name) WHERE id = new.id;")
+	defer dropTrigger()
+
+	routines := readTestCatalog(t, sqliteTestDb, conf).routines()
+	if len(routines) != 1 {
+		t.Fatalf("expected 1 routine; got %d", len(routines))
+	}
+	expected := routine{ID: "product_name_trim ON product", Name: "product_name_trim", Kind: triggerKind,
+		Language: "sql", Table: "product", Event: "AFTER UPDATE FOR EACH ROW", BodyHash: routines[0].BodyHash}
+	if routines[0] != expected || routines[0].BodyHash == "" {
+		t.Errorf("expected trigger %+v; got %+v", expected, routines[0])
+	}
+
+	storage, err := newJsonStorageIn(t.TempDir())
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from newJsonStorageIn; got %s", err)
+	}
+	introspector, err := introspectors[conf.DatabaseDriver].newIntrospector(sqliteTestDb, conf)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the introspector; got %s", err)
+	}
+	err = setupInitialMetadata(storage, conf, introspector)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from databaseMetaDataSetup; got %s", err)
+	}
+
+	err = storage.UpdateAddRoutineDescription(expected.ID, "Trims the names of the products.")
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from UpdateAddRoutineDescription; got %s", err)
+	}
+
+	// Changing the body of the trigger is reported as a change, and syncing it preserves its description.
+	dropTrigger()
+	createTrigger("UPDATE product SET name = upper(trim(new.name)) WHERE id = new.id;")
+	cat := readTestCatalog(t, sqliteTestDb, conf)
+	changes, err := getRoutineChanges(storage, cat)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from getRoutineChanges; got %s", err)
+	}
+	if len(changes) != 1 || changes[0].ChangesMessage != "trigger body has been changed" {
+		t.Fatalf("expected the body of the trigger product_name_trim to be reported as changed; got %+v", changes)
+	}
+	if err = storage.UpdateRoutineMetadata(cat.routines()[0]); err != nil {
+		t.Fatalf("we shouldn't get an error from UpdateRoutineMetadata; got %s", err)
+	}
+	stored, err := storage.GetRoutines()
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from GetRoutines; got %s", err)
+	}
+	if len(stored) != 1 || stored[0].BodyHash != cat.routines()[0].BodyHash ||
+		stored[0].Description != "Trims the names of the products." {
+		t.Errorf("expected the stored trigger to be updated keeping its description; got %+v", stored)
+	}
+
+	// A dropped trigger is reported as deleted.
+	dropTrigger()
+	deleted, err := getDeletedRoutinesChanges(storage, readTestCatalog(t, sqliteTestDb, conf))
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from getDeletedRoutinesChanges; got %s", err)
+	}
+	if len(deleted) != 1 || deleted[0].ID != expected.ID {
+		t.Errorf("expected the trigger product_name_trim to be reported as deleted; got %+v", deleted)
+	}
+}
+
+func Test_catalog_sequences_for_sqlite_db(t *testing.T) {
+	conf := createSqliteConf()
+
+	_, err := sqliteTestDb.Exec(`
+		CREATE TABLE invoice (id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT, total REAL);
+		INSERT INTO invoice (total) VALUES (10), (20), (30);
+	`)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the table invoice; got %s", err)
+	}
+	defer func() {
+		if _, err := sqliteTestDb.Exec("DROP TABLE invoice;"); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	sequences := readTestCatalog(t, sqliteTestDb, conf).sequences()
+	expected := Sequences{{ID: "invoice", Name: "invoice", Kind: autoIncrementKind, Table: "invoice", Column: "id",
+		DataType: "INTEGER", Increment: 1, CurrentValue: 3, MaxValue: 9223372036854775807}}
+	if !reflect.DeepEqual(sequences, expected) {
+		t.Errorf("expected sequences %+v; got %+v", expected, sequences)
+	}
+}
+
+func Test_recordTablesStats_for_sqlite_db(t *testing.T) {
+	conf := createSqliteConf()
+	conf.ForceDelete = true // for testing we force delete of the data in the database.
+
+	storage, err := newJsonStorageIn(t.TempDir())
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from newJsonStorageIn; got %s", err)
+	}
+	introspector, err := introspectors[conf.DatabaseDriver].newIntrospector(sqliteTestDb, conf)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the introspector; got %s", err)
+	}
+	err = setupInitialMetadata(storage, conf, introspector)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from databaseMetaDataSetup; got %s", err)
+	}
+
+	// Every sync adds a sample with the rows of the tables, which sqlite only estimates when they are analyzed.
+	if _, err := sqliteTestDb.Exec(`INSERT INTO "order" (id) VALUES (1), (2); ANALYZE;`); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if _, err := sqliteTestDb.Exec(`DELETE FROM "order"; DROP TABLE sqlite_stat1;`); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	at := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	if err = recordTablesStats(storage, readTestCatalog(t, sqliteTestDb, conf), at); err != nil {
+		t.Fatalf("we shouldn't get an error from recordTablesStats; got %s", err)
+	}
+
+	stats, err := storage.GetTablesStats()
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from GetTablesStats; got %s", err)
+	}
+	if len(stats) != 3 {
+		t.Fatalf("expected the statistics of 3 tables, views do not have any; got %d", len(stats))
+	}
+	for _, series := range stats {
+		if series.Table != "order" {
+			continue
+		}
+		if len(series.Samples) != 2 || series.Samples[0].Rows != 0 || series.Samples[1].Rows != 2 ||
+			!series.Samples[1].Time.Equal(at) {
+			t.Errorf("expected the table order to have 2 samples with 0 and 2 rows; got %+v", series.Samples)
+		}
+	}
+
+	// The statistics of a removed table are removed with it.
+	if err = storage.RemoveTable("order"); err != nil {
+		t.Fatalf("we shouldn't get an error from RemoveTable; got %s", err)
+	}
+	stats, err = storage.GetTablesStats()
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from GetTablesStats; got %s", err)
+	}
+	if len(stats) != 2 {
+		t.Errorf("expected the statistics of 2 tables after removing the table order; got %d", len(stats))
+	}
+}
+
+func Test_profileTables_for_sqlite_db(t *testing.T) {
+	conf := createSqliteConf()
+	conf.ForceDelete = true // for testing we force delete of the data in the database.
+
+	storage, err := newJsonStorageIn(t.TempDir())
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from newJsonStorageIn; got %s", err)
+	}
+	introspector, err := introspectors[conf.DatabaseDriver].newIntrospector(sqliteTestDb, conf)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the introspector; got %s", err)
+	}
+	err = setupInitialMetadata(storage, conf, introspector)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from databaseMetaDataSetup; got %s", err)
+	}
+
+	_, err = sqliteTestDb.Exec(`
+		INSERT INTO product (id, name, counting_option) VALUES (1, 'apple', 'unit'), (2, 'pear', 'unit'),
+			(3, 'banana', 'decimal');
+	`)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when inserting the products; got %s", err)
+	}
+	defer func() {
+		if _, err := sqliteTestDb.Exec("DELETE FROM product;"); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	// Only the tables opted in for profiling are profiled.
+	if err = storage.UpdateTableProfiling("product", true); err != nil {
+		t.Fatalf("we shouldn't get an error from UpdateTableProfiling; got %s", err)
+	}
+	report, err := profileTables(storage, introspector, profileOptions{Budget: time.Minute, SampleRows: 100})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from profileTables; got %s", err)
+	}
+	if len(report.Profiled) != 3 || len(report.Skipped) != 0 {
+		t.Fatalf("expected the 3 columns of the table product to be profiled; got %+v", report)
+	}
+
+	cols, err := storage.GetColumns()
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from GetColumns; got %s", err)
+	}
+	expected := map[string]columnProfile{
+		"id": {SampledRows: 3, DistinctCount: 3, Min: "1", Max: "3",
+			TopValues: []valueCount{{"1", 1}, {"2", 1}, {"3", 1}}},
+		"counting_option": {SampledRows: 3, DistinctCount: 2, Min: "decimal", Max: "unit", AvgLength: 5,
+			TopValues: []valueCount{{"unit", 2}, {"decimal", 1}}},
+	}
+	for _, col := range cols {
+		if col.TBName != "product" {
+			if col.Profile != nil {
+				t.Errorf("expected the column %s.%s not to be profiled; got %+v", col.TBName, col.Name, col.Profile)
+			}
+			continue
+		}
+		want, ok := expected[col.Name]
+		if !ok {
+			continue
+		}
+		if col.Profile == nil {
+			t.Fatalf("expected the column product.%s to be profiled", col.Name)
+		}
+		got := *col.Profile
+		got.Time = time.Time{}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("expected the profile of the column product.%s to be %+v; got %+v", col.Name, want, got)
+		}
+	}
+
+	// The sample is limited to the given number of rows.
+	report, err = profileTables(storage, introspector, profileOptions{Budget: time.Minute, SampleRows: 2})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from profileTables; got %s", err)
+	}
+	cols, err = storage.GetColumns()
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from GetColumns; got %s", err)
+	}
+	for _, col := range cols {
+		if col.TBName == "product" && col.Profile.SampledRows != 2 {
+			t.Errorf("expected 2 sampled rows for the column product.%s; got %d", col.Name, col.Profile.SampledRows)
+		}
+	}
+
+	// The columns are left for the next run when the time budget runs out.
+	report, err = profileTables(storage, introspector, profileOptions{Budget: 0, SampleRows: 100})
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from profileTables; got %s", err)
+	}
+	if len(report.Profiled) != 0 || len(report.Skipped) != 3 {
+		t.Errorf("expected the 3 columns of the table product to be skipped; got %+v", report)
+	}
+}
+
+func Test_commentWriter_for_sqlite_db(t *testing.T) {
+	introspector, err := newSqliteIntrospector(sqliteTestDb, createSqliteConf())
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the introspector; got %s", err)
+	}
+
+	_, err = commentWriter(introspector)
+	if err == nil {
+		t.Errorf("expected an error since sqlite databases do not support comments")
+	}
+}
+
+func Test_syncDatabase_preserves_the_descriptions_of_changed_columns_for_sqlite_db(t *testing.T) {
+	conf := createSqliteConf()
+	conf.ForceDelete = true // for testing we force delete of the data in the database.
+
+	createNote := func(body string) {
+		_, err := sqliteTestDb.Exec("DROP TABLE IF EXISTS note; CREATE TABLE note (id INTEGER NOT NULL PRIMARY KEY, " +
+			"body " + body + ");")
+		if err != nil {
+			t.Fatalf("we shouldn't get an error when creating the table note; got %s", err)
+		}
+	}
+	createNote("TEXT NULL")
+	defer sqliteTestDb.Exec("DROP TABLE IF EXISTS note;")
+
+	storage, err := newJsonStorageIn(t.TempDir())
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from newJsonStorageIn; got %s", err)
+	}
+	introspector, err := introspectors[conf.DatabaseDriver].newIntrospector(sqliteTestDb, conf)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the introspector; got %s", err)
+	}
+	if err = setupInitialMetadata(storage, conf, introspector); err != nil {
+		t.Fatalf("we shouldn't get an error from setupInitialMetadata; got %s", err)
+	}
+	if err = storage.UpdateAddColumnDescription(columnID("note", "body"), "The text of the note."); err != nil {
+		t.Fatalf("we shouldn't get an error from UpdateAddColumnDescription; got %s", err)
+	}
+
+	createNote("TEXT NOT NULL")
+	rec := httptest.NewRecorder()
+	syncDatabase(storage, introspector)(rec, httptest.NewRequest(http.MethodPost, "/sync-db", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected the database to be synced; got status %d", rec.Code)
+	}
+
+	columns, err := storage.GetColumns()
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from GetColumns; got %s", err)
+	}
+	body, err := columns.getByColNameAndTableName("body", "note")
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from getByColNameAndTableName; got %s", err)
+	}
+	if body.Nullable {
+		t.Errorf("expected the column body of table note to be synced as not nullable")
+	}
+	if body.Description != "The text of the note." {
+		t.Errorf("expected the description of the column body to survive the sync; got (%s)", body.Description)
+	}
+}
+
+func Test_getColumnChanges_syncs_the_legacy_columns_silently_for_sqlite_db(t *testing.T) {
+	conf := createSqliteConf()
+	conf.ForceDelete = true // for testing we force delete of the data in the database.
+
+	storage, err := newJsonStorageIn(t.TempDir())
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from newJsonStorageIn; got %s", err)
+	}
+	introspector, err := introspectors[conf.DatabaseDriver].newIntrospector(sqliteTestDb, conf)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the introspector; got %s", err)
+	}
+	if err = setupInitialMetadata(storage, conf, introspector); err != nil {
+		t.Fatalf("we shouldn't get an error from setupInitialMetadata; got %s", err)
+	}
+
+	// The columns are stored again as legacy columns, see colMetadata.legacy.
+	columns, err := storage.GetColumns()
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from GetColumns; got %s", err)
+	}
+	for _, c := range columns {
+		legacy := colMetadata{ID: c.ID, Name: c.Name, DBType: c.DBType, Nullable: c.Nullable, GoType: c.GoType,
+			Length: c.Length, TBName: c.TBName, Description: c.Description, IsPrimaryKey: c.IsPrimaryKey,
+			IsForeignKey: c.IsForeignKey, TargetTableFK: c.TargetTableFK, DeleteRule: c.DeleteRule,
+			UpdateRule: c.UpdateRule, HasENUM: c.HasENUM, ENUMName: c.ENUMName, ENUMValues: c.ENUMValues,
+			IsUnique: c.IsUnique}
+		if err = storage.db.Write(collectionColumn, c.ID, legacy); err != nil {
+			t.Fatalf("we shouldn't get an error when writing the legacy column; got %s", err)
+		}
+	}
+
+	cat := readTestCatalog(t, sqliteTestDb, conf)
+	changes, err := getColumnChanges(storage, cat)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from getColumnChanges; got %s", err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("expected the metadata not recorded by the legacy columns not to be reported; got %+v", changes)
+	}
+
+	// The metadata is synced anyway.
+	unreported, err := getUnreportedColumnChanges(storage, cat)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from getUnreportedColumnChanges; got %s", err)
+	}
+	if len(unreported) != len(columns) {
+		t.Fatalf("expected the %d legacy columns to be synced; got %d", len(columns), len(unreported))
+	}
+	for _, c := range unreported {
+		if err = storage.UpdateColMetadata(c); err != nil {
+			t.Fatalf("we shouldn't get an error from UpdateColMetadata; got %s", err)
+		}
+	}
+	synced, err := storage.GetColumns()
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from GetColumns; got %s", err)
+	}
+	if !reflect.DeepEqual(synced, columns) {
+		t.Errorf("expected the synced columns to be %+v; got %+v", columns, synced)
+	}
+}
+
+func Test_databaseMetaDataSetup_AND_some_repository_methods_for_sqlite_db(t *testing.T) {
+	conf := createSqliteConf()
+	conf.ForceDelete = true // for testing we force delete of the data in the database.
+
+	storage, err := newJsonStorageIn(t.TempDir())
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from newJsonStorageIn; got %s", err)
+	}
+
+	// Test setup.
+
+	introspector, err := introspectors[conf.DatabaseDriver].newIntrospector(sqliteTestDb, conf)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the introspector; got %s", err)
+	}
+
+	err = setupInitialMetadata(storage, conf, introspector)
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from databaseMetaDataSetup; got %s", err)
+	}
+
+	// Test some repository methods.
+
+	tables, err := storage.GetTables()
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from GetTables; got %s", err)
+	}
+
+	if tables.count() != 4 {
+		t.Fatalf("expected to have 4 tables got %d", tables.count())
+	}
+
+	expectedTables := []table{
+		{
+			ID:          "order",
+			Name:        "order",
+			Kind:        tableKind,
+			Description: "",
+			PrimaryKey:  &keyConstraint{Name: "", Columns: []string{"id"}},
+			ForeignKeys: []keyConstraint{},
+			Indexes:     []tableIndex{testIndex("order_id_uindex", true, "id")},
+			Checks:      []checkConstraint{},
+		}, {
+			ID:          "product",
+			Name:        "product",
+			Kind:        tableKind,
+			Description: "",
+			PrimaryKey:  &keyConstraint{Name: "", Columns: []string{"id"}},
+			ForeignKeys: []keyConstraint{},
+			Indexes: []tableIndex{
+				testIndex("product_id_uindex", true, "id"),
+				{Name: "product_lower_name_index", Keys: []indexKey{{Expression: "expression"}}, Method: "btree"},
+				testIndex("product_name_uindex", true, "name"),
+			},
+			Checks: []checkConstraint{},
+		}, {
+			ID:          "order_line",
+			Name:        "order_line",
+			Kind:        tableKind,
+			Description: "",
+			PrimaryKey:  &keyConstraint{Name: "", Columns: []string{"id"}},
+			ForeignKeys: []keyConstraint{
+				{
+					Name:          "order_line_fk_0",
+					Columns:       []string{"product_id"},
+					TargetTable:   "product",
+					TargetColumns: []string{"id"},
+					DeleteRule:    "RESTRICT",
+					UpdateRule:    "NO ACTION",
+				}, {
+					Name:          "order_line_fk_1",
+					Columns:       []string{"order_id"},
+					TargetTable:   "order",
+					TargetColumns: []string{"id"},
+					DeleteRule:    "RESTRICT",
+					UpdateRule:    "NO ACTION",
+				},
+			},
+			Indexes: []tableIndex{
+				testIndex("order_line_id_uindex", true, "id"),
+				{Name: "order_line_product_id_index", Keys: []indexKey{{Column: "product_id"}}, Method: "btree",
+					Predicate: "product_id > 0"},
+			},
+			Checks: []checkConstraint{},
+		},
+	}
+
+	for _, e := range expectedTables {
+		exists := false
+		for _, tb := range tables {
+			if tb.ID == e.ID {
+				exists = true
+				if !reflect.DeepEqual(tb, e) {
+					t.Errorf("expected table data to be %+v; got %+v instead", e, tb)
+				}
+				break
+			}
+		}
+		if !exists {
+			t.Errorf("table %s does not exist after setup", e.Name)
+		}
+	}
+
+	databaseInfo, err := storage.GetDatabaseInfo()
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from GetDatabaseInfo; got %s", err)
+	}
+	if equal, _ := compareStoredDatabaseInfoWithConf(databaseInfo, conf); !equal {
+		t.Errorf("database info (%+v) differs from current conf (%+v)", databaseInfo, conf)
+	}
+
+	orderTable, _ := tables.get("order")
+	err = storage.UpdateAddTableDescription(orderTable.ID, "I am a cool table.")
+	if err != nil {
+		t.Fatalf("we shouldn't get any error from UpdateAddTableDescription; got %s", err)
+	}
+
+	tables, _ = storage.GetTables()
+	updatedTable, _ := tables.get("order")
+	if updatedTable.Description != "I am a cool table." {
+		t.Errorf("expected tables description to be (%s); got %s instead", "I am a cool table.",
+			updatedTable.Description)
+	}
+
+	columns, err := storage.GetColumns()
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from GetColumns; got %s", err)
+	}
+
+	if len(columns) != 9 {
+		t.Fatalf("expected 9 columns got %d", len(columns))
+	}
+
+	// The columns of a table are returned in their order in the table.
+	productCols := columns.getAllColumnsFromTable("product")
+	for i, name := range []string{"id", "name", "counting_option"} {
+		if productCols[i].Name != name || productCols[i].Position != i+1 {
+			t.Errorf("expected column %s at position %d of table product; got column %s at position %d", name, i+1,
+				productCols[i].Name, productCols[i].Position)
+		}
+	}
+
+	orderIDCol, err := columns.getByColNameAndTableName("order_id", "order_line")
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from getByColNameAndTableName; got %s", err)
+	}
+	if orderIDCol.TargetColFK != "id" {
+		t.Errorf("expected column order_id to reference the column (id); got (%s)", orderIDCol.TargetColFK)
+	}
+
+	productNameCol, err := columns.getByColNameAndTableName("name", "product")
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from getByColNameAndTableName; got %s", err)
+	}
+
+	err = storage.UpdateAddColumnDescription(productNameCol.ID, "I have a nice name.")
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from UpdateAddColumnDescription; got %s", err)
+	}
+
+	columns, err = storage.GetColumns()
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from GetColumns; got %s", err)
+	}
+
+	productNameCol, _ = columns.getByColNameAndTableName("name", "product")
+	if productNameCol.Description != "I have a nice name." {
+		t.Errorf("expected description to be (%s) in column %s in table %s got %s", "I have a nice name.",
+			productNameCol.Name, productNameCol.TBName, productNameCol.Description)
+	}
+
+	// The descriptions not yet written as comments in the database are the ones to write.
+	comments, err := getCommentsToWrite(storage, readTestCatalog(t, sqliteTestDb, conf))
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from getCommentsToWrite; got %s", err)
+	}
+	if len(comments) != 2 {
+		t.Fatalf("expected 2 descriptions to write as comments; got %d", len(comments))
+	}
+	if comments[0].Table != "order" || comments[0].Column != "" || comments[0].Description != "I am a cool table." {
+		t.Errorf("expected the description of table order to be written; got %+v", comments[0])
+	}
+	if comments[1].Table != "product" || comments[1].Column != "name" || comments[1].ColumnID != productNameCol.ID {
+		t.Errorf("expected the description of column name in table product to be written; got %+v", comments[1])
+	}
+
+	// An index that is not stored yet is reported as a change of its table.
+	tables, _ = storage.GetTables()
+	productTable, _ := tables.get("product")
+	productTable.Indexes = productTable.Indexes[:1]
+	if err = storage.UpdateTableMetadata(productTable); err != nil {
+		t.Fatalf("we shouldn't get an error from UpdateTableMetadata; got %s", err)
+	}
+	changes, err := getTableChanges(storage, readTestCatalog(t, sqliteTestDb, conf))
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from getTableChanges; got %s", err)
+	}
+	if len(changes) != 1 || !strings.Contains(changes[0].ChangesMessage, "new index (product_lower_name_index btree ((expression)))") {
+		t.Errorf("expected the new indexes of table product to be reported; got %+v", changes)
+	}
+}
+
+func Test_queryTableColumns_reads_the_declared_decimal_size_for_sqlite_db(t *testing.T) {
+	_, err := sqliteTestDb.Exec("CREATE TABLE invoice (total DECIMAL(12, 2) NOT NULL, amount REAL NOT NULL);")
+	if err != nil {
+		t.Fatalf("we shouldn't get an error when creating the table invoice; got %s", err)
+	}
+	defer func() {
+		if _, err := sqliteTestDb.Exec("DROP TABLE invoice;"); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	cols, err := queryTableColumns(sqliteTestDb, fmt.Sprintf(sqliteQueryGetColumns, "", "invoice"))
+	if err != nil {
+		t.Fatalf("we shouldn't get an error from queryTableColumns; got %s", err)
+	}
+
+	if cols[0].Precision != 12 || cols[0].Scale != 2 {
+		t.Errorf("expected DECIMAL(12, 2) to have precision 12 and scale 2; got %d and %d", cols[0].Precision, cols[0].Scale)
+	}
+	if cols[1].Precision != 0 || cols[1].Scale != 0 {
+		t.Errorf("expected column without a declared precision to have precision and scale 0; got %d and %d",
+			cols[1].Precision, cols[1].Scale)
+	}
+}
+
+func Test_compareColumnMetadata_reports_only_narrowed_decimal_sizes(t *testing.T) {
+	stored := colMetadata{Name: "price", TBName: "product", Position: 1, DBType: "NUMERIC", Precision: 12, Scale: 2}
+	tests := []struct {
+		name      string
+		precision int64
+		scale     int64
+		expected  string
+	}{
+		{"narrowed precision", 10, 2, "column precision narrowed from 12 to 10."},
+		{"narrowed scale", 12, 1, "column scale narrowed from 2 to 1."},
+		{"widened precision", 18, 2, ""},
+		{"widened scale", 12, 4, ""},
+		{"undeclared precision", 0, 0, ""},
+	}
+	for _, tt := range tests {
+		current := stored
+		current.Precision, current.Scale = tt.precision, tt.scale
+		equal, msg, err := compareColumnMetadata(stored, current)
+		if err != nil {
+			t.Fatalf("we shouldn't get an error from compareColumnMetadata; got %s", err)
+		}
+		if equal != (tt.expected == "") || msg != tt.expected {
+			t.Errorf("%s: expected the change (%s); got (%s)", tt.name, tt.expected, msg)
+		}
+	}
+
+	// A declared precision narrows a column that did not have any.
+	undeclared := stored
+	undeclared.Precision, undeclared.Scale = 0, 0
+	if _, msg, _ := compareColumnMetadata(undeclared, stored); msg != "column precision narrowed from 0 to 12." {
+		t.Errorf("expected a declared precision to be reported as narrowed; got (%s)", msg)
+	}
+}
+
+func Test_compareColumnMetadata_reports_only_the_recorded_metadata(t *testing.T) {
+	fields := []struct {
+		name string
+		set  func(c *colMetadata, value string)
+	}{
+		{"default", func(c *colMetadata, v string) { c.Default = v }},
+		{"identity", func(c *colMetadata, v string) { c.Identity = v }},
+		{"generation", func(c *colMetadata, v string) { c.Generation = v }},
+		{"extra", func(c *colMetadata, v string) { c.Extra = v }},
+		{"type kind", func(c *colMetadata, v string) { c.TypeKind = v }},
+		{"domain checks", func(c *colMetadata, v string) {
+			c.DomainChecks = nil
+			if v != "" {
+				c.DomainChecks = []string{v}
+			}
+		}},
+		{"target column", func(c *colMetadata, v string) { c.TargetColFK = v }},
+		{"charset", func(c *colMetadata, v string) { c.Charset = v }},
+		{"collation", func(c *colMetadata, v string) { c.Collation = v }},
+	}
+	tests := []struct {
+		name     string
+		position int
+		stored   string
+		current  string
+		equal    bool
+	}{
+		{"not recorded by a legacy column", 0, "", "a", true},
+		{"changed in a legacy column", 0, "a", "b", false},
+		{"recorded empty", 1, "", "a", false},
+		{"changed", 1, "a", "b", false},
+	}
+
+	for _, f := range fields {
+		for _, tt := range tests {
+			stored := colMetadata{Name: "order_id", TBName: "order_line", Position: tt.position, IsForeignKey: true}
+			current := stored
+			current.Position = 1
+			f.set(&stored, tt.stored)
+			f.set(&current, tt.current)
+			equal, msg, err := compareColumnMetadata(stored, current)
+			if err != nil {
+				t.Fatalf("we shouldn't get an error from compareColumnMetadata; got %s", err)
+			}
+			if equal != tt.equal {
+				t.Errorf("%s %s: expected equal to be %t; got %t (%s)", f.name, tt.name, tt.equal, equal, msg)
+			}
+		}
+	}
+}
+
+func Test_movedColumns(t *testing.T) {
+	stored := ColumnsMetadata{
+		{Name: "a", Position: 1}, {Name: "b", Position: 2}, {Name: "c", Position: 3}, {Name: "d", Position: 4},
+	}
+	columns := func(names ...string) []column {
+		cols := make([]column, 0, len(names))
+		for _, name := range names {
+			cols = append(cols, column{Name: name})
+		}
+		return cols
+	}
+
+	tests := []struct {
+		current  []column
+		expected map[string]bool
+	}{
+		{columns("a", "b", "c", "d"), map[string]bool{}},
+		// Adding or dropping a column shifts the following columns without moving them.
+		{columns("a", "x", "b", "c", "d"), map[string]bool{}},
+		{columns("a", "c", "d"), map[string]bool{}},
+		// Moving a column with ALTER TABLE ... MODIFY COLUMN d ... AFTER a only moves d.
+		{columns("a", "d", "b", "c"), map[string]bool{"d": true}},
+		{columns("b", "c", "d", "a"), map[string]bool{"a": true}},
+	}
+
+	for _, tt := range tests {
+		if got := movedColumns(stored, tt.current); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("expected moved columns %v for %+v; got %v", tt.expected, tt.current, got)
+		}
+	}
+
+	// The legacy columns do not have a position, see colMetadata.legacy.
+	legacy := ColumnsMetadata{{Name: "a"}, {Name: "b"}}
+	if got := movedColumns(legacy, columns("b", "a")); len(got) != 0 {
+		t.Errorf("expected no moved columns without stored positions; got %v", got)
+	}
+}